@@ -25,7 +25,7 @@ var File_todo_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_todo_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1atodo/v1/auth_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x12todo/v1/auth.proto2\xd6\b\n" +
+	"\x1atodo/v1/auth_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x12todo/v1/auth.proto2\xfe\x17\n" +
 	"\vAuthService\x12]\n" +
 	"\bRegister\x12\x18.todo.v1.RegisterRequest\x1a\x19.todo.v1.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12Q\n" +
 	"\x05Login\x12\x15.todo.v1.LoginRequest\x1a\x16.todo.v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12U\n" +
@@ -35,32 +35,77 @@ const file_todo_v1_auth_service_proto_rawDesc = "" +
 	"GetProfile\x12\x1a.todo.v1.GetProfileRequest\x1a\x1b.todo.v1.GetProfileResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/auth/profile\x12k\n" +
 	"\rUpdateProfile\x12\x1d.todo.v1.UpdateProfileRequest\x1a\x1e.todo.v1.UpdateProfileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/auth/profile\x12v\n" +
 	"\x0eChangePassword\x12\x1e.todo.v1.ChangePasswordRequest\x1a\x1f.todo.v1.ChangePasswordResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/change-password\x12j\n" +
-	"\vVerifyEmail\x12\x1b.todo.v1.VerifyEmailRequest\x1a\x1c.todo.v1.VerifyEmailResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-email\x12\x8f\x01\n" +
+	"\vVerifyEmail\x12\x1b.todo.v1.VerifyEmailRequest\x1a\x1c.todo.v1.VerifyEmailResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-email\x12\x9b\x01\n" +
+	"\x17ResendVerificationEmail\x12'.todo.v1.ResendVerificationEmailRequest\x1a(.todo.v1.ResendVerificationEmailResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/auth/resend-verification-email\x12\x8f\x01\n" +
 	"\x14RequestPasswordReset\x12$.todo.v1.RequestPasswordResetRequest\x1a%.todo.v1.RequestPasswordResetResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/request-password-reset\x12\x8f\x01\n" +
-	"\x14ConfirmPasswordReset\x12$.todo.v1.ConfirmPasswordResetRequest\x1a%.todo.v1.ConfirmPasswordResetResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/confirm-password-resetBA\n" +
+	"\x14ConfirmPasswordReset\x12$.todo.v1.ConfirmPasswordResetRequest\x1a%.todo.v1.ConfirmPasswordResetResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/confirm-password-reset\x12f\n" +
+	"\fListSessions\x12\x1c.todo.v1.ListSessionsRequest\x1a\x1d.todo.v1.ListSessionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/auth/sessions\x12v\n" +
+	"\rRevokeSession\x12\x1d.todo.v1.RevokeSessionRequest\x1a\x1e.todo.v1.RevokeSessionResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/auth/sessions/{session_id}\x12w\n" +
+	"\x10ListSSOProviders\x12 .todo.v1.ListSSOProvidersRequest\x1a!.todo.v1.ListSSOProvidersResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/auth/sso/providers\x12y\n" +
+	"\rStartSSOLogin\x12\x1d.todo.v1.StartSSOLoginRequest\x1a\x1e.todo.v1.StartSSOLoginResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/auth/sso/{provider}/authorize\x12\x84\x01\n" +
+	"\x10CompleteSSOLogin\x12 .todo.v1.CompleteSSOLoginRequest\x1a!.todo.v1.CompleteSSOLoginResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/auth/sso/{provider}/callback\x12\x8e\x01\n" +
+	"\x19CreatePersonalAccessToken\x12).todo.v1.CreatePersonalAccessTokenRequest\x1a*.todo.v1.CreatePersonalAccessTokenResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/tokens\x12\x88\x01\n" +
+	"\x18ListPersonalAccessTokens\x12(.todo.v1.ListPersonalAccessTokensRequest\x1a).todo.v1.ListPersonalAccessTokensResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/auth/tokens\x12\x96\x01\n" +
+	"\x19RevokePersonalAccessToken\x12).todo.v1.RevokePersonalAccessTokenRequest\x1a*.todo.v1.RevokePersonalAccessTokenResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/auth/tokens/{token_id}\x12\x83\x01\n" +
+	"\x14VerifyTwoFactorLogin\x12$.todo.v1.VerifyTwoFactorLoginRequest\x1a%.todo.v1.VerifyTwoFactorLoginResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/2fa/verify\x12t\n" +
+	"\x0fEnrollTwoFactor\x12\x1f.todo.v1.EnrollTwoFactorRequest\x1a .todo.v1.EnrollTwoFactorResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/2fa/enroll\x12x\n" +
+	"\x10ConfirmTwoFactor\x12 .todo.v1.ConfirmTwoFactorRequest\x1a!.todo.v1.ConfirmTwoFactorResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/auth/2fa/confirm\x12x\n" +
+	"\x10DisableTwoFactor\x12 .todo.v1.DisableTwoFactorRequest\x1a!.todo.v1.DisableTwoFactorResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/auth/2fa/disable\x12r\n" +
+	"\rDeleteAccount\x12\x1d.todo.v1.DeleteAccountRequest\x1a\x1e.todo.v1.DeleteAccountResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/account/delete\x12\x93\x01\n" +
+	"\x15CancelAccountDeletion\x12%.todo.v1.CancelAccountDeletionRequest\x1a&.todo.v1.CancelAccountDeletionResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/auth/account/cancel-deletionBA\n" +
 	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
 
 var file_todo_v1_auth_service_proto_goTypes = []any{
-	(*RegisterRequest)(nil),              // 0: todo.v1.RegisterRequest
-	(*LoginRequest)(nil),                 // 1: todo.v1.LoginRequest
-	(*LogoutRequest)(nil),                // 2: todo.v1.LogoutRequest
-	(*RefreshTokenRequest)(nil),          // 3: todo.v1.RefreshTokenRequest
-	(*GetProfileRequest)(nil),            // 4: todo.v1.GetProfileRequest
-	(*UpdateProfileRequest)(nil),         // 5: todo.v1.UpdateProfileRequest
-	(*ChangePasswordRequest)(nil),        // 6: todo.v1.ChangePasswordRequest
-	(*VerifyEmailRequest)(nil),           // 7: todo.v1.VerifyEmailRequest
-	(*RequestPasswordResetRequest)(nil),  // 8: todo.v1.RequestPasswordResetRequest
-	(*ConfirmPasswordResetRequest)(nil),  // 9: todo.v1.ConfirmPasswordResetRequest
-	(*RegisterResponse)(nil),             // 10: todo.v1.RegisterResponse
-	(*LoginResponse)(nil),                // 11: todo.v1.LoginResponse
-	(*LogoutResponse)(nil),               // 12: todo.v1.LogoutResponse
-	(*RefreshTokenResponse)(nil),         // 13: todo.v1.RefreshTokenResponse
-	(*GetProfileResponse)(nil),           // 14: todo.v1.GetProfileResponse
-	(*UpdateProfileResponse)(nil),        // 15: todo.v1.UpdateProfileResponse
-	(*ChangePasswordResponse)(nil),       // 16: todo.v1.ChangePasswordResponse
-	(*VerifyEmailResponse)(nil),          // 17: todo.v1.VerifyEmailResponse
-	(*RequestPasswordResetResponse)(nil), // 18: todo.v1.RequestPasswordResetResponse
-	(*ConfirmPasswordResetResponse)(nil), // 19: todo.v1.ConfirmPasswordResetResponse
+	(*RegisterRequest)(nil),                   // 0: todo.v1.RegisterRequest
+	(*LoginRequest)(nil),                      // 1: todo.v1.LoginRequest
+	(*LogoutRequest)(nil),                     // 2: todo.v1.LogoutRequest
+	(*RefreshTokenRequest)(nil),               // 3: todo.v1.RefreshTokenRequest
+	(*GetProfileRequest)(nil),                 // 4: todo.v1.GetProfileRequest
+	(*UpdateProfileRequest)(nil),              // 5: todo.v1.UpdateProfileRequest
+	(*ChangePasswordRequest)(nil),             // 6: todo.v1.ChangePasswordRequest
+	(*VerifyEmailRequest)(nil),                // 7: todo.v1.VerifyEmailRequest
+	(*ResendVerificationEmailRequest)(nil),    // 8: todo.v1.ResendVerificationEmailRequest
+	(*RequestPasswordResetRequest)(nil),       // 9: todo.v1.RequestPasswordResetRequest
+	(*ConfirmPasswordResetRequest)(nil),       // 10: todo.v1.ConfirmPasswordResetRequest
+	(*ListSessionsRequest)(nil),               // 11: todo.v1.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),              // 12: todo.v1.RevokeSessionRequest
+	(*ListSSOProvidersRequest)(nil),           // 13: todo.v1.ListSSOProvidersRequest
+	(*StartSSOLoginRequest)(nil),              // 14: todo.v1.StartSSOLoginRequest
+	(*CompleteSSOLoginRequest)(nil),           // 15: todo.v1.CompleteSSOLoginRequest
+	(*CreatePersonalAccessTokenRequest)(nil),  // 16: todo.v1.CreatePersonalAccessTokenRequest
+	(*ListPersonalAccessTokensRequest)(nil),   // 17: todo.v1.ListPersonalAccessTokensRequest
+	(*RevokePersonalAccessTokenRequest)(nil),  // 18: todo.v1.RevokePersonalAccessTokenRequest
+	(*VerifyTwoFactorLoginRequest)(nil),       // 19: todo.v1.VerifyTwoFactorLoginRequest
+	(*EnrollTwoFactorRequest)(nil),            // 20: todo.v1.EnrollTwoFactorRequest
+	(*ConfirmTwoFactorRequest)(nil),           // 21: todo.v1.ConfirmTwoFactorRequest
+	(*DisableTwoFactorRequest)(nil),           // 22: todo.v1.DisableTwoFactorRequest
+	(*DeleteAccountRequest)(nil),              // 23: todo.v1.DeleteAccountRequest
+	(*CancelAccountDeletionRequest)(nil),      // 24: todo.v1.CancelAccountDeletionRequest
+	(*RegisterResponse)(nil),                  // 25: todo.v1.RegisterResponse
+	(*LoginResponse)(nil),                     // 26: todo.v1.LoginResponse
+	(*LogoutResponse)(nil),                    // 27: todo.v1.LogoutResponse
+	(*RefreshTokenResponse)(nil),              // 28: todo.v1.RefreshTokenResponse
+	(*GetProfileResponse)(nil),                // 29: todo.v1.GetProfileResponse
+	(*UpdateProfileResponse)(nil),             // 30: todo.v1.UpdateProfileResponse
+	(*ChangePasswordResponse)(nil),            // 31: todo.v1.ChangePasswordResponse
+	(*VerifyEmailResponse)(nil),               // 32: todo.v1.VerifyEmailResponse
+	(*ResendVerificationEmailResponse)(nil),   // 33: todo.v1.ResendVerificationEmailResponse
+	(*RequestPasswordResetResponse)(nil),      // 34: todo.v1.RequestPasswordResetResponse
+	(*ConfirmPasswordResetResponse)(nil),      // 35: todo.v1.ConfirmPasswordResetResponse
+	(*ListSessionsResponse)(nil),              // 36: todo.v1.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),             // 37: todo.v1.RevokeSessionResponse
+	(*ListSSOProvidersResponse)(nil),          // 38: todo.v1.ListSSOProvidersResponse
+	(*StartSSOLoginResponse)(nil),             // 39: todo.v1.StartSSOLoginResponse
+	(*CompleteSSOLoginResponse)(nil),          // 40: todo.v1.CompleteSSOLoginResponse
+	(*CreatePersonalAccessTokenResponse)(nil), // 41: todo.v1.CreatePersonalAccessTokenResponse
+	(*ListPersonalAccessTokensResponse)(nil),  // 42: todo.v1.ListPersonalAccessTokensResponse
+	(*RevokePersonalAccessTokenResponse)(nil), // 43: todo.v1.RevokePersonalAccessTokenResponse
+	(*VerifyTwoFactorLoginResponse)(nil),      // 44: todo.v1.VerifyTwoFactorLoginResponse
+	(*EnrollTwoFactorResponse)(nil),           // 45: todo.v1.EnrollTwoFactorResponse
+	(*ConfirmTwoFactorResponse)(nil),          // 46: todo.v1.ConfirmTwoFactorResponse
+	(*DisableTwoFactorResponse)(nil),          // 47: todo.v1.DisableTwoFactorResponse
+	(*DeleteAccountResponse)(nil),             // 48: todo.v1.DeleteAccountResponse
+	(*CancelAccountDeletionResponse)(nil),     // 49: todo.v1.CancelAccountDeletionResponse
 }
 var file_todo_v1_auth_service_proto_depIdxs = []int32{
 	0,  // 0: todo.v1.AuthService.Register:input_type -> todo.v1.RegisterRequest
@@ -71,20 +116,50 @@ var file_todo_v1_auth_service_proto_depIdxs = []int32{
 	5,  // 5: todo.v1.AuthService.UpdateProfile:input_type -> todo.v1.UpdateProfileRequest
 	6,  // 6: todo.v1.AuthService.ChangePassword:input_type -> todo.v1.ChangePasswordRequest
 	7,  // 7: todo.v1.AuthService.VerifyEmail:input_type -> todo.v1.VerifyEmailRequest
-	8,  // 8: todo.v1.AuthService.RequestPasswordReset:input_type -> todo.v1.RequestPasswordResetRequest
-	9,  // 9: todo.v1.AuthService.ConfirmPasswordReset:input_type -> todo.v1.ConfirmPasswordResetRequest
-	10, // 10: todo.v1.AuthService.Register:output_type -> todo.v1.RegisterResponse
-	11, // 11: todo.v1.AuthService.Login:output_type -> todo.v1.LoginResponse
-	12, // 12: todo.v1.AuthService.Logout:output_type -> todo.v1.LogoutResponse
-	13, // 13: todo.v1.AuthService.RefreshToken:output_type -> todo.v1.RefreshTokenResponse
-	14, // 14: todo.v1.AuthService.GetProfile:output_type -> todo.v1.GetProfileResponse
-	15, // 15: todo.v1.AuthService.UpdateProfile:output_type -> todo.v1.UpdateProfileResponse
-	16, // 16: todo.v1.AuthService.ChangePassword:output_type -> todo.v1.ChangePasswordResponse
-	17, // 17: todo.v1.AuthService.VerifyEmail:output_type -> todo.v1.VerifyEmailResponse
-	18, // 18: todo.v1.AuthService.RequestPasswordReset:output_type -> todo.v1.RequestPasswordResetResponse
-	19, // 19: todo.v1.AuthService.ConfirmPasswordReset:output_type -> todo.v1.ConfirmPasswordResetResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	8,  // 8: todo.v1.AuthService.ResendVerificationEmail:input_type -> todo.v1.ResendVerificationEmailRequest
+	9,  // 9: todo.v1.AuthService.RequestPasswordReset:input_type -> todo.v1.RequestPasswordResetRequest
+	10, // 10: todo.v1.AuthService.ConfirmPasswordReset:input_type -> todo.v1.ConfirmPasswordResetRequest
+	11, // 11: todo.v1.AuthService.ListSessions:input_type -> todo.v1.ListSessionsRequest
+	12, // 12: todo.v1.AuthService.RevokeSession:input_type -> todo.v1.RevokeSessionRequest
+	13, // 13: todo.v1.AuthService.ListSSOProviders:input_type -> todo.v1.ListSSOProvidersRequest
+	14, // 14: todo.v1.AuthService.StartSSOLogin:input_type -> todo.v1.StartSSOLoginRequest
+	15, // 15: todo.v1.AuthService.CompleteSSOLogin:input_type -> todo.v1.CompleteSSOLoginRequest
+	16, // 16: todo.v1.AuthService.CreatePersonalAccessToken:input_type -> todo.v1.CreatePersonalAccessTokenRequest
+	17, // 17: todo.v1.AuthService.ListPersonalAccessTokens:input_type -> todo.v1.ListPersonalAccessTokensRequest
+	18, // 18: todo.v1.AuthService.RevokePersonalAccessToken:input_type -> todo.v1.RevokePersonalAccessTokenRequest
+	19, // 19: todo.v1.AuthService.VerifyTwoFactorLogin:input_type -> todo.v1.VerifyTwoFactorLoginRequest
+	20, // 20: todo.v1.AuthService.EnrollTwoFactor:input_type -> todo.v1.EnrollTwoFactorRequest
+	21, // 21: todo.v1.AuthService.ConfirmTwoFactor:input_type -> todo.v1.ConfirmTwoFactorRequest
+	22, // 22: todo.v1.AuthService.DisableTwoFactor:input_type -> todo.v1.DisableTwoFactorRequest
+	23, // 23: todo.v1.AuthService.DeleteAccount:input_type -> todo.v1.DeleteAccountRequest
+	24, // 24: todo.v1.AuthService.CancelAccountDeletion:input_type -> todo.v1.CancelAccountDeletionRequest
+	25, // 25: todo.v1.AuthService.Register:output_type -> todo.v1.RegisterResponse
+	26, // 26: todo.v1.AuthService.Login:output_type -> todo.v1.LoginResponse
+	27, // 27: todo.v1.AuthService.Logout:output_type -> todo.v1.LogoutResponse
+	28, // 28: todo.v1.AuthService.RefreshToken:output_type -> todo.v1.RefreshTokenResponse
+	29, // 29: todo.v1.AuthService.GetProfile:output_type -> todo.v1.GetProfileResponse
+	30, // 30: todo.v1.AuthService.UpdateProfile:output_type -> todo.v1.UpdateProfileResponse
+	31, // 31: todo.v1.AuthService.ChangePassword:output_type -> todo.v1.ChangePasswordResponse
+	32, // 32: todo.v1.AuthService.VerifyEmail:output_type -> todo.v1.VerifyEmailResponse
+	33, // 33: todo.v1.AuthService.ResendVerificationEmail:output_type -> todo.v1.ResendVerificationEmailResponse
+	34, // 34: todo.v1.AuthService.RequestPasswordReset:output_type -> todo.v1.RequestPasswordResetResponse
+	35, // 35: todo.v1.AuthService.ConfirmPasswordReset:output_type -> todo.v1.ConfirmPasswordResetResponse
+	36, // 36: todo.v1.AuthService.ListSessions:output_type -> todo.v1.ListSessionsResponse
+	37, // 37: todo.v1.AuthService.RevokeSession:output_type -> todo.v1.RevokeSessionResponse
+	38, // 38: todo.v1.AuthService.ListSSOProviders:output_type -> todo.v1.ListSSOProvidersResponse
+	39, // 39: todo.v1.AuthService.StartSSOLogin:output_type -> todo.v1.StartSSOLoginResponse
+	40, // 40: todo.v1.AuthService.CompleteSSOLogin:output_type -> todo.v1.CompleteSSOLoginResponse
+	41, // 41: todo.v1.AuthService.CreatePersonalAccessToken:output_type -> todo.v1.CreatePersonalAccessTokenResponse
+	42, // 42: todo.v1.AuthService.ListPersonalAccessTokens:output_type -> todo.v1.ListPersonalAccessTokensResponse
+	43, // 43: todo.v1.AuthService.RevokePersonalAccessToken:output_type -> todo.v1.RevokePersonalAccessTokenResponse
+	44, // 44: todo.v1.AuthService.VerifyTwoFactorLogin:output_type -> todo.v1.VerifyTwoFactorLoginResponse
+	45, // 45: todo.v1.AuthService.EnrollTwoFactor:output_type -> todo.v1.EnrollTwoFactorResponse
+	46, // 46: todo.v1.AuthService.ConfirmTwoFactor:output_type -> todo.v1.ConfirmTwoFactorResponse
+	47, // 47: todo.v1.AuthService.DisableTwoFactor:output_type -> todo.v1.DisableTwoFactorResponse
+	48, // 48: todo.v1.AuthService.DeleteAccount:output_type -> todo.v1.DeleteAccountResponse
+	49, // 49: todo.v1.AuthService.CancelAccountDeletion:output_type -> todo.v1.CancelAccountDeletionResponse
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
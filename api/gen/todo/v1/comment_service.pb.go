@@ -0,0 +1,84 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: todo/v1/comment_service.proto
+
+package todov1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_todo_v1_comment_service_proto protoreflect.FileDescriptor
+
+const file_todo_v1_comment_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtodo/v1/comment_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x15todo/v1/comment.proto2\xd5\x03\n" +
+	"\x0eCommentService\x12w\n" +
+	"\rCreateComment\x12\x1d.todo.v1.CreateCommentRequest\x1a\x1e.todo.v1.CreateCommentResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/todos/{todo_id}/comments\x12q\n" +
+	"\fListComments\x12\x1c.todo.v1.ListCommentsRequest\x1a\x1d.todo.v1.ListCommentsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/todos/{todo_id}/comments\x12l\n" +
+	"\rUpdateComment\x12\x1d.todo.v1.UpdateCommentRequest\x1a\x1e.todo.v1.UpdateCommentResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/comments/{id}\x12i\n" +
+	"\rDeleteComment\x12\x1d.todo.v1.DeleteCommentRequest\x1a\x1e.todo.v1.DeleteCommentResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/comments/{id}BA\n" +
+	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
+
+var file_todo_v1_comment_service_proto_goTypes = []any{
+	(*CreateCommentRequest)(nil),  // 0: todo.v1.CreateCommentRequest
+	(*ListCommentsRequest)(nil),   // 1: todo.v1.ListCommentsRequest
+	(*UpdateCommentRequest)(nil),  // 2: todo.v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),  // 3: todo.v1.DeleteCommentRequest
+	(*CreateCommentResponse)(nil), // 4: todo.v1.CreateCommentResponse
+	(*ListCommentsResponse)(nil),  // 5: todo.v1.ListCommentsResponse
+	(*UpdateCommentResponse)(nil), // 6: todo.v1.UpdateCommentResponse
+	(*DeleteCommentResponse)(nil), // 7: todo.v1.DeleteCommentResponse
+}
+var file_todo_v1_comment_service_proto_depIdxs = []int32{
+	0, // 0: todo.v1.CommentService.CreateComment:input_type -> todo.v1.CreateCommentRequest
+	1, // 1: todo.v1.CommentService.ListComments:input_type -> todo.v1.ListCommentsRequest
+	2, // 2: todo.v1.CommentService.UpdateComment:input_type -> todo.v1.UpdateCommentRequest
+	3, // 3: todo.v1.CommentService.DeleteComment:input_type -> todo.v1.DeleteCommentRequest
+	4, // 4: todo.v1.CommentService.CreateComment:output_type -> todo.v1.CreateCommentResponse
+	5, // 5: todo.v1.CommentService.ListComments:output_type -> todo.v1.ListCommentsResponse
+	6, // 6: todo.v1.CommentService.UpdateComment:output_type -> todo.v1.UpdateCommentResponse
+	7, // 7: todo.v1.CommentService.DeleteComment:output_type -> todo.v1.DeleteCommentResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_todo_v1_comment_service_proto_init() }
+func file_todo_v1_comment_service_proto_init() {
+	if File_todo_v1_comment_service_proto != nil {
+		return
+	}
+	file_todo_v1_comment_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_v1_comment_service_proto_rawDesc), len(file_todo_v1_comment_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_todo_v1_comment_service_proto_goTypes,
+		DependencyIndexes: file_todo_v1_comment_service_proto_depIdxs,
+	}.Build()
+	File_todo_v1_comment_service_proto = out.File
+	file_todo_v1_comment_service_proto_goTypes = nil
+	file_todo_v1_comment_service_proto_depIdxs = nil
+}
@@ -583,7 +583,7 @@ func (x *OnlineUser) GetTeamIds() []string {
 type GetOnlineUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TodoId        *string                `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3,oneof" json:"todo_id,omitempty"` // Filter by TODO list
-	TeamId        *string                `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Filter by team
+	TeamId        *string                `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Required; the caller must be a member of the team
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -38,7 +38,7 @@ type RealtimeServiceClient interface {
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error)
 	// List recent activities.
 	ListActivities(ctx context.Context, in *ListActivitiesRequest, opts ...grpc.CallOption) (*ListActivitiesResponse, error)
-	// Get online users for a TODO list or team.
+	// Get the online members of one of the caller's teams.
 	GetOnlineUsers(ctx context.Context, in *GetOnlineUsersRequest, opts ...grpc.CallOption) (*GetOnlineUsersResponse, error)
 	// Send heartbeat to indicate user is active.
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
@@ -123,7 +123,7 @@ type RealtimeServiceServer interface {
 	PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error)
 	// List recent activities.
 	ListActivities(context.Context, *ListActivitiesRequest) (*ListActivitiesResponse, error)
-	// Get online users for a TODO list or team.
+	// Get the online members of one of the caller's teams.
 	GetOnlineUsers(context.Context, *GetOnlineUsersRequest) (*GetOnlineUsersResponse, error)
 	// Send heartbeat to indicate user is active.
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: todo/v1/reminder.proto
+
+package todov1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Reminder notifies its owner about a TODO once. Fired reminders are
+// delivered as "reminder" notifications over the WebSocket connection.
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TodoId        string                 `protobuf:"bytes,2,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BeforeDue     *durationpb.Duration   `protobuf:"bytes,4,opt,name=before_due,json=beforeDue,proto3" json:"before_due,omitempty"` // Set for reminders relative to the due date
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`    // When the reminder fires; unset while the TODO has no due date
+	FiredAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	DismissedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dismissed_at,json=dismissedAt,proto3" json:"dismissed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reminder) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+func (x *Reminder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Reminder) GetBeforeDue() *durationpb.Duration {
+	if x != nil {
+		return x.BeforeDue
+	}
+	return nil
+}
+
+func (x *Reminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiredAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetDismissedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DismissedAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateReminderRequest attaches a reminder to a TODO.
+type CreateReminderRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TodoId string                 `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	// Types that are valid to be assigned to Trigger:
+	//
+	//	*CreateReminderRequest_RemindAt
+	//	*CreateReminderRequest_BeforeDue
+	Trigger       isCreateReminderRequest_Trigger `protobuf_oneof:"trigger"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderRequest) Reset() {
+	*x = CreateReminderRequest{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderRequest) ProtoMessage() {}
+
+func (x *CreateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderRequest.ProtoReflect.Descriptor instead.
+func (*CreateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateReminderRequest) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetTrigger() isCreateReminderRequest_Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Trigger.(*CreateReminderRequest_RemindAt); ok {
+			return x.RemindAt
+		}
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetBeforeDue() *durationpb.Duration {
+	if x != nil {
+		if x, ok := x.Trigger.(*CreateReminderRequest_BeforeDue); ok {
+			return x.BeforeDue
+		}
+	}
+	return nil
+}
+
+type isCreateReminderRequest_Trigger interface {
+	isCreateReminderRequest_Trigger()
+}
+
+type CreateReminderRequest_RemindAt struct {
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3,oneof"` // Fire at a fixed time
+}
+
+type CreateReminderRequest_BeforeDue struct {
+	BeforeDue *durationpb.Duration `protobuf:"bytes,3,opt,name=before_due,json=beforeDue,proto3,oneof"` // Fire this long before the TODO is due, e.g. "3600s"
+}
+
+func (*CreateReminderRequest_RemindAt) isCreateReminderRequest_Trigger() {}
+
+func (*CreateReminderRequest_BeforeDue) isCreateReminderRequest_Trigger() {}
+
+// CreateReminderResponse contains the created reminder.
+type CreateReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *Reminder              `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderResponse) Reset() {
+	*x = CreateReminderResponse{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderResponse) ProtoMessage() {}
+
+func (x *CreateReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderResponse.ProtoReflect.Descriptor instead.
+func (*CreateReminderResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+// ListRemindersRequest identifies the TODO whose reminders are listed.
+type ListRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TodoId        string                 `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemindersRequest) Reset() {
+	*x = ListRemindersRequest{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemindersRequest) ProtoMessage() {}
+
+func (x *ListRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ListRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRemindersRequest) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+// ListRemindersResponse contains the caller's reminders on a TODO.
+type ListRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemindersResponse) Reset() {
+	*x = ListRemindersResponse{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemindersResponse) ProtoMessage() {}
+
+func (x *ListRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ListRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+// SnoozeReminderRequest postpones a reminder.
+type SnoozeReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"` // Fire again this long from now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeReminderRequest) Reset() {
+	*x = SnoozeReminderRequest{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeReminderRequest) ProtoMessage() {}
+
+func (x *SnoozeReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeReminderRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeReminderRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SnoozeReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnoozeReminderRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// SnoozeReminderResponse contains the snoozed reminder.
+type SnoozeReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *Reminder              `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeReminderResponse) Reset() {
+	*x = SnoozeReminderResponse{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeReminderResponse) ProtoMessage() {}
+
+func (x *SnoozeReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeReminderResponse.ProtoReflect.Descriptor instead.
+func (*SnoozeReminderResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SnoozeReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+// DismissReminderRequest identifies the reminder to dismiss.
+type DismissReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissReminderRequest) Reset() {
+	*x = DismissReminderRequest{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissReminderRequest) ProtoMessage() {}
+
+func (x *DismissReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissReminderRequest.ProtoReflect.Descriptor instead.
+func (*DismissReminderRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DismissReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DismissReminderResponse contains the dismissed reminder.
+type DismissReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *Reminder              `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissReminderResponse) Reset() {
+	*x = DismissReminderResponse{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissReminderResponse) ProtoMessage() {}
+
+func (x *DismissReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissReminderResponse.ProtoReflect.Descriptor instead.
+func (*DismissReminderResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DismissReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+// DeleteReminderRequest identifies the reminder to delete.
+type DeleteReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderRequest) Reset() {
+	*x = DeleteReminderRequest{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderRequest) ProtoMessage() {}
+
+func (x *DeleteReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReminderRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteReminderResponse confirms the reminder was deleted.
+type DeleteReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderResponse) Reset() {
+	*x = DeleteReminderResponse{}
+	mi := &file_todo_v1_reminder_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderResponse) ProtoMessage() {}
+
+func (x *DeleteReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_reminder_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReminderResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_reminder_proto_rawDescGZIP(), []int{10}
+}
+
+var File_todo_v1_reminder_proto protoreflect.FileDescriptor
+
+const file_todo_v1_reminder_proto_rawDesc = "" +
+	"\n" +
+	"\x16todo/v1/reminder.proto\x12\atodo.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf0\x02\n" +
+	"\bReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atodo_id\x18\x02 \x01(\tR\x06todoId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x128\n" +
+	"\n" +
+	"before_due\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tbeforeDue\x127\n" +
+	"\tremind_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x125\n" +
+	"\bfired_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\afiredAt\x12=\n" +
+	"\fdismissed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vdismissedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb2\x01\n" +
+	"\x15CreateReminderRequest\x12\x17\n" +
+	"\atodo_id\x18\x01 \x01(\tR\x06todoId\x129\n" +
+	"\tremind_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bremindAt\x12:\n" +
+	"\n" +
+	"before_due\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x00R\tbeforeDueB\t\n" +
+	"\atrigger\"G\n" +
+	"\x16CreateReminderResponse\x12-\n" +
+	"\breminder\x18\x01 \x01(\v2\x11.todo.v1.ReminderR\breminder\"/\n" +
+	"\x14ListRemindersRequest\x12\x17\n" +
+	"\atodo_id\x18\x01 \x01(\tR\x06todoId\"H\n" +
+	"\x15ListRemindersResponse\x12/\n" +
+	"\treminders\x18\x01 \x03(\v2\x11.todo.v1.ReminderR\treminders\"^\n" +
+	"\x15SnoozeReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\"G\n" +
+	"\x16SnoozeReminderResponse\x12-\n" +
+	"\breminder\x18\x01 \x01(\v2\x11.todo.v1.ReminderR\breminder\"(\n" +
+	"\x16DismissReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x17DismissReminderResponse\x12-\n" +
+	"\breminder\x18\x01 \x01(\v2\x11.todo.v1.ReminderR\breminder\"'\n" +
+	"\x15DeleteReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteReminderResponseBA\n" +
+	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
+
+var (
+	file_todo_v1_reminder_proto_rawDescOnce sync.Once
+	file_todo_v1_reminder_proto_rawDescData []byte
+)
+
+func file_todo_v1_reminder_proto_rawDescGZIP() []byte {
+	file_todo_v1_reminder_proto_rawDescOnce.Do(func() {
+		file_todo_v1_reminder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_todo_v1_reminder_proto_rawDesc), len(file_todo_v1_reminder_proto_rawDesc)))
+	})
+	return file_todo_v1_reminder_proto_rawDescData
+}
+
+var file_todo_v1_reminder_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_todo_v1_reminder_proto_goTypes = []any{
+	(*Reminder)(nil),                // 0: todo.v1.Reminder
+	(*CreateReminderRequest)(nil),   // 1: todo.v1.CreateReminderRequest
+	(*CreateReminderResponse)(nil),  // 2: todo.v1.CreateReminderResponse
+	(*ListRemindersRequest)(nil),    // 3: todo.v1.ListRemindersRequest
+	(*ListRemindersResponse)(nil),   // 4: todo.v1.ListRemindersResponse
+	(*SnoozeReminderRequest)(nil),   // 5: todo.v1.SnoozeReminderRequest
+	(*SnoozeReminderResponse)(nil),  // 6: todo.v1.SnoozeReminderResponse
+	(*DismissReminderRequest)(nil),  // 7: todo.v1.DismissReminderRequest
+	(*DismissReminderResponse)(nil), // 8: todo.v1.DismissReminderResponse
+	(*DeleteReminderRequest)(nil),   // 9: todo.v1.DeleteReminderRequest
+	(*DeleteReminderResponse)(nil),  // 10: todo.v1.DeleteReminderResponse
+	(*durationpb.Duration)(nil),     // 11: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+}
+var file_todo_v1_reminder_proto_depIdxs = []int32{
+	11, // 0: todo.v1.Reminder.before_due:type_name -> google.protobuf.Duration
+	12, // 1: todo.v1.Reminder.remind_at:type_name -> google.protobuf.Timestamp
+	12, // 2: todo.v1.Reminder.fired_at:type_name -> google.protobuf.Timestamp
+	12, // 3: todo.v1.Reminder.dismissed_at:type_name -> google.protobuf.Timestamp
+	12, // 4: todo.v1.Reminder.created_at:type_name -> google.protobuf.Timestamp
+	12, // 5: todo.v1.CreateReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	11, // 6: todo.v1.CreateReminderRequest.before_due:type_name -> google.protobuf.Duration
+	0,  // 7: todo.v1.CreateReminderResponse.reminder:type_name -> todo.v1.Reminder
+	0,  // 8: todo.v1.ListRemindersResponse.reminders:type_name -> todo.v1.Reminder
+	11, // 9: todo.v1.SnoozeReminderRequest.duration:type_name -> google.protobuf.Duration
+	0,  // 10: todo.v1.SnoozeReminderResponse.reminder:type_name -> todo.v1.Reminder
+	0,  // 11: todo.v1.DismissReminderResponse.reminder:type_name -> todo.v1.Reminder
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_todo_v1_reminder_proto_init() }
+func file_todo_v1_reminder_proto_init() {
+	if File_todo_v1_reminder_proto != nil {
+		return
+	}
+	file_todo_v1_reminder_proto_msgTypes[1].OneofWrappers = []any{
+		(*CreateReminderRequest_RemindAt)(nil),
+		(*CreateReminderRequest_BeforeDue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_v1_reminder_proto_rawDesc), len(file_todo_v1_reminder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_todo_v1_reminder_proto_goTypes,
+		DependencyIndexes: file_todo_v1_reminder_proto_depIdxs,
+		MessageInfos:      file_todo_v1_reminder_proto_msgTypes,
+	}.Build()
+	File_todo_v1_reminder_proto = out.File
+	file_todo_v1_reminder_proto_goTypes = nil
+	file_todo_v1_reminder_proto_depIdxs = nil
+}
@@ -0,0 +1,89 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: todo/v1/reminder_service.proto
+
+package todov1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_todo_v1_reminder_service_proto protoreflect.FileDescriptor
+
+const file_todo_v1_reminder_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1etodo/v1/reminder_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x16todo/v1/reminder.proto2\xe7\x04\n" +
+	"\x0fReminderService\x12{\n" +
+	"\x0eCreateReminder\x12\x1e.todo.v1.CreateReminderRequest\x1a\x1f.todo.v1.CreateReminderResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/todos/{todo_id}/reminders\x12u\n" +
+	"\rListReminders\x12\x1d.todo.v1.ListRemindersRequest\x1a\x1e.todo.v1.ListRemindersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/todos/{todo_id}/reminders\x12w\n" +
+	"\x0eSnoozeReminder\x12\x1e.todo.v1.SnoozeReminderRequest\x1a\x1f.todo.v1.SnoozeReminderResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/reminders/{id}/snooze\x12x\n" +
+	"\x0fDismissReminder\x12\x1f.todo.v1.DismissReminderRequest\x1a .todo.v1.DismissReminderResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/reminders/{id}/dismiss\x12m\n" +
+	"\x0eDeleteReminder\x12\x1e.todo.v1.DeleteReminderRequest\x1a\x1f.todo.v1.DeleteReminderResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/reminders/{id}BA\n" +
+	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
+
+var file_todo_v1_reminder_service_proto_goTypes = []any{
+	(*CreateReminderRequest)(nil),   // 0: todo.v1.CreateReminderRequest
+	(*ListRemindersRequest)(nil),    // 1: todo.v1.ListRemindersRequest
+	(*SnoozeReminderRequest)(nil),   // 2: todo.v1.SnoozeReminderRequest
+	(*DismissReminderRequest)(nil),  // 3: todo.v1.DismissReminderRequest
+	(*DeleteReminderRequest)(nil),   // 4: todo.v1.DeleteReminderRequest
+	(*CreateReminderResponse)(nil),  // 5: todo.v1.CreateReminderResponse
+	(*ListRemindersResponse)(nil),   // 6: todo.v1.ListRemindersResponse
+	(*SnoozeReminderResponse)(nil),  // 7: todo.v1.SnoozeReminderResponse
+	(*DismissReminderResponse)(nil), // 8: todo.v1.DismissReminderResponse
+	(*DeleteReminderResponse)(nil),  // 9: todo.v1.DeleteReminderResponse
+}
+var file_todo_v1_reminder_service_proto_depIdxs = []int32{
+	0, // 0: todo.v1.ReminderService.CreateReminder:input_type -> todo.v1.CreateReminderRequest
+	1, // 1: todo.v1.ReminderService.ListReminders:input_type -> todo.v1.ListRemindersRequest
+	2, // 2: todo.v1.ReminderService.SnoozeReminder:input_type -> todo.v1.SnoozeReminderRequest
+	3, // 3: todo.v1.ReminderService.DismissReminder:input_type -> todo.v1.DismissReminderRequest
+	4, // 4: todo.v1.ReminderService.DeleteReminder:input_type -> todo.v1.DeleteReminderRequest
+	5, // 5: todo.v1.ReminderService.CreateReminder:output_type -> todo.v1.CreateReminderResponse
+	6, // 6: todo.v1.ReminderService.ListReminders:output_type -> todo.v1.ListRemindersResponse
+	7, // 7: todo.v1.ReminderService.SnoozeReminder:output_type -> todo.v1.SnoozeReminderResponse
+	8, // 8: todo.v1.ReminderService.DismissReminder:output_type -> todo.v1.DismissReminderResponse
+	9, // 9: todo.v1.ReminderService.DeleteReminder:output_type -> todo.v1.DeleteReminderResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_todo_v1_reminder_service_proto_init() }
+func file_todo_v1_reminder_service_proto_init() {
+	if File_todo_v1_reminder_service_proto != nil {
+		return
+	}
+	file_todo_v1_reminder_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_v1_reminder_service_proto_rawDesc), len(file_todo_v1_reminder_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_todo_v1_reminder_service_proto_goTypes,
+		DependencyIndexes: file_todo_v1_reminder_service_proto_depIdxs,
+	}.Build()
+	File_todo_v1_reminder_service_proto = out.File
+	file_todo_v1_reminder_service_proto_goTypes = nil
+	file_todo_v1_reminder_service_proto_depIdxs = nil
+}
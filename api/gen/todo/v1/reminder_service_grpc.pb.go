@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: todo/v1/reminder_service.proto
+
+package todov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReminderService_CreateReminder_FullMethodName  = "/todo.v1.ReminderService/CreateReminder"
+	ReminderService_ListReminders_FullMethodName   = "/todo.v1.ReminderService/ListReminders"
+	ReminderService_SnoozeReminder_FullMethodName  = "/todo.v1.ReminderService/SnoozeReminder"
+	ReminderService_DismissReminder_FullMethodName = "/todo.v1.ReminderService/DismissReminder"
+	ReminderService_DeleteReminder_FullMethodName  = "/todo.v1.ReminderService/DeleteReminder"
+)
+
+// ReminderServiceClient is the client API for ReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReminderService manages the caller's reminders on TODO items.
+type ReminderServiceClient interface {
+	// Attach a reminder to a TODO item.
+	CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*CreateReminderResponse, error)
+	// List the caller's reminders on a TODO item.
+	ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error)
+	// Make a reminder fire again later.
+	SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*SnoozeReminderResponse, error)
+	// Stop a reminder from firing.
+	DismissReminder(ctx context.Context, in *DismissReminderRequest, opts ...grpc.CallOption) (*DismissReminderResponse, error)
+	// Delete a reminder.
+	DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*DeleteReminderResponse, error)
+}
+
+type reminderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReminderServiceClient(cc grpc.ClientConnInterface) ReminderServiceClient {
+	return &reminderServiceClient{cc}
+}
+
+func (c *reminderServiceClient) CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*CreateReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReminderResponse)
+	err := c.cc.Invoke(ctx, ReminderService_CreateReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRemindersResponse)
+	err := c.cc.Invoke(ctx, ReminderService_ListReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*SnoozeReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeReminderResponse)
+	err := c.cc.Invoke(ctx, ReminderService_SnoozeReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) DismissReminder(ctx context.Context, in *DismissReminderRequest, opts ...grpc.CallOption) (*DismissReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissReminderResponse)
+	err := c.cc.Invoke(ctx, ReminderService_DismissReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*DeleteReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReminderResponse)
+	err := c.cc.Invoke(ctx, ReminderService_DeleteReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReminderServiceServer is the server API for ReminderService service.
+// All implementations should embed UnimplementedReminderServiceServer
+// for forward compatibility.
+//
+// ReminderService manages the caller's reminders on TODO items.
+type ReminderServiceServer interface {
+	// Attach a reminder to a TODO item.
+	CreateReminder(context.Context, *CreateReminderRequest) (*CreateReminderResponse, error)
+	// List the caller's reminders on a TODO item.
+	ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error)
+	// Make a reminder fire again later.
+	SnoozeReminder(context.Context, *SnoozeReminderRequest) (*SnoozeReminderResponse, error)
+	// Stop a reminder from firing.
+	DismissReminder(context.Context, *DismissReminderRequest) (*DismissReminderResponse, error)
+	// Delete a reminder.
+	DeleteReminder(context.Context, *DeleteReminderRequest) (*DeleteReminderResponse, error)
+}
+
+// UnimplementedReminderServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReminderServiceServer struct{}
+
+func (UnimplementedReminderServiceServer) CreateReminder(context.Context, *CreateReminderRequest) (*CreateReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReminders not implemented")
+}
+func (UnimplementedReminderServiceServer) SnoozeReminder(context.Context, *SnoozeReminderRequest) (*SnoozeReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnoozeReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) DismissReminder(context.Context, *DismissReminderRequest) (*DismissReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DismissReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) DeleteReminder(context.Context, *DeleteReminderRequest) (*DeleteReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) testEmbeddedByValue() {}
+
+// UnsafeReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReminderServiceServer will
+// result in compilation errors.
+type UnsafeReminderServiceServer interface {
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+func RegisterReminderServiceServer(s grpc.ServiceRegistrar, srv ReminderServiceServer) {
+	// If the following call panics, it indicates UnimplementedReminderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReminderService_ServiceDesc, srv)
+}
+
+func _ReminderService_CreateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).CreateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_CreateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).CreateReminder(ctx, req.(*CreateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_ListReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).ListReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_ListReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).ListReminders(ctx, req.(*ListRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_SnoozeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).SnoozeReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_SnoozeReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).SnoozeReminder(ctx, req.(*SnoozeReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_DismissReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).DismissReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_DismissReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).DismissReminder(ctx, req.(*DismissReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_DeleteReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_DeleteReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, req.(*DeleteReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReminderService_ServiceDesc is the grpc.ServiceDesc for ReminderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "todo.v1.ReminderService",
+	HandlerType: (*ReminderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReminder",
+			Handler:    _ReminderService_CreateReminder_Handler,
+		},
+		{
+			MethodName: "ListReminders",
+			Handler:    _ReminderService_ListReminders_Handler,
+		},
+		{
+			MethodName: "SnoozeReminder",
+			Handler:    _ReminderService_SnoozeReminder_Handler,
+		},
+		{
+			MethodName: "DismissReminder",
+			Handler:    _ReminderService_DismissReminder_Handler,
+		},
+		{
+			MethodName: "DeleteReminder",
+			Handler:    _ReminderService_DeleteReminder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "todo/v1/reminder_service.proto",
+}
@@ -10,6 +10,8 @@ import (
 	v1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -41,8 +43,15 @@ type TODO struct {
 	AssignedTo       string                 `protobuf:"bytes,13,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"` // User ID of assignee
 	ParentId         string                 `protobuf:"bytes,14,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`       // Parent TODO ID for subtasks
 	Position         int32                  `protobuf:"varint,15,opt,name=position,proto3" json:"position,omitempty"`                      // Position in list (for manual ordering)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// RFC 5545 recurrence rule, e.g. "FREQ=WEEKLY;BYDAY=MO". Only the open
+	// occurrence of a series carries the rule; empty if the TODO does not recur.
+	RecurrenceRule string                 `protobuf:"bytes,16,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	Occurrence     int32                  `protobuf:"varint,17,opt,name=occurrence,proto3" json:"occurrence,omitempty"`               // Number of this occurrence in its series, starting at 1
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Set while the TODO is in the trash
+	OrderKey       string                 `protobuf:"bytes,19,opt,name=order_key,json=orderKey,proto3" json:"order_key,omitempty"`    // Sorts the TODO among its siblings, byte by byte
+	Version        int64                  `protobuf:"varint,20,opt,name=version,proto3" json:"version,omitempty"`                     // Incremented by every change; also sent as the ETag header
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TODO) Reset() {
@@ -180,6 +189,41 @@ func (x *TODO) GetPosition() int32 {
 	return 0
 }
 
+func (x *TODO) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *TODO) GetOccurrence() int32 {
+	if x != nil {
+		return x.Occurrence
+	}
+	return 0
+}
+
+func (x *TODO) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TODO) GetOrderKey() string {
+	if x != nil {
+		return x.OrderKey
+	}
+	return ""
+}
+
+func (x *TODO) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateTODORequest contains data for creating a new TODO.
 type CreateTODORequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -192,8 +236,11 @@ type CreateTODORequest struct {
 	MediaAttachments []*MediaAttachment     `protobuf:"bytes,7,rep,name=media_attachments,json=mediaAttachments,proto3" json:"media_attachments,omitempty"`
 	AssignedTo       *string                `protobuf:"bytes,8,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`
 	ParentId         *string                `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// RFC 5545 recurrence rule supporting FREQ (DAILY, WEEKLY, MONTHLY,
+	// YEARLY), INTERVAL, BYDAY, COUNT and UNTIL. Requires a due date.
+	RecurrenceRule *string `protobuf:"bytes,10,opt,name=recurrence_rule,json=recurrenceRule,proto3,oneof" json:"recurrence_rule,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateTODORequest) Reset() {
@@ -289,6 +336,13 @@ func (x *CreateTODORequest) GetParentId() string {
 	return ""
 }
 
+func (x *CreateTODORequest) GetRecurrenceRule() string {
+	if x != nil && x.RecurrenceRule != nil {
+		return *x.RecurrenceRule
+	}
+	return ""
+}
+
 // UpdateTODORequest contains data for updating an existing TODO.
 type UpdateTODORequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -303,6 +357,9 @@ type UpdateTODORequest struct {
 	AssignedTo       *string                `protobuf:"bytes,9,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`
 	ParentId         *string                `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 	Position         *int32                 `protobuf:"varint,11,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	RecurrenceRule   *string                `protobuf:"bytes,12,opt,name=recurrence_rule,json=recurrenceRule,proto3,oneof" json:"recurrence_rule,omitempty"` // Empty to end the series
+	Force            bool                   `protobuf:"varint,13,opt,name=force,proto3" json:"force,omitempty"`                                              // Start or complete the TODO even if it has unfinished blockers
+	ExpectedVersion  int64                  `protobuf:"varint,14,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`   // Fail with ABORTED unless the TODO is at this version; also read from If-Match
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -414,6 +471,27 @@ func (x *UpdateTODORequest) GetPosition() int32 {
 	return 0
 }
 
+func (x *UpdateTODORequest) GetRecurrenceRule() string {
+	if x != nil && x.RecurrenceRule != nil {
+		return *x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *UpdateTODORequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *UpdateTODORequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // GetTODORequest contains TODO ID.
 type GetTODORequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -518,6 +596,7 @@ type ListTODOsRequest struct {
 	SearchQuery   *string                `protobuf:"bytes,9,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`      // Full-text search
 	SortOptions   []*v1.SortOption       `protobuf:"bytes,10,rep,name=sort_options,json=sortOptions,proto3" json:"sort_options,omitempty"`           // Sorting criteria
 	Pagination    *v1.PaginationRequest  `protobuf:"bytes,11,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`                          // Pagination parameters
+	ReadyToStart  bool                   `protobuf:"varint,12,opt,name=ready_to_start,json=readyToStart,proto3" json:"ready_to_start,omitempty"`     // Only TODOs not started whose blockers are all completed or cancelled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -629,6 +708,13 @@ func (x *ListTODOsRequest) GetPagination() *v1.PaginationRequest {
 	return nil
 }
 
+func (x *ListTODOsRequest) GetReadyToStart() bool {
+	if x != nil {
+		return x.ReadyToStart
+	}
+	return false
+}
+
 // ListTODOsResponse contains TODO list and pagination info.
 type ListTODOsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -685,8 +771,9 @@ func (x *ListTODOsResponse) GetPagination() *v1.PaginationResponse {
 // BulkUpdateStatusRequest for updating status of multiple TODOs.
 type BulkUpdateStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // At most 100 TODOs
 	Status        v1.Status              `protobuf:"varint,2,opt,name=status,proto3,enum=common.v1.Status" json:"status,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"` // Start or complete TODOs even if they have unfinished blockers
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -735,10 +822,17 @@ func (x *BulkUpdateStatusRequest) GetStatus() v1.Status {
 	return v1.Status(0)
 }
 
+func (x *BulkUpdateStatusRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // BulkDeleteRequest for deleting multiple TODOs.
 type BulkDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // At most 100 TODOs
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -782,12 +876,13 @@ func (x *BulkDeleteRequest) GetIds() []string {
 
 // MoveTODORequest for changing TODO position or parent.
 type MoveTODORequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ParentId      *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // New parent ID (null for root)
-	Position      *int32                 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"`                // New position in list
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId        *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`                 // New parent ID (null for root)
+	Position        *int32                 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"`                                // New position in list
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Fail with ABORTED unless the TODO is at this version; also read from If-Match
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MoveTODORequest) Reset() {
@@ -841,6 +936,13 @@ func (x *MoveTODORequest) GetPosition() int32 {
 	return 0
 }
 
+func (x *MoveTODORequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // CreateTODOResponse contains created TODO item.
 type CreateTODOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -976,27 +1078,35 @@ func (x *UpdateTODOResponse) GetTodo() *TODO {
 	return nil
 }
 
-// DeleteTODOResponse confirms TODO deletion.
-type DeleteTODOResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// PatchTODORequest updates only the fields of a TODO named in update_mask:
+// title, description, status, priority, due_date, tags, assigned_to,
+// parent_id, position and recurrence_rule. Masked fields left unset in todo
+// are cleared. Through the gateway the mask defaults to the fields present in
+// the PATCH body, so sending null clears a field.
+type PatchTODORequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Todo            *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"` // Carries the ID of the TODO and the new field values
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Force           bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                                            // Start or complete the TODO even if it has unfinished blockers
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Fail with ABORTED unless the TODO is at this version; also read from If-Match
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteTODOResponse) Reset() {
-	*x = DeleteTODOResponse{}
+func (x *PatchTODORequest) Reset() {
+	*x = PatchTODORequest{}
 	mi := &file_todo_v1_todo_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTODOResponse) String() string {
+func (x *PatchTODORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTODOResponse) ProtoMessage() {}
+func (*PatchTODORequest) ProtoMessage() {}
 
-func (x *DeleteTODOResponse) ProtoReflect() protoreflect.Message {
+func (x *PatchTODORequest) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_v1_todo_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1008,32 +1118,61 @@ func (x *DeleteTODOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTODOResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTODOResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PatchTODORequest.ProtoReflect.Descriptor instead.
+func (*PatchTODORequest) Descriptor() ([]byte, []int) {
 	return file_todo_v1_todo_proto_rawDescGZIP(), []int{13}
 }
 
-// BulkUpdateStatusResponse confirms bulk status update.
-type BulkUpdateStatusResponse struct {
+func (x *PatchTODORequest) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *PatchTODORequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchTODORequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *PatchTODORequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// PatchTODOResponse contains the updated TODO item.
+type PatchTODOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateStatusResponse) Reset() {
-	*x = BulkUpdateStatusResponse{}
+func (x *PatchTODOResponse) Reset() {
+	*x = PatchTODOResponse{}
 	mi := &file_todo_v1_todo_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateStatusResponse) String() string {
+func (x *PatchTODOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateStatusResponse) ProtoMessage() {}
+func (*PatchTODOResponse) ProtoMessage() {}
 
-func (x *BulkUpdateStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *PatchTODOResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_v1_todo_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1045,32 +1184,39 @@ func (x *BulkUpdateStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateStatusResponse.ProtoReflect.Descriptor instead.
-func (*BulkUpdateStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PatchTODOResponse.ProtoReflect.Descriptor instead.
+func (*PatchTODOResponse) Descriptor() ([]byte, []int) {
 	return file_todo_v1_todo_proto_rawDescGZIP(), []int{14}
 }
 
-// BulkDeleteResponse confirms bulk deletion.
-type BulkDeleteResponse struct {
+func (x *PatchTODOResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// DeleteTODOResponse confirms TODO deletion.
+type DeleteTODOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkDeleteResponse) Reset() {
-	*x = BulkDeleteResponse{}
+func (x *DeleteTODOResponse) Reset() {
+	*x = DeleteTODOResponse{}
 	mi := &file_todo_v1_todo_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkDeleteResponse) String() string {
+func (x *DeleteTODOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkDeleteResponse) ProtoMessage() {}
+func (*DeleteTODOResponse) ProtoMessage() {}
 
-func (x *BulkDeleteResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTODOResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_v1_todo_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,33 +1228,36 @@ func (x *BulkDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkDeleteResponse.ProtoReflect.Descriptor instead.
-func (*BulkDeleteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTODOResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTODOResponse) Descriptor() ([]byte, []int) {
 	return file_todo_v1_todo_proto_rawDescGZIP(), []int{15}
 }
 
-// MoveTODOResponse contains moved TODO item.
-type MoveTODOResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// BulkUpdateStatusResponse contains the outcome for each TODO, in the order
+// of the request's IDs.
+type BulkUpdateStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*BulkUpdateResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SucceededCount int32                  `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount    int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *MoveTODOResponse) Reset() {
-	*x = MoveTODOResponse{}
+func (x *BulkUpdateStatusResponse) Reset() {
+	*x = BulkUpdateStatusResponse{}
 	mi := &file_todo_v1_todo_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveTODOResponse) String() string {
+func (x *BulkUpdateStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveTODOResponse) ProtoMessage() {}
+func (*BulkUpdateStatusResponse) ProtoMessage() {}
 
-func (x *MoveTODOResponse) ProtoReflect() protoreflect.Message {
+func (x *BulkUpdateStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_v1_todo_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1120,40 +1269,57 @@ func (x *MoveTODOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveTODOResponse.ProtoReflect.Descriptor instead.
-func (*MoveTODOResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkUpdateStatusResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateStatusResponse) Descriptor() ([]byte, []int) {
 	return file_todo_v1_todo_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MoveTODOResponse) GetTodo() *TODO {
+func (x *BulkUpdateStatusResponse) GetResults() []*BulkUpdateResult {
 	if x != nil {
-		return x.Todo
+		return x.Results
 	}
 	return nil
 }
 
-// CompleteTODORequest requests TODO completion.
-type CompleteTODORequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BulkUpdateStatusResponse) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
 }
 
-func (x *CompleteTODORequest) Reset() {
-	*x = CompleteTODORequest{}
+func (x *BulkUpdateStatusResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// BulkDeleteResponse contains the outcome for each TODO, in the order of the
+// request's IDs.
+type BulkDeleteResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*BulkUpdateResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SucceededCount int32                  `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount    int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkDeleteResponse) Reset() {
+	*x = BulkDeleteResponse{}
 	mi := &file_todo_v1_todo_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTODORequest) String() string {
+func (x *BulkDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTODORequest) ProtoMessage() {}
+func (*BulkDeleteResponse) ProtoMessage() {}
 
-func (x *CompleteTODORequest) ProtoReflect() protoreflect.Message {
+func (x *BulkDeleteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_v1_todo_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,40 +1331,67 @@ func (x *CompleteTODORequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTODORequest.ProtoReflect.Descriptor instead.
-func (*CompleteTODORequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BulkDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_todo_v1_todo_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CompleteTODORequest) GetId() string {
+func (x *BulkDeleteResponse) GetResults() []*BulkUpdateResult {
 	if x != nil {
-		return x.Id
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-// CompleteTODOResponse contains completed TODO item.
-type CompleteTODOResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+func (x *BulkDeleteResponse) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *BulkDeleteResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// BulkUpdateTODOsRequest makes the same changes to several TODOs. Unset
+// fields leave the TODOs unchanged.
+type BulkUpdateTODOsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Ids          []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // At most 100 TODOs
+	Status       *v1.Status             `protobuf:"varint,2,opt,name=status,proto3,enum=common.v1.Status,oneof" json:"status,omitempty"`
+	Priority     *v1.Priority           `protobuf:"varint,3,opt,name=priority,proto3,enum=common.v1.Priority,oneof" json:"priority,omitempty"`
+	AddTags      []string               `protobuf:"bytes,4,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	RemoveTags   []string               `protobuf:"bytes,5,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`       // Removed before add_tags are added
+	AssignedTo   *string                `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"` // Empty to unassign the TODOs
+	DueDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	ClearDueDate bool                   `protobuf:"varint,8,opt,name=clear_due_date,json=clearDueDate,proto3" json:"clear_due_date,omitempty"`
+	ParentId     *string                `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // Empty to make the TODOs top-level
+	// Update all of the TODOs or none of them. Otherwise each TODO is updated
+	// on its own, even if others fail.
+	Transactional bool `protobuf:"varint,10,opt,name=transactional,proto3" json:"transactional,omitempty"`
+	Force         bool `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"` // Start or complete TODOs even if they have unfinished blockers
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTODOResponse) Reset() {
-	*x = CompleteTODOResponse{}
+func (x *BulkUpdateTODOsRequest) Reset() {
+	*x = BulkUpdateTODOsRequest{}
 	mi := &file_todo_v1_todo_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTODOResponse) String() string {
+func (x *BulkUpdateTODOsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTODOResponse) ProtoMessage() {}
+func (*BulkUpdateTODOsRequest) ProtoMessage() {}
 
-func (x *CompleteTODOResponse) ProtoReflect() protoreflect.Message {
+func (x *BulkUpdateTODOsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_todo_v1_todo_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,86 +1403,1644 @@ func (x *CompleteTODOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTODOResponse.ProtoReflect.Descriptor instead.
-func (*CompleteTODOResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkUpdateTODOsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTODOsRequest) Descriptor() ([]byte, []int) {
 	return file_todo_v1_todo_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CompleteTODOResponse) GetTodo() *TODO {
+func (x *BulkUpdateTODOsRequest) GetIds() []string {
 	if x != nil {
-		return x.Todo
+		return x.Ids
 	}
 	return nil
 }
 
-// ReopenTODORequest requests TODO reopening.
-type ReopenTODORequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BulkUpdateTODOsRequest) GetStatus() v1.Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return v1.Status(0)
 }
 
-func (x *ReopenTODORequest) Reset() {
-	*x = ReopenTODORequest{}
-	mi := &file_todo_v1_todo_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BulkUpdateTODOsRequest) GetPriority() v1.Priority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return v1.Priority(0)
 }
 
-func (x *ReopenTODORequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BulkUpdateTODOsRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
 }
 
-func (*ReopenTODORequest) ProtoMessage() {}
-
-func (x *ReopenTODORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_todo_proto_msgTypes[19]
+func (x *BulkUpdateTODOsRequest) GetRemoveTags() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RemoveTags
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ReopenTODORequest.ProtoReflect.Descriptor instead.
-func (*ReopenTODORequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_todo_proto_rawDescGZIP(), []int{19}
+func (x *BulkUpdateTODOsRequest) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
 }
 
-func (x *ReopenTODORequest) GetId() string {
+func (x *BulkUpdateTODOsRequest) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.DueDate
 	}
-	return ""
+	return nil
+}
+
+func (x *BulkUpdateTODOsRequest) GetClearDueDate() bool {
+	if x != nil {
+		return x.ClearDueDate
+	}
+	return false
+}
+
+func (x *BulkUpdateTODOsRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *BulkUpdateTODOsRequest) GetTransactional() bool {
+	if x != nil {
+		return x.Transactional
+	}
+	return false
+}
+
+func (x *BulkUpdateTODOsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// BulkUpdateResult is the outcome of a bulk update for one TODO.
+type BulkUpdateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Todo          *TODO                  `protobuf:"bytes,3,opt,name=todo,proto3" json:"todo,omitempty"`   // The updated TODO, if successful; unset for deleted TODOs
+	Error         *v1.Error              `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // Why the TODO was not updated, if unsuccessful
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateResult) Reset() {
+	*x = BulkUpdateResult{}
+	mi := &file_todo_v1_todo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateResult) ProtoMessage() {}
+
+func (x *BulkUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateResult.ProtoReflect.Descriptor instead.
+func (*BulkUpdateResult) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BulkUpdateResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkUpdateResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkUpdateResult) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *BulkUpdateResult) GetError() *v1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// BulkUpdateTODOsResponse contains the outcome for each TODO, in the order
+// of the request's IDs.
+type BulkUpdateTODOsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*BulkUpdateResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SucceededCount int32                  `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount    int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkUpdateTODOsResponse) Reset() {
+	*x = BulkUpdateTODOsResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateTODOsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateTODOsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateTODOsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateTODOsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTODOsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BulkUpdateTODOsResponse) GetResults() []*BulkUpdateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateTODOsResponse) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateTODOsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// ReorderTODORequest places a TODO just before or just after another TODO,
+// moving it into that TODO's list if needed.
+type ReorderTODORequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Anchor:
+	//
+	//	*ReorderTODORequest_BeforeId
+	//	*ReorderTODORequest_AfterId
+	Anchor        isReorderTODORequest_Anchor `protobuf_oneof:"anchor"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderTODORequest) Reset() {
+	*x = ReorderTODORequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderTODORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderTODORequest) ProtoMessage() {}
+
+func (x *ReorderTODORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderTODORequest.ProtoReflect.Descriptor instead.
+func (*ReorderTODORequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReorderTODORequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReorderTODORequest) GetAnchor() isReorderTODORequest_Anchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *ReorderTODORequest) GetBeforeId() string {
+	if x != nil {
+		if x, ok := x.Anchor.(*ReorderTODORequest_BeforeId); ok {
+			return x.BeforeId
+		}
+	}
+	return ""
+}
+
+func (x *ReorderTODORequest) GetAfterId() string {
+	if x != nil {
+		if x, ok := x.Anchor.(*ReorderTODORequest_AfterId); ok {
+			return x.AfterId
+		}
+	}
+	return ""
+}
+
+type isReorderTODORequest_Anchor interface {
+	isReorderTODORequest_Anchor()
+}
+
+type ReorderTODORequest_BeforeId struct {
+	BeforeId string `protobuf:"bytes,2,opt,name=before_id,json=beforeId,proto3,oneof"`
+}
+
+type ReorderTODORequest_AfterId struct {
+	AfterId string `protobuf:"bytes,3,opt,name=after_id,json=afterId,proto3,oneof"`
+}
+
+func (*ReorderTODORequest_BeforeId) isReorderTODORequest_Anchor() {}
+
+func (*ReorderTODORequest_AfterId) isReorderTODORequest_Anchor() {}
+
+// ReorderTODOResponse contains the reordered TODO item.
+type ReorderTODOResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderTODOResponse) Reset() {
+	*x = ReorderTODOResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderTODOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderTODOResponse) ProtoMessage() {}
+
+func (x *ReorderTODOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderTODOResponse.ProtoReflect.Descriptor instead.
+func (*ReorderTODOResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReorderTODOResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// MoveTODOResponse contains moved TODO item.
+type MoveTODOResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTODOResponse) Reset() {
+	*x = MoveTODOResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTODOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTODOResponse) ProtoMessage() {}
+
+func (x *MoveTODOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTODOResponse.ProtoReflect.Descriptor instead.
+func (*MoveTODOResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MoveTODOResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// CompleteTODORequest requests TODO completion.
+type CompleteTODORequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force           bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                                            // Complete the TODO even if it has unfinished blockers
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Fail with ABORTED unless the TODO is at this version; also read from If-Match
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompleteTODORequest) Reset() {
+	*x = CompleteTODORequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTODORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTODORequest) ProtoMessage() {}
+
+func (x *CompleteTODORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTODORequest.ProtoReflect.Descriptor instead.
+func (*CompleteTODORequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CompleteTODORequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTODORequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *CompleteTODORequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// CompleteTODOResponse contains completed TODO item.
+type CompleteTODOResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Todo  *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	// Next occurrence of a recurring TODO; unset if the series has ended.
+	NextOccurrence *TODO `protobuf:"bytes,2,opt,name=next_occurrence,json=nextOccurrence,proto3" json:"next_occurrence,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompleteTODOResponse) Reset() {
+	*x = CompleteTODOResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTODOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTODOResponse) ProtoMessage() {}
+
+func (x *CompleteTODOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTODOResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTODOResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CompleteTODOResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *CompleteTODOResponse) GetNextOccurrence() *TODO {
+	if x != nil {
+		return x.NextOccurrence
+	}
+	return nil
+}
+
+// ReopenTODORequest requests TODO reopening.
+type ReopenTODORequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Fail with ABORTED unless the TODO is at this version; also read from If-Match
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReopenTODORequest) Reset() {
+	*x = ReopenTODORequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTODORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTODORequest) ProtoMessage() {}
+
+func (x *ReopenTODORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTODORequest.ProtoReflect.Descriptor instead.
+func (*ReopenTODORequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReopenTODORequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReopenTODORequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
 }
 
 // ReopenTODOResponse contains reopened TODO item.
 type ReopenTODOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTODOResponse) Reset() {
+	*x = ReopenTODOResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTODOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTODOResponse) ProtoMessage() {}
+
+func (x *ReopenTODOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTODOResponse.ProtoReflect.Descriptor instead.
+func (*ReopenTODOResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReopenTODOResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// SkipOccurrenceRequest requests skipping the current occurrence of a recurring TODO.
+type SkipOccurrenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipOccurrenceRequest) Reset() {
+	*x = SkipOccurrenceRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipOccurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipOccurrenceRequest) ProtoMessage() {}
+
+func (x *SkipOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SkipOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SkipOccurrenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SkipOccurrenceResponse contains the TODO moved on to its next occurrence.
+type SkipOccurrenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipOccurrenceResponse) Reset() {
+	*x = SkipOccurrenceResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipOccurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipOccurrenceResponse) ProtoMessage() {}
+
+func (x *SkipOccurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipOccurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SkipOccurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SkipOccurrenceResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// EndRecurrenceRequest requests ending the series of a recurring TODO.
+type EndRecurrenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndRecurrenceRequest) Reset() {
+	*x = EndRecurrenceRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndRecurrenceRequest) ProtoMessage() {}
+
+func (x *EndRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*EndRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EndRecurrenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// EndRecurrenceResponse contains the TODO, which no longer recurs.
+type EndRecurrenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndRecurrenceResponse) Reset() {
+	*x = EndRecurrenceResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndRecurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndRecurrenceResponse) ProtoMessage() {}
+
+func (x *EndRecurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndRecurrenceResponse.ProtoReflect.Descriptor instead.
+func (*EndRecurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EndRecurrenceResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// TODORevision is the state of a TODO after one change.
+type TODORevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TodoId        string                 `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`             // Revisions of a TODO are numbered from 1
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // User who made the change; empty once deleted
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                  // created, updated, moved, completed, reopened, occurrence_skipped, recurrence_ended or restored
+	ChangedFields []string               `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Snapshot      *TODO                  `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // Fields of the TODO after the change
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TODORevision) Reset() {
+	*x = TODORevision{}
+	mi := &file_todo_v1_todo_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TODORevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TODORevision) ProtoMessage() {}
+
+func (x *TODORevision) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TODORevision.ProtoReflect.Descriptor instead.
+func (*TODORevision) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TODORevision) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+func (x *TODORevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TODORevision) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *TODORevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TODORevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *TODORevision) GetSnapshot() *TODO {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *TODORevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// FieldChange is the change of one field of a TODO between two revisions.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      *structpb.Value        `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // Null if the field was unset
+	NewValue      *structpb.Value        `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // Null if the field is unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_todo_v1_todo_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() *structpb.Value {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *FieldChange) GetNewValue() *structpb.Value {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+// ListTODORevisionsRequest requests the revision history of a TODO.
+type ListTODORevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTODORevisionsRequest) Reset() {
+	*x = ListTODORevisionsRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTODORevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTODORevisionsRequest) ProtoMessage() {}
+
+func (x *ListTODORevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTODORevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTODORevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListTODORevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListTODORevisionsRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// ListTODORevisionsResponse contains revisions, newest first.
+type ListTODORevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TODORevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTODORevisionsResponse) Reset() {
+	*x = ListTODORevisionsResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTODORevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTODORevisionsResponse) ProtoMessage() {}
+
+func (x *ListTODORevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTODORevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTODORevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTODORevisionsResponse) GetRevisions() []*TODORevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListTODORevisionsResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// DiffTODORevisionsRequest requests the changes between two revisions.
+type DiffTODORevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromRevision  int32                  `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    int32                  `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffTODORevisionsRequest) Reset() {
+	*x = DiffTODORevisionsRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffTODORevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffTODORevisionsRequest) ProtoMessage() {}
+
+func (x *DiffTODORevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffTODORevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffTODORevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiffTODORevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffTODORevisionsRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffTODORevisionsRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+// DiffTODORevisionsResponse contains the fields that differ.
+type DiffTODORevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*FieldChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffTODORevisionsResponse) Reset() {
+	*x = DiffTODORevisionsResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffTODORevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffTODORevisionsResponse) ProtoMessage() {}
+
+func (x *DiffTODORevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffTODORevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffTODORevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DiffTODORevisionsResponse) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// RestoreTODORevisionRequest requests setting a TODO back to a revision.
+type RestoreTODORevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTODORevisionRequest) Reset() {
+	*x = RestoreTODORevisionRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTODORevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTODORevisionRequest) ProtoMessage() {}
+
+func (x *RestoreTODORevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTODORevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTODORevisionRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreTODORevisionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTODORevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// RestoreTODORevisionResponse contains the restored TODO.
+type RestoreTODORevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTODORevisionResponse) Reset() {
+	*x = RestoreTODORevisionResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTODORevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTODORevisionResponse) ProtoMessage() {}
+
+func (x *RestoreTODORevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTODORevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTODORevisionResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreTODORevisionResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// ListTrashRequest requests the caller's TODOs in the trash.
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTrashRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// ListTrashResponse contains TODOs in the trash, most recently deleted first.
+// Subtasks deleted together with their parent are not listed separately.
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todos         []*TODO                `protobuf:"bytes,1,rep,name=todos,proto3" json:"todos,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListTrashResponse) GetTodos() []*TODO {
+	if x != nil {
+		return x.Todos
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// RestoreTODORequest requests taking a TODO out of the trash.
+type RestoreTODORequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTODORequest) Reset() {
+	*x = RestoreTODORequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTODORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTODORequest) ProtoMessage() {}
+
+func (x *RestoreTODORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTODORequest.ProtoReflect.Descriptor instead.
+func (*RestoreTODORequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RestoreTODORequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RestoreTODOResponse contains the restored TODO.
+type RestoreTODOResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTODOResponse) Reset() {
+	*x = RestoreTODOResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTODOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTODOResponse) ProtoMessage() {}
+
+func (x *RestoreTODOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTODOResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTODOResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RestoreTODOResponse) GetTodo() *TODO {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+// PurgeTODORequest requests permanently deleting a TODO in the trash.
+type PurgeTODORequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTODORequest) Reset() {
+	*x = PurgeTODORequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTODORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTODORequest) ProtoMessage() {}
+
+func (x *PurgeTODORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTODORequest.ProtoReflect.Descriptor instead.
+func (*PurgeTODORequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PurgeTODORequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PurgeTODOResponse is empty on success.
+type PurgeTODOResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTODOResponse) Reset() {
+	*x = PurgeTODOResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTODOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTODOResponse) ProtoMessage() {}
+
+func (x *PurgeTODOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTODOResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTODOResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{45}
+}
+
+// AddTODODependencyRequest requests that a TODO be blocked by another TODO.
+type AddTODODependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlockedById   string                 `protobuf:"bytes,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTODODependencyRequest) Reset() {
+	*x = AddTODODependencyRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTODODependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTODODependencyRequest) ProtoMessage() {}
+
+func (x *AddTODODependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTODODependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddTODODependencyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddTODODependencyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddTODODependencyRequest) GetBlockedById() string {
+	if x != nil {
+		return x.BlockedById
+	}
+	return ""
+}
+
+// AddTODODependencyResponse is empty on success.
+type AddTODODependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTODODependencyResponse) Reset() {
+	*x = AddTODODependencyResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTODODependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTODODependencyResponse) ProtoMessage() {}
+
+func (x *AddTODODependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTODODependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddTODODependencyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{47}
+}
+
+// RemoveTODODependencyRequest requests that a TODO no longer be blocked by
+// another TODO.
+type RemoveTODODependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlockedById   string                 `protobuf:"bytes,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReopenTODOResponse) Reset() {
-	*x = ReopenTODOResponse{}
-	mi := &file_todo_v1_todo_proto_msgTypes[20]
+func (x *RemoveTODODependencyRequest) Reset() {
+	*x = RemoveTODODependencyRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReopenTODOResponse) String() string {
+func (x *RemoveTODODependencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReopenTODOResponse) ProtoMessage() {}
+func (*RemoveTODODependencyRequest) ProtoMessage() {}
 
-func (x *ReopenTODOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_todo_proto_msgTypes[20]
+func (x *RemoveTODODependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,23 +3051,342 @@ func (x *ReopenTODOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReopenTODOResponse.ProtoReflect.Descriptor instead.
-func (*ReopenTODOResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_todo_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RemoveTODODependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTODODependencyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ReopenTODOResponse) GetTodo() *TODO {
+func (x *RemoveTODODependencyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveTODODependencyRequest) GetBlockedById() string {
+	if x != nil {
+		return x.BlockedById
+	}
+	return ""
+}
+
+// RemoveTODODependencyResponse is empty on success.
+type RemoveTODODependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTODODependencyResponse) Reset() {
+	*x = RemoveTODODependencyResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTODODependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTODODependencyResponse) ProtoMessage() {}
+
+func (x *RemoveTODODependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTODODependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTODODependencyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{49}
+}
+
+// ListTODODependenciesRequest requests the dependencies of a TODO.
+type ListTODODependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTODODependenciesRequest) Reset() {
+	*x = ListTODODependenciesRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTODODependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTODODependenciesRequest) ProtoMessage() {}
+
+func (x *ListTODODependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTODODependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListTODODependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListTODODependenciesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListTODODependenciesResponse contains the TODOs that a TODO is blocked by
+// and the TODOs that it blocks.
+type ListTODODependenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockedBy     []*TODO                `protobuf:"bytes,1,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	Blocking      []*TODO                `protobuf:"bytes,2,rep,name=blocking,proto3" json:"blocking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTODODependenciesResponse) Reset() {
+	*x = ListTODODependenciesResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTODODependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTODODependenciesResponse) ProtoMessage() {}
+
+func (x *ListTODODependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTODODependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListTODODependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListTODODependenciesResponse) GetBlockedBy() []*TODO {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *ListTODODependenciesResponse) GetBlocking() []*TODO {
+	if x != nil {
+		return x.Blocking
+	}
+	return nil
+}
+
+// TODOTreeNode is a TODO with its subtasks and the progress of all of its
+// subtasks, however deep.
+type TODOTreeNode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Todo              *TODO                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	Children          []*TODOTreeNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"` // Empty below the requested depth
+	CompletedSubtasks int32                  `protobuf:"varint,3,opt,name=completed_subtasks,json=completedSubtasks,proto3" json:"completed_subtasks,omitempty"`
+	TotalSubtasks     int32                  `protobuf:"varint,4,opt,name=total_subtasks,json=totalSubtasks,proto3" json:"total_subtasks,omitempty"`
+	Progress          float64                `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"` // Percentage of subtasks completed
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TODOTreeNode) Reset() {
+	*x = TODOTreeNode{}
+	mi := &file_todo_v1_todo_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TODOTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TODOTreeNode) ProtoMessage() {}
+
+func (x *TODOTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TODOTreeNode.ProtoReflect.Descriptor instead.
+func (*TODOTreeNode) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TODOTreeNode) GetTodo() *TODO {
 	if x != nil {
 		return x.Todo
 	}
 	return nil
 }
 
+func (x *TODOTreeNode) GetChildren() []*TODOTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *TODOTreeNode) GetCompletedSubtasks() int32 {
+	if x != nil {
+		return x.CompletedSubtasks
+	}
+	return 0
+}
+
+func (x *TODOTreeNode) GetTotalSubtasks() int32 {
+	if x != nil {
+		return x.TotalSubtasks
+	}
+	return 0
+}
+
+func (x *TODOTreeNode) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+// GetTODOTreeRequest requests a TODO with its subtasks.
+type GetTODOTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"` // Levels of subtasks to include; defaults to 3, at most 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTODOTreeRequest) Reset() {
+	*x = GetTODOTreeRequest{}
+	mi := &file_todo_v1_todo_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTODOTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTODOTreeRequest) ProtoMessage() {}
+
+func (x *GetTODOTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTODOTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTODOTreeRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetTODOTreeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTODOTreeRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// GetTODOTreeResponse contains the tree rooted at the requested TODO.
+type GetTODOTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          *TODOTreeNode          `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTODOTreeResponse) Reset() {
+	*x = GetTODOTreeResponse{}
+	mi := &file_todo_v1_todo_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTODOTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTODOTreeResponse) ProtoMessage() {}
+
+func (x *GetTODOTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_todo_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTODOTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTODOTreeResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_todo_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTODOTreeResponse) GetRoot() *TODOTreeNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
 var File_todo_v1_todo_proto protoreflect.FileDescriptor
 
 const file_todo_v1_todo_proto_rawDesc = "" +
 	"\n" +
-	"\x12todo/v1/todo.proto\x12\atodo.v1\x1a\x15common/v1/enums.proto\x1a\x1acommon/v1/pagination.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13todo/v1/media.proto\"\xe4\x04\n" +
+	"\x12todo/v1/todo.proto\x12\atodo.v1\x1a\x15common/v1/enums.proto\x1a\x16common/v1/errors.proto\x1a\x1acommon/v1/pagination.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13todo/v1/media.proto\"\x9f\x06\n" +
 	"\x04TODO\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -1336,7 +3406,15 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	"\vassigned_to\x18\r \x01(\tR\n" +
 	"assignedTo\x12\x1b\n" +
 	"\tparent_id\x18\x0e \x01(\tR\bparentId\x12\x1a\n" +
-	"\bposition\x18\x0f \x01(\x05R\bposition\"\xe8\x03\n" +
+	"\bposition\x18\x0f \x01(\x05R\bposition\x12'\n" +
+	"\x0frecurrence_rule\x18\x10 \x01(\tR\x0erecurrenceRule\x12\x1e\n" +
+	"\n" +
+	"occurrence\x18\x11 \x01(\x05R\n" +
+	"occurrence\x129\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1b\n" +
+	"\torder_key\x18\x13 \x01(\tR\borderKey\x12\x18\n" +
+	"\aversion\x18\x14 \x01(\x03R\aversion\"\xaa\x04\n" +
 	"\x11CreateTODORequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12.\n" +
@@ -1347,14 +3425,17 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	"\x11media_attachments\x18\a \x03(\v2\x18.todo.v1.MediaAttachmentR\x10mediaAttachments\x12$\n" +
 	"\vassigned_to\x18\b \x01(\tH\x04R\n" +
 	"assignedTo\x88\x01\x01\x12 \n" +
-	"\tparent_id\x18\t \x01(\tH\x05R\bparentId\x88\x01\x01B\x0e\n" +
+	"\tparent_id\x18\t \x01(\tH\x05R\bparentId\x88\x01\x01\x12,\n" +
+	"\x0frecurrence_rule\x18\n" +
+	" \x01(\tH\x06R\x0erecurrenceRule\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\t\n" +
 	"\a_statusB\v\n" +
 	"\t_priorityB\v\n" +
 	"\t_due_dateB\x0e\n" +
 	"\f_assigned_toB\f\n" +
 	"\n" +
-	"_parent_id\"\xb5\x04\n" +
+	"_parent_idB\x12\n" +
+	"\x10_recurrence_rule\"\xb8\x05\n" +
 	"\x11UpdateTODORequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
@@ -1368,7 +3449,10 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	"assignedTo\x88\x01\x01\x12 \n" +
 	"\tparent_id\x18\n" +
 	" \x01(\tH\x06R\bparentId\x88\x01\x01\x12\x1f\n" +
-	"\bposition\x18\v \x01(\x05H\aR\bposition\x88\x01\x01B\b\n" +
+	"\bposition\x18\v \x01(\x05H\aR\bposition\x88\x01\x01\x12,\n" +
+	"\x0frecurrence_rule\x18\f \x01(\tH\bR\x0erecurrenceRule\x88\x01\x01\x12\x14\n" +
+	"\x05force\x18\r \x01(\bR\x05force\x12)\n" +
+	"\x10expected_version\x18\x0e \x01(\x03R\x0fexpectedVersionB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\t\n" +
 	"\a_statusB\v\n" +
@@ -1377,11 +3461,12 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	"\f_assigned_toB\f\n" +
 	"\n" +
 	"_parent_idB\v\n" +
-	"\t_position\" \n" +
+	"\t_positionB\x12\n" +
+	"\x10_recurrence_rule\" \n" +
 	"\x0eGetTODORequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"#\n" +
 	"\x11DeleteTODORequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x04\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xeb\x04\n" +
 	"\x10ListTODOsRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1c\n" +
 	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x88\x01\x01\x12-\n" +
@@ -1399,7 +3484,8 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	" \x03(\v2\x15.common.v1.SortOptionR\vsortOptions\x12A\n" +
 	"\n" +
 	"pagination\x18\v \x01(\v2\x1c.common.v1.PaginationRequestH\x05R\n" +
-	"pagination\x88\x01\x01B\n" +
+	"pagination\x88\x01\x01\x12$\n" +
+	"\x0eready_to_start\x18\f \x01(\bR\freadyToStartB\n" +
 	"\n" +
 	"\b_user_idB\x11\n" +
 	"\x0f_due_date_rangeB\x0e\n" +
@@ -1412,16 +3498,18 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	"\x05todos\x18\x01 \x03(\v2\r.todo.v1.TODOR\x05todos\x12=\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
-	"pagination\"V\n" +
+	"pagination\"l\n" +
 	"\x17BulkUpdateStatusRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\x12)\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x11.common.v1.StatusR\x06status\"%\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.common.v1.StatusR\x06status\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"%\n" +
 	"\x11BulkDeleteRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"\x7f\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xaa\x01\n" +
 	"\x0fMoveTODORequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x1f\n" +
-	"\bposition\x18\x03 \x01(\x05H\x01R\bposition\x88\x01\x01B\f\n" +
+	"\bposition\x18\x03 \x01(\x05H\x01R\bposition\x88\x01\x01\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersionB\f\n" +
 	"\n" +
 	"_parent_idB\v\n" +
 	"\t_position\"7\n" +
@@ -1430,20 +3518,160 @@ const file_todo_v1_todo_proto_rawDesc = "" +
 	"\x0fGetTODOResponse\x12!\n" +
 	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"7\n" +
 	"\x12UpdateTODOResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"\xb3\x01\n" +
+	"\x10PatchTODORequest\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"6\n" +
+	"\x11PatchTODOResponse\x12!\n" +
 	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"\x14\n" +
-	"\x12DeleteTODOResponse\"\x1a\n" +
-	"\x18BulkUpdateStatusResponse\"\x14\n" +
-	"\x12BulkDeleteResponse\"5\n" +
+	"\x12DeleteTODOResponse\"\x9b\x01\n" +
+	"\x18BulkUpdateStatusResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.todo.v1.BulkUpdateResultR\aresults\x12'\n" +
+	"\x0fsucceeded_count\x18\x02 \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"\x95\x01\n" +
+	"\x12BulkDeleteResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.todo.v1.BulkUpdateResultR\aresults\x12'\n" +
+	"\x0fsucceeded_count\x18\x02 \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"\xe3\x03\n" +
+	"\x16BulkUpdateTODOsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.common.v1.StatusH\x00R\x06status\x88\x01\x01\x124\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x13.common.v1.PriorityH\x01R\bpriority\x88\x01\x01\x12\x19\n" +
+	"\badd_tags\x18\x04 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\x05 \x03(\tR\n" +
+	"removeTags\x12$\n" +
+	"\vassigned_to\x18\x06 \x01(\tH\x02R\n" +
+	"assignedTo\x88\x01\x01\x125\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12$\n" +
+	"\x0eclear_due_date\x18\b \x01(\bR\fclearDueDate\x12 \n" +
+	"\tparent_id\x18\t \x01(\tH\x03R\bparentId\x88\x01\x01\x12$\n" +
+	"\rtransactional\x18\n" +
+	" \x01(\bR\rtransactional\x12\x14\n" +
+	"\x05force\x18\v \x01(\bR\x05forceB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_priorityB\x0e\n" +
+	"\f_assigned_toB\f\n" +
+	"\n" +
+	"_parent_id\"\x87\x01\n" +
+	"\x10BulkUpdateResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12!\n" +
+	"\x04todo\x18\x03 \x01(\v2\r.todo.v1.TODOR\x04todo\x12&\n" +
+	"\x05error\x18\x04 \x01(\v2\x10.common.v1.ErrorR\x05error\"\x9a\x01\n" +
+	"\x17BulkUpdateTODOsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.todo.v1.BulkUpdateResultR\aresults\x12'\n" +
+	"\x0fsucceeded_count\x18\x02 \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"j\n" +
+	"\x12ReorderTODORequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\tbefore_id\x18\x02 \x01(\tH\x00R\bbeforeId\x12\x1b\n" +
+	"\bafter_id\x18\x03 \x01(\tH\x00R\aafterIdB\b\n" +
+	"\x06anchor\"8\n" +
+	"\x13ReorderTODOResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"5\n" +
 	"\x10MoveTODOResponse\x12!\n" +
-	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"%\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"f\n" +
 	"\x13CompleteTODORequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"q\n" +
 	"\x14CompleteTODOResponse\x12!\n" +
-	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"#\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\x126\n" +
+	"\x0fnext_occurrence\x18\x02 \x01(\v2\r.todo.v1.TODOR\x0enextOccurrence\"N\n" +
 	"\x11ReopenTODORequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"7\n" +
 	"\x12ReopenTODOResponse\x12!\n" +
-	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todoBA\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"'\n" +
+	"\x15SkipOccurrenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x16SkipOccurrenceResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"&\n" +
+	"\x14EndRecurrenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x15EndRecurrenceResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"\x83\x02\n" +
+	"\fTODORevision\x12\x17\n" +
+	"\atodo_id\x18\x01 \x01(\tR\x06todoId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12%\n" +
+	"\x0echanged_fields\x18\x05 \x03(\tR\rchangedFields\x12)\n" +
+	"\bsnapshot\x18\x06 \x01(\v2\r.todo.v1.TODOR\bsnapshot\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x123\n" +
+	"\told_value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\boldValue\x123\n" +
+	"\tnew_value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\bnewValue\"|\n" +
+	"\x18ListTODORevisionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1c.common.v1.PaginationRequestH\x00R\n" +
+	"pagination\x88\x01\x01B\r\n" +
+	"\v_pagination\"\x8f\x01\n" +
+	"\x19ListTODORevisionsResponse\x123\n" +
+	"\trevisions\x18\x01 \x03(\v2\x15.todo.v1.TODORevisionR\trevisions\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
+	"pagination\"p\n" +
+	"\x18DiffTODORevisionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rfrom_revision\x18\x02 \x01(\x05R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x03 \x01(\x05R\n" +
+	"toRevision\"K\n" +
+	"\x19DiffTODORevisionsResponse\x12.\n" +
+	"\achanges\x18\x01 \x03(\v2\x14.todo.v1.FieldChangeR\achanges\"H\n" +
+	"\x1aRestoreTODORevisionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"@\n" +
+	"\x1bRestoreTODORevisionResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"d\n" +
+	"\x10ListTrashRequest\x12A\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.common.v1.PaginationRequestH\x00R\n" +
+	"pagination\x88\x01\x01B\r\n" +
+	"\v_pagination\"w\n" +
+	"\x11ListTrashResponse\x12#\n" +
+	"\x05todos\x18\x01 \x03(\v2\r.todo.v1.TODOR\x05todos\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
+	"pagination\"$\n" +
+	"\x12RestoreTODORequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x13RestoreTODOResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\"\"\n" +
+	"\x10PurgeTODORequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11PurgeTODOResponse\"N\n" +
+	"\x18AddTODODependencyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rblocked_by_id\x18\x02 \x01(\tR\vblockedById\"\x1b\n" +
+	"\x19AddTODODependencyResponse\"Q\n" +
+	"\x1bRemoveTODODependencyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rblocked_by_id\x18\x02 \x01(\tR\vblockedById\"\x1e\n" +
+	"\x1cRemoveTODODependencyResponse\"-\n" +
+	"\x1bListTODODependenciesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"w\n" +
+	"\x1cListTODODependenciesResponse\x12,\n" +
+	"\n" +
+	"blocked_by\x18\x01 \x03(\v2\r.todo.v1.TODOR\tblockedBy\x12)\n" +
+	"\bblocking\x18\x02 \x03(\v2\r.todo.v1.TODOR\bblocking\"\xd6\x01\n" +
+	"\fTODOTreeNode\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TODOR\x04todo\x121\n" +
+	"\bchildren\x18\x02 \x03(\v2\x15.todo.v1.TODOTreeNodeR\bchildren\x12-\n" +
+	"\x12completed_subtasks\x18\x03 \x01(\x05R\x11completedSubtasks\x12%\n" +
+	"\x0etotal_subtasks\x18\x04 \x01(\x05R\rtotalSubtasks\x12\x1a\n" +
+	"\bprogress\x18\x05 \x01(\x01R\bprogress\":\n" +
+	"\x12GetTODOTreeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"@\n" +
+	"\x13GetTODOTreeResponse\x12)\n" +
+	"\x04root\x18\x01 \x01(\v2\x15.todo.v1.TODOTreeNodeR\x04rootBA\n" +
 	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
 
 var (
@@ -1458,73 +3686,144 @@ func file_todo_v1_todo_proto_rawDescGZIP() []byte {
 	return file_todo_v1_todo_proto_rawDescData
 }
 
-var file_todo_v1_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_todo_v1_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_todo_v1_todo_proto_goTypes = []any{
-	(*TODO)(nil),                     // 0: todo.v1.TODO
-	(*CreateTODORequest)(nil),        // 1: todo.v1.CreateTODORequest
-	(*UpdateTODORequest)(nil),        // 2: todo.v1.UpdateTODORequest
-	(*GetTODORequest)(nil),           // 3: todo.v1.GetTODORequest
-	(*DeleteTODORequest)(nil),        // 4: todo.v1.DeleteTODORequest
-	(*ListTODOsRequest)(nil),         // 5: todo.v1.ListTODOsRequest
-	(*ListTODOsResponse)(nil),        // 6: todo.v1.ListTODOsResponse
-	(*BulkUpdateStatusRequest)(nil),  // 7: todo.v1.BulkUpdateStatusRequest
-	(*BulkDeleteRequest)(nil),        // 8: todo.v1.BulkDeleteRequest
-	(*MoveTODORequest)(nil),          // 9: todo.v1.MoveTODORequest
-	(*CreateTODOResponse)(nil),       // 10: todo.v1.CreateTODOResponse
-	(*GetTODOResponse)(nil),          // 11: todo.v1.GetTODOResponse
-	(*UpdateTODOResponse)(nil),       // 12: todo.v1.UpdateTODOResponse
-	(*DeleteTODOResponse)(nil),       // 13: todo.v1.DeleteTODOResponse
-	(*BulkUpdateStatusResponse)(nil), // 14: todo.v1.BulkUpdateStatusResponse
-	(*BulkDeleteResponse)(nil),       // 15: todo.v1.BulkDeleteResponse
-	(*MoveTODOResponse)(nil),         // 16: todo.v1.MoveTODOResponse
-	(*CompleteTODORequest)(nil),      // 17: todo.v1.CompleteTODORequest
-	(*CompleteTODOResponse)(nil),     // 18: todo.v1.CompleteTODOResponse
-	(*ReopenTODORequest)(nil),        // 19: todo.v1.ReopenTODORequest
-	(*ReopenTODOResponse)(nil),       // 20: todo.v1.ReopenTODOResponse
-	(v1.Status)(0),                   // 21: common.v1.Status
-	(v1.Priority)(0),                 // 22: common.v1.Priority
-	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
-	(*MediaAttachment)(nil),          // 24: todo.v1.MediaAttachment
-	(*v1.DateRange)(nil),             // 25: common.v1.DateRange
-	(*v1.SortOption)(nil),            // 26: common.v1.SortOption
-	(*v1.PaginationRequest)(nil),     // 27: common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),    // 28: common.v1.PaginationResponse
+	(*TODO)(nil),                         // 0: todo.v1.TODO
+	(*CreateTODORequest)(nil),            // 1: todo.v1.CreateTODORequest
+	(*UpdateTODORequest)(nil),            // 2: todo.v1.UpdateTODORequest
+	(*GetTODORequest)(nil),               // 3: todo.v1.GetTODORequest
+	(*DeleteTODORequest)(nil),            // 4: todo.v1.DeleteTODORequest
+	(*ListTODOsRequest)(nil),             // 5: todo.v1.ListTODOsRequest
+	(*ListTODOsResponse)(nil),            // 6: todo.v1.ListTODOsResponse
+	(*BulkUpdateStatusRequest)(nil),      // 7: todo.v1.BulkUpdateStatusRequest
+	(*BulkDeleteRequest)(nil),            // 8: todo.v1.BulkDeleteRequest
+	(*MoveTODORequest)(nil),              // 9: todo.v1.MoveTODORequest
+	(*CreateTODOResponse)(nil),           // 10: todo.v1.CreateTODOResponse
+	(*GetTODOResponse)(nil),              // 11: todo.v1.GetTODOResponse
+	(*UpdateTODOResponse)(nil),           // 12: todo.v1.UpdateTODOResponse
+	(*PatchTODORequest)(nil),             // 13: todo.v1.PatchTODORequest
+	(*PatchTODOResponse)(nil),            // 14: todo.v1.PatchTODOResponse
+	(*DeleteTODOResponse)(nil),           // 15: todo.v1.DeleteTODOResponse
+	(*BulkUpdateStatusResponse)(nil),     // 16: todo.v1.BulkUpdateStatusResponse
+	(*BulkDeleteResponse)(nil),           // 17: todo.v1.BulkDeleteResponse
+	(*BulkUpdateTODOsRequest)(nil),       // 18: todo.v1.BulkUpdateTODOsRequest
+	(*BulkUpdateResult)(nil),             // 19: todo.v1.BulkUpdateResult
+	(*BulkUpdateTODOsResponse)(nil),      // 20: todo.v1.BulkUpdateTODOsResponse
+	(*ReorderTODORequest)(nil),           // 21: todo.v1.ReorderTODORequest
+	(*ReorderTODOResponse)(nil),          // 22: todo.v1.ReorderTODOResponse
+	(*MoveTODOResponse)(nil),             // 23: todo.v1.MoveTODOResponse
+	(*CompleteTODORequest)(nil),          // 24: todo.v1.CompleteTODORequest
+	(*CompleteTODOResponse)(nil),         // 25: todo.v1.CompleteTODOResponse
+	(*ReopenTODORequest)(nil),            // 26: todo.v1.ReopenTODORequest
+	(*ReopenTODOResponse)(nil),           // 27: todo.v1.ReopenTODOResponse
+	(*SkipOccurrenceRequest)(nil),        // 28: todo.v1.SkipOccurrenceRequest
+	(*SkipOccurrenceResponse)(nil),       // 29: todo.v1.SkipOccurrenceResponse
+	(*EndRecurrenceRequest)(nil),         // 30: todo.v1.EndRecurrenceRequest
+	(*EndRecurrenceResponse)(nil),        // 31: todo.v1.EndRecurrenceResponse
+	(*TODORevision)(nil),                 // 32: todo.v1.TODORevision
+	(*FieldChange)(nil),                  // 33: todo.v1.FieldChange
+	(*ListTODORevisionsRequest)(nil),     // 34: todo.v1.ListTODORevisionsRequest
+	(*ListTODORevisionsResponse)(nil),    // 35: todo.v1.ListTODORevisionsResponse
+	(*DiffTODORevisionsRequest)(nil),     // 36: todo.v1.DiffTODORevisionsRequest
+	(*DiffTODORevisionsResponse)(nil),    // 37: todo.v1.DiffTODORevisionsResponse
+	(*RestoreTODORevisionRequest)(nil),   // 38: todo.v1.RestoreTODORevisionRequest
+	(*RestoreTODORevisionResponse)(nil),  // 39: todo.v1.RestoreTODORevisionResponse
+	(*ListTrashRequest)(nil),             // 40: todo.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),            // 41: todo.v1.ListTrashResponse
+	(*RestoreTODORequest)(nil),           // 42: todo.v1.RestoreTODORequest
+	(*RestoreTODOResponse)(nil),          // 43: todo.v1.RestoreTODOResponse
+	(*PurgeTODORequest)(nil),             // 44: todo.v1.PurgeTODORequest
+	(*PurgeTODOResponse)(nil),            // 45: todo.v1.PurgeTODOResponse
+	(*AddTODODependencyRequest)(nil),     // 46: todo.v1.AddTODODependencyRequest
+	(*AddTODODependencyResponse)(nil),    // 47: todo.v1.AddTODODependencyResponse
+	(*RemoveTODODependencyRequest)(nil),  // 48: todo.v1.RemoveTODODependencyRequest
+	(*RemoveTODODependencyResponse)(nil), // 49: todo.v1.RemoveTODODependencyResponse
+	(*ListTODODependenciesRequest)(nil),  // 50: todo.v1.ListTODODependenciesRequest
+	(*ListTODODependenciesResponse)(nil), // 51: todo.v1.ListTODODependenciesResponse
+	(*TODOTreeNode)(nil),                 // 52: todo.v1.TODOTreeNode
+	(*GetTODOTreeRequest)(nil),           // 53: todo.v1.GetTODOTreeRequest
+	(*GetTODOTreeResponse)(nil),          // 54: todo.v1.GetTODOTreeResponse
+	(v1.Status)(0),                       // 55: common.v1.Status
+	(v1.Priority)(0),                     // 56: common.v1.Priority
+	(*timestamppb.Timestamp)(nil),        // 57: google.protobuf.Timestamp
+	(*MediaAttachment)(nil),              // 58: todo.v1.MediaAttachment
+	(*v1.DateRange)(nil),                 // 59: common.v1.DateRange
+	(*v1.SortOption)(nil),                // 60: common.v1.SortOption
+	(*v1.PaginationRequest)(nil),         // 61: common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),        // 62: common.v1.PaginationResponse
+	(*fieldmaskpb.FieldMask)(nil),        // 63: google.protobuf.FieldMask
+	(*v1.Error)(nil),                     // 64: common.v1.Error
+	(*structpb.Value)(nil),               // 65: google.protobuf.Value
 }
 var file_todo_v1_todo_proto_depIdxs = []int32{
-	21, // 0: todo.v1.TODO.status:type_name -> common.v1.Status
-	22, // 1: todo.v1.TODO.priority:type_name -> common.v1.Priority
-	23, // 2: todo.v1.TODO.due_date:type_name -> google.protobuf.Timestamp
-	24, // 3: todo.v1.TODO.media_attachments:type_name -> todo.v1.MediaAttachment
-	23, // 4: todo.v1.TODO.created_at:type_name -> google.protobuf.Timestamp
-	23, // 5: todo.v1.TODO.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 6: todo.v1.TODO.completed_at:type_name -> google.protobuf.Timestamp
-	21, // 7: todo.v1.CreateTODORequest.status:type_name -> common.v1.Status
-	22, // 8: todo.v1.CreateTODORequest.priority:type_name -> common.v1.Priority
-	23, // 9: todo.v1.CreateTODORequest.due_date:type_name -> google.protobuf.Timestamp
-	24, // 10: todo.v1.CreateTODORequest.media_attachments:type_name -> todo.v1.MediaAttachment
-	21, // 11: todo.v1.UpdateTODORequest.status:type_name -> common.v1.Status
-	22, // 12: todo.v1.UpdateTODORequest.priority:type_name -> common.v1.Priority
-	23, // 13: todo.v1.UpdateTODORequest.due_date:type_name -> google.protobuf.Timestamp
-	24, // 14: todo.v1.UpdateTODORequest.media_attachments:type_name -> todo.v1.MediaAttachment
-	21, // 15: todo.v1.ListTODOsRequest.statuses:type_name -> common.v1.Status
-	22, // 16: todo.v1.ListTODOsRequest.priorities:type_name -> common.v1.Priority
-	25, // 17: todo.v1.ListTODOsRequest.due_date_range:type_name -> common.v1.DateRange
-	26, // 18: todo.v1.ListTODOsRequest.sort_options:type_name -> common.v1.SortOption
-	27, // 19: todo.v1.ListTODOsRequest.pagination:type_name -> common.v1.PaginationRequest
-	0,  // 20: todo.v1.ListTODOsResponse.todos:type_name -> todo.v1.TODO
-	28, // 21: todo.v1.ListTODOsResponse.pagination:type_name -> common.v1.PaginationResponse
-	21, // 22: todo.v1.BulkUpdateStatusRequest.status:type_name -> common.v1.Status
-	0,  // 23: todo.v1.CreateTODOResponse.todo:type_name -> todo.v1.TODO
-	0,  // 24: todo.v1.GetTODOResponse.todo:type_name -> todo.v1.TODO
-	0,  // 25: todo.v1.UpdateTODOResponse.todo:type_name -> todo.v1.TODO
-	0,  // 26: todo.v1.MoveTODOResponse.todo:type_name -> todo.v1.TODO
-	0,  // 27: todo.v1.CompleteTODOResponse.todo:type_name -> todo.v1.TODO
-	0,  // 28: todo.v1.ReopenTODOResponse.todo:type_name -> todo.v1.TODO
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	55, // 0: todo.v1.TODO.status:type_name -> common.v1.Status
+	56, // 1: todo.v1.TODO.priority:type_name -> common.v1.Priority
+	57, // 2: todo.v1.TODO.due_date:type_name -> google.protobuf.Timestamp
+	58, // 3: todo.v1.TODO.media_attachments:type_name -> todo.v1.MediaAttachment
+	57, // 4: todo.v1.TODO.created_at:type_name -> google.protobuf.Timestamp
+	57, // 5: todo.v1.TODO.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 6: todo.v1.TODO.completed_at:type_name -> google.protobuf.Timestamp
+	57, // 7: todo.v1.TODO.deleted_at:type_name -> google.protobuf.Timestamp
+	55, // 8: todo.v1.CreateTODORequest.status:type_name -> common.v1.Status
+	56, // 9: todo.v1.CreateTODORequest.priority:type_name -> common.v1.Priority
+	57, // 10: todo.v1.CreateTODORequest.due_date:type_name -> google.protobuf.Timestamp
+	58, // 11: todo.v1.CreateTODORequest.media_attachments:type_name -> todo.v1.MediaAttachment
+	55, // 12: todo.v1.UpdateTODORequest.status:type_name -> common.v1.Status
+	56, // 13: todo.v1.UpdateTODORequest.priority:type_name -> common.v1.Priority
+	57, // 14: todo.v1.UpdateTODORequest.due_date:type_name -> google.protobuf.Timestamp
+	58, // 15: todo.v1.UpdateTODORequest.media_attachments:type_name -> todo.v1.MediaAttachment
+	55, // 16: todo.v1.ListTODOsRequest.statuses:type_name -> common.v1.Status
+	56, // 17: todo.v1.ListTODOsRequest.priorities:type_name -> common.v1.Priority
+	59, // 18: todo.v1.ListTODOsRequest.due_date_range:type_name -> common.v1.DateRange
+	60, // 19: todo.v1.ListTODOsRequest.sort_options:type_name -> common.v1.SortOption
+	61, // 20: todo.v1.ListTODOsRequest.pagination:type_name -> common.v1.PaginationRequest
+	0,  // 21: todo.v1.ListTODOsResponse.todos:type_name -> todo.v1.TODO
+	62, // 22: todo.v1.ListTODOsResponse.pagination:type_name -> common.v1.PaginationResponse
+	55, // 23: todo.v1.BulkUpdateStatusRequest.status:type_name -> common.v1.Status
+	0,  // 24: todo.v1.CreateTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 25: todo.v1.GetTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 26: todo.v1.UpdateTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 27: todo.v1.PatchTODORequest.todo:type_name -> todo.v1.TODO
+	63, // 28: todo.v1.PatchTODORequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 29: todo.v1.PatchTODOResponse.todo:type_name -> todo.v1.TODO
+	19, // 30: todo.v1.BulkUpdateStatusResponse.results:type_name -> todo.v1.BulkUpdateResult
+	19, // 31: todo.v1.BulkDeleteResponse.results:type_name -> todo.v1.BulkUpdateResult
+	55, // 32: todo.v1.BulkUpdateTODOsRequest.status:type_name -> common.v1.Status
+	56, // 33: todo.v1.BulkUpdateTODOsRequest.priority:type_name -> common.v1.Priority
+	57, // 34: todo.v1.BulkUpdateTODOsRequest.due_date:type_name -> google.protobuf.Timestamp
+	0,  // 35: todo.v1.BulkUpdateResult.todo:type_name -> todo.v1.TODO
+	64, // 36: todo.v1.BulkUpdateResult.error:type_name -> common.v1.Error
+	19, // 37: todo.v1.BulkUpdateTODOsResponse.results:type_name -> todo.v1.BulkUpdateResult
+	0,  // 38: todo.v1.ReorderTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 39: todo.v1.MoveTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 40: todo.v1.CompleteTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 41: todo.v1.CompleteTODOResponse.next_occurrence:type_name -> todo.v1.TODO
+	0,  // 42: todo.v1.ReopenTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 43: todo.v1.SkipOccurrenceResponse.todo:type_name -> todo.v1.TODO
+	0,  // 44: todo.v1.EndRecurrenceResponse.todo:type_name -> todo.v1.TODO
+	0,  // 45: todo.v1.TODORevision.snapshot:type_name -> todo.v1.TODO
+	57, // 46: todo.v1.TODORevision.created_at:type_name -> google.protobuf.Timestamp
+	65, // 47: todo.v1.FieldChange.old_value:type_name -> google.protobuf.Value
+	65, // 48: todo.v1.FieldChange.new_value:type_name -> google.protobuf.Value
+	61, // 49: todo.v1.ListTODORevisionsRequest.pagination:type_name -> common.v1.PaginationRequest
+	32, // 50: todo.v1.ListTODORevisionsResponse.revisions:type_name -> todo.v1.TODORevision
+	62, // 51: todo.v1.ListTODORevisionsResponse.pagination:type_name -> common.v1.PaginationResponse
+	33, // 52: todo.v1.DiffTODORevisionsResponse.changes:type_name -> todo.v1.FieldChange
+	0,  // 53: todo.v1.RestoreTODORevisionResponse.todo:type_name -> todo.v1.TODO
+	61, // 54: todo.v1.ListTrashRequest.pagination:type_name -> common.v1.PaginationRequest
+	0,  // 55: todo.v1.ListTrashResponse.todos:type_name -> todo.v1.TODO
+	62, // 56: todo.v1.ListTrashResponse.pagination:type_name -> common.v1.PaginationResponse
+	0,  // 57: todo.v1.RestoreTODOResponse.todo:type_name -> todo.v1.TODO
+	0,  // 58: todo.v1.ListTODODependenciesResponse.blocked_by:type_name -> todo.v1.TODO
+	0,  // 59: todo.v1.ListTODODependenciesResponse.blocking:type_name -> todo.v1.TODO
+	0,  // 60: todo.v1.TODOTreeNode.todo:type_name -> todo.v1.TODO
+	52, // 61: todo.v1.TODOTreeNode.children:type_name -> todo.v1.TODOTreeNode
+	52, // 62: todo.v1.GetTODOTreeResponse.root:type_name -> todo.v1.TODOTreeNode
+	63, // [63:63] is the sub-list for method output_type
+	63, // [63:63] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_todo_v1_todo_proto_init() }
@@ -1537,13 +3836,20 @@ func file_todo_v1_todo_proto_init() {
 	file_todo_v1_todo_proto_msgTypes[2].OneofWrappers = []any{}
 	file_todo_v1_todo_proto_msgTypes[5].OneofWrappers = []any{}
 	file_todo_v1_todo_proto_msgTypes[9].OneofWrappers = []any{}
+	file_todo_v1_todo_proto_msgTypes[18].OneofWrappers = []any{}
+	file_todo_v1_todo_proto_msgTypes[21].OneofWrappers = []any{
+		(*ReorderTODORequest_BeforeId)(nil),
+		(*ReorderTODORequest_AfterId)(nil),
+	}
+	file_todo_v1_todo_proto_msgTypes[34].OneofWrappers = []any{}
+	file_todo_v1_todo_proto_msgTypes[40].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_v1_todo_proto_rawDesc), len(file_todo_v1_todo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
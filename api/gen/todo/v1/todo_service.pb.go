@@ -25,70 +25,145 @@ var File_todo_v1_todo_service_proto protoreflect.FileDescriptor
 
 const file_todo_v1_todo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1atodo/v1/todo_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x12todo/v1/todo.proto2\xf1\a\n" +
+	"\x1atodo/v1/todo_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x12todo/v1/todo.proto2\xed\x15\n" +
 	"\vTODOService\x12[\n" +
 	"\n" +
 	"CreateTODO\x12\x1a.todo.v1.CreateTODORequest\x1a\x1b.todo.v1.CreateTODOResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/todos\x12T\n" +
 	"\aGetTODO\x12\x17.todo.v1.GetTODORequest\x1a\x18.todo.v1.GetTODOResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/todos/{id}\x12`\n" +
 	"\n" +
-	"UpdateTODO\x12\x1a.todo.v1.UpdateTODORequest\x1a\x1b.todo.v1.UpdateTODOResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/todos/{id}\x12]\n" +
+	"UpdateTODO\x12\x1a.todo.v1.UpdateTODORequest\x1a\x1b.todo.v1.UpdateTODOResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/todos/{id}\x12e\n" +
+	"\tPatchTODO\x12\x19.todo.v1.PatchTODORequest\x1a\x1a.todo.v1.PatchTODOResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x04todo2\x13/v1/todos/{todo.id}\x12]\n" +
 	"\n" +
 	"DeleteTODO\x12\x1a.todo.v1.DeleteTODORequest\x1a\x1b.todo.v1.DeleteTODOResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/todos/{id}\x12U\n" +
 	"\tListTODOs\x12\x19.todo.v1.ListTODOsRequest\x1a\x1a.todo.v1.ListTODOsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/todos\x12y\n" +
 	"\x10BulkUpdateStatus\x12 .todo.v1.BulkUpdateStatusRequest\x1a!.todo.v1.BulkUpdateStatusResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/todos/bulk/status\x12g\n" +
 	"\n" +
-	"BulkDelete\x12\x1a.todo.v1.BulkDeleteRequest\x1a\x1b.todo.v1.BulkDeleteResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/todos/bulk/delete\x12_\n" +
-	"\bMoveTODO\x12\x18.todo.v1.MoveTODORequest\x1a\x19.todo.v1.MoveTODOResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/todos/{id}/move\x12l\n" +
+	"BulkDelete\x12\x1a.todo.v1.BulkDeleteRequest\x1a\x1b.todo.v1.BulkDeleteResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/todos/bulk/delete\x12v\n" +
+	"\x0fBulkUpdateTODOs\x12\x1f.todo.v1.BulkUpdateTODOsRequest\x1a .todo.v1.BulkUpdateTODOsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/todos/bulk/update\x12_\n" +
+	"\bMoveTODO\x12\x18.todo.v1.MoveTODORequest\x1a\x19.todo.v1.MoveTODOResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/todos/{id}/move\x12k\n" +
+	"\vReorderTODO\x12\x1b.todo.v1.ReorderTODORequest\x1a\x1c.todo.v1.ReorderTODOResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/todos/{id}/reorder\x12l\n" +
 	"\fCompleteTODO\x12\x1c.todo.v1.CompleteTODORequest\x1a\x1d.todo.v1.CompleteTODOResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/v1/todos/{id}/complete\x12d\n" +
 	"\n" +
-	"ReopenTODO\x12\x1a.todo.v1.ReopenTODORequest\x1a\x1b.todo.v1.ReopenTODOResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/v1/todos/{id}/reopenBA\n" +
+	"ReopenTODO\x12\x1a.todo.v1.ReopenTODORequest\x1a\x1b.todo.v1.ReopenTODOResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/v1/todos/{id}/reopen\x12n\n" +
+	"\x0eSkipOccurrence\x12\x1e.todo.v1.SkipOccurrenceRequest\x1a\x1f.todo.v1.SkipOccurrenceResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/v1/todos/{id}/skip\x12u\n" +
+	"\rEndRecurrence\x12\x1d.todo.v1.EndRecurrenceRequest\x1a\x1e.todo.v1.EndRecurrenceResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1d/v1/todos/{id}/end-recurrence\x12|\n" +
+	"\x11ListTODORevisions\x12!.todo.v1.ListTODORevisionsRequest\x1a\".todo.v1.ListTODORevisionsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/todos/{id}/revisions\x12\x81\x01\n" +
+	"\x11DiffTODORevisions\x12!.todo.v1.DiffTODORevisionsRequest\x1a\".todo.v1.DiffTODORevisionsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/todos/{id}/revisions/diff\x12\x95\x01\n" +
+	"\x13RestoreTODORevision\x12#.todo.v1.RestoreTODORevisionRequest\x1a$.todo.v1.RestoreTODORevisionResponse\"3\x82\xd3\xe4\x93\x02-\"+/v1/todos/{id}/revisions/{revision}/restore\x12U\n" +
+	"\tListTrash\x12\x19.todo.v1.ListTrashRequest\x1a\x1a.todo.v1.ListTrashResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/trash\x12h\n" +
+	"\vRestoreTODO\x12\x1b.todo.v1.RestoreTODORequest\x1a\x1c.todo.v1.RestoreTODOResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x16/v1/trash/{id}/restore\x12Z\n" +
+	"\tPurgeTODO\x12\x19.todo.v1.PurgeTODORequest\x1a\x1a.todo.v1.PurgeTODOResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/trash/{id}\x12\x82\x01\n" +
+	"\x11AddTODODependency\x12!.todo.v1.AddTODODependencyRequest\x1a\".todo.v1.AddTODODependencyResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/todos/{id}/dependencies\x12\x98\x01\n" +
+	"\x14RemoveTODODependency\x12$.todo.v1.RemoveTODODependencyRequest\x1a%.todo.v1.RemoveTODODependencyResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/todos/{id}/dependencies/{blocked_by_id}\x12\x88\x01\n" +
+	"\x14ListTODODependencies\x12$.todo.v1.ListTODODependenciesRequest\x1a%.todo.v1.ListTODODependenciesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/todos/{id}/dependencies\x12e\n" +
+	"\vGetTODOTree\x12\x1b.todo.v1.GetTODOTreeRequest\x1a\x1c.todo.v1.GetTODOTreeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/todos/{id}/treeBA\n" +
 	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
 
 var file_todo_v1_todo_service_proto_goTypes = []any{
-	(*CreateTODORequest)(nil),        // 0: todo.v1.CreateTODORequest
-	(*GetTODORequest)(nil),           // 1: todo.v1.GetTODORequest
-	(*UpdateTODORequest)(nil),        // 2: todo.v1.UpdateTODORequest
-	(*DeleteTODORequest)(nil),        // 3: todo.v1.DeleteTODORequest
-	(*ListTODOsRequest)(nil),         // 4: todo.v1.ListTODOsRequest
-	(*BulkUpdateStatusRequest)(nil),  // 5: todo.v1.BulkUpdateStatusRequest
-	(*BulkDeleteRequest)(nil),        // 6: todo.v1.BulkDeleteRequest
-	(*MoveTODORequest)(nil),          // 7: todo.v1.MoveTODORequest
-	(*CompleteTODORequest)(nil),      // 8: todo.v1.CompleteTODORequest
-	(*ReopenTODORequest)(nil),        // 9: todo.v1.ReopenTODORequest
-	(*CreateTODOResponse)(nil),       // 10: todo.v1.CreateTODOResponse
-	(*GetTODOResponse)(nil),          // 11: todo.v1.GetTODOResponse
-	(*UpdateTODOResponse)(nil),       // 12: todo.v1.UpdateTODOResponse
-	(*DeleteTODOResponse)(nil),       // 13: todo.v1.DeleteTODOResponse
-	(*ListTODOsResponse)(nil),        // 14: todo.v1.ListTODOsResponse
-	(*BulkUpdateStatusResponse)(nil), // 15: todo.v1.BulkUpdateStatusResponse
-	(*BulkDeleteResponse)(nil),       // 16: todo.v1.BulkDeleteResponse
-	(*MoveTODOResponse)(nil),         // 17: todo.v1.MoveTODOResponse
-	(*CompleteTODOResponse)(nil),     // 18: todo.v1.CompleteTODOResponse
-	(*ReopenTODOResponse)(nil),       // 19: todo.v1.ReopenTODOResponse
+	(*CreateTODORequest)(nil),            // 0: todo.v1.CreateTODORequest
+	(*GetTODORequest)(nil),               // 1: todo.v1.GetTODORequest
+	(*UpdateTODORequest)(nil),            // 2: todo.v1.UpdateTODORequest
+	(*PatchTODORequest)(nil),             // 3: todo.v1.PatchTODORequest
+	(*DeleteTODORequest)(nil),            // 4: todo.v1.DeleteTODORequest
+	(*ListTODOsRequest)(nil),             // 5: todo.v1.ListTODOsRequest
+	(*BulkUpdateStatusRequest)(nil),      // 6: todo.v1.BulkUpdateStatusRequest
+	(*BulkDeleteRequest)(nil),            // 7: todo.v1.BulkDeleteRequest
+	(*BulkUpdateTODOsRequest)(nil),       // 8: todo.v1.BulkUpdateTODOsRequest
+	(*MoveTODORequest)(nil),              // 9: todo.v1.MoveTODORequest
+	(*ReorderTODORequest)(nil),           // 10: todo.v1.ReorderTODORequest
+	(*CompleteTODORequest)(nil),          // 11: todo.v1.CompleteTODORequest
+	(*ReopenTODORequest)(nil),            // 12: todo.v1.ReopenTODORequest
+	(*SkipOccurrenceRequest)(nil),        // 13: todo.v1.SkipOccurrenceRequest
+	(*EndRecurrenceRequest)(nil),         // 14: todo.v1.EndRecurrenceRequest
+	(*ListTODORevisionsRequest)(nil),     // 15: todo.v1.ListTODORevisionsRequest
+	(*DiffTODORevisionsRequest)(nil),     // 16: todo.v1.DiffTODORevisionsRequest
+	(*RestoreTODORevisionRequest)(nil),   // 17: todo.v1.RestoreTODORevisionRequest
+	(*ListTrashRequest)(nil),             // 18: todo.v1.ListTrashRequest
+	(*RestoreTODORequest)(nil),           // 19: todo.v1.RestoreTODORequest
+	(*PurgeTODORequest)(nil),             // 20: todo.v1.PurgeTODORequest
+	(*AddTODODependencyRequest)(nil),     // 21: todo.v1.AddTODODependencyRequest
+	(*RemoveTODODependencyRequest)(nil),  // 22: todo.v1.RemoveTODODependencyRequest
+	(*ListTODODependenciesRequest)(nil),  // 23: todo.v1.ListTODODependenciesRequest
+	(*GetTODOTreeRequest)(nil),           // 24: todo.v1.GetTODOTreeRequest
+	(*CreateTODOResponse)(nil),           // 25: todo.v1.CreateTODOResponse
+	(*GetTODOResponse)(nil),              // 26: todo.v1.GetTODOResponse
+	(*UpdateTODOResponse)(nil),           // 27: todo.v1.UpdateTODOResponse
+	(*PatchTODOResponse)(nil),            // 28: todo.v1.PatchTODOResponse
+	(*DeleteTODOResponse)(nil),           // 29: todo.v1.DeleteTODOResponse
+	(*ListTODOsResponse)(nil),            // 30: todo.v1.ListTODOsResponse
+	(*BulkUpdateStatusResponse)(nil),     // 31: todo.v1.BulkUpdateStatusResponse
+	(*BulkDeleteResponse)(nil),           // 32: todo.v1.BulkDeleteResponse
+	(*BulkUpdateTODOsResponse)(nil),      // 33: todo.v1.BulkUpdateTODOsResponse
+	(*MoveTODOResponse)(nil),             // 34: todo.v1.MoveTODOResponse
+	(*ReorderTODOResponse)(nil),          // 35: todo.v1.ReorderTODOResponse
+	(*CompleteTODOResponse)(nil),         // 36: todo.v1.CompleteTODOResponse
+	(*ReopenTODOResponse)(nil),           // 37: todo.v1.ReopenTODOResponse
+	(*SkipOccurrenceResponse)(nil),       // 38: todo.v1.SkipOccurrenceResponse
+	(*EndRecurrenceResponse)(nil),        // 39: todo.v1.EndRecurrenceResponse
+	(*ListTODORevisionsResponse)(nil),    // 40: todo.v1.ListTODORevisionsResponse
+	(*DiffTODORevisionsResponse)(nil),    // 41: todo.v1.DiffTODORevisionsResponse
+	(*RestoreTODORevisionResponse)(nil),  // 42: todo.v1.RestoreTODORevisionResponse
+	(*ListTrashResponse)(nil),            // 43: todo.v1.ListTrashResponse
+	(*RestoreTODOResponse)(nil),          // 44: todo.v1.RestoreTODOResponse
+	(*PurgeTODOResponse)(nil),            // 45: todo.v1.PurgeTODOResponse
+	(*AddTODODependencyResponse)(nil),    // 46: todo.v1.AddTODODependencyResponse
+	(*RemoveTODODependencyResponse)(nil), // 47: todo.v1.RemoveTODODependencyResponse
+	(*ListTODODependenciesResponse)(nil), // 48: todo.v1.ListTODODependenciesResponse
+	(*GetTODOTreeResponse)(nil),          // 49: todo.v1.GetTODOTreeResponse
 }
 var file_todo_v1_todo_service_proto_depIdxs = []int32{
 	0,  // 0: todo.v1.TODOService.CreateTODO:input_type -> todo.v1.CreateTODORequest
 	1,  // 1: todo.v1.TODOService.GetTODO:input_type -> todo.v1.GetTODORequest
 	2,  // 2: todo.v1.TODOService.UpdateTODO:input_type -> todo.v1.UpdateTODORequest
-	3,  // 3: todo.v1.TODOService.DeleteTODO:input_type -> todo.v1.DeleteTODORequest
-	4,  // 4: todo.v1.TODOService.ListTODOs:input_type -> todo.v1.ListTODOsRequest
-	5,  // 5: todo.v1.TODOService.BulkUpdateStatus:input_type -> todo.v1.BulkUpdateStatusRequest
-	6,  // 6: todo.v1.TODOService.BulkDelete:input_type -> todo.v1.BulkDeleteRequest
-	7,  // 7: todo.v1.TODOService.MoveTODO:input_type -> todo.v1.MoveTODORequest
-	8,  // 8: todo.v1.TODOService.CompleteTODO:input_type -> todo.v1.CompleteTODORequest
-	9,  // 9: todo.v1.TODOService.ReopenTODO:input_type -> todo.v1.ReopenTODORequest
-	10, // 10: todo.v1.TODOService.CreateTODO:output_type -> todo.v1.CreateTODOResponse
-	11, // 11: todo.v1.TODOService.GetTODO:output_type -> todo.v1.GetTODOResponse
-	12, // 12: todo.v1.TODOService.UpdateTODO:output_type -> todo.v1.UpdateTODOResponse
-	13, // 13: todo.v1.TODOService.DeleteTODO:output_type -> todo.v1.DeleteTODOResponse
-	14, // 14: todo.v1.TODOService.ListTODOs:output_type -> todo.v1.ListTODOsResponse
-	15, // 15: todo.v1.TODOService.BulkUpdateStatus:output_type -> todo.v1.BulkUpdateStatusResponse
-	16, // 16: todo.v1.TODOService.BulkDelete:output_type -> todo.v1.BulkDeleteResponse
-	17, // 17: todo.v1.TODOService.MoveTODO:output_type -> todo.v1.MoveTODOResponse
-	18, // 18: todo.v1.TODOService.CompleteTODO:output_type -> todo.v1.CompleteTODOResponse
-	19, // 19: todo.v1.TODOService.ReopenTODO:output_type -> todo.v1.ReopenTODOResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	3,  // 3: todo.v1.TODOService.PatchTODO:input_type -> todo.v1.PatchTODORequest
+	4,  // 4: todo.v1.TODOService.DeleteTODO:input_type -> todo.v1.DeleteTODORequest
+	5,  // 5: todo.v1.TODOService.ListTODOs:input_type -> todo.v1.ListTODOsRequest
+	6,  // 6: todo.v1.TODOService.BulkUpdateStatus:input_type -> todo.v1.BulkUpdateStatusRequest
+	7,  // 7: todo.v1.TODOService.BulkDelete:input_type -> todo.v1.BulkDeleteRequest
+	8,  // 8: todo.v1.TODOService.BulkUpdateTODOs:input_type -> todo.v1.BulkUpdateTODOsRequest
+	9,  // 9: todo.v1.TODOService.MoveTODO:input_type -> todo.v1.MoveTODORequest
+	10, // 10: todo.v1.TODOService.ReorderTODO:input_type -> todo.v1.ReorderTODORequest
+	11, // 11: todo.v1.TODOService.CompleteTODO:input_type -> todo.v1.CompleteTODORequest
+	12, // 12: todo.v1.TODOService.ReopenTODO:input_type -> todo.v1.ReopenTODORequest
+	13, // 13: todo.v1.TODOService.SkipOccurrence:input_type -> todo.v1.SkipOccurrenceRequest
+	14, // 14: todo.v1.TODOService.EndRecurrence:input_type -> todo.v1.EndRecurrenceRequest
+	15, // 15: todo.v1.TODOService.ListTODORevisions:input_type -> todo.v1.ListTODORevisionsRequest
+	16, // 16: todo.v1.TODOService.DiffTODORevisions:input_type -> todo.v1.DiffTODORevisionsRequest
+	17, // 17: todo.v1.TODOService.RestoreTODORevision:input_type -> todo.v1.RestoreTODORevisionRequest
+	18, // 18: todo.v1.TODOService.ListTrash:input_type -> todo.v1.ListTrashRequest
+	19, // 19: todo.v1.TODOService.RestoreTODO:input_type -> todo.v1.RestoreTODORequest
+	20, // 20: todo.v1.TODOService.PurgeTODO:input_type -> todo.v1.PurgeTODORequest
+	21, // 21: todo.v1.TODOService.AddTODODependency:input_type -> todo.v1.AddTODODependencyRequest
+	22, // 22: todo.v1.TODOService.RemoveTODODependency:input_type -> todo.v1.RemoveTODODependencyRequest
+	23, // 23: todo.v1.TODOService.ListTODODependencies:input_type -> todo.v1.ListTODODependenciesRequest
+	24, // 24: todo.v1.TODOService.GetTODOTree:input_type -> todo.v1.GetTODOTreeRequest
+	25, // 25: todo.v1.TODOService.CreateTODO:output_type -> todo.v1.CreateTODOResponse
+	26, // 26: todo.v1.TODOService.GetTODO:output_type -> todo.v1.GetTODOResponse
+	27, // 27: todo.v1.TODOService.UpdateTODO:output_type -> todo.v1.UpdateTODOResponse
+	28, // 28: todo.v1.TODOService.PatchTODO:output_type -> todo.v1.PatchTODOResponse
+	29, // 29: todo.v1.TODOService.DeleteTODO:output_type -> todo.v1.DeleteTODOResponse
+	30, // 30: todo.v1.TODOService.ListTODOs:output_type -> todo.v1.ListTODOsResponse
+	31, // 31: todo.v1.TODOService.BulkUpdateStatus:output_type -> todo.v1.BulkUpdateStatusResponse
+	32, // 32: todo.v1.TODOService.BulkDelete:output_type -> todo.v1.BulkDeleteResponse
+	33, // 33: todo.v1.TODOService.BulkUpdateTODOs:output_type -> todo.v1.BulkUpdateTODOsResponse
+	34, // 34: todo.v1.TODOService.MoveTODO:output_type -> todo.v1.MoveTODOResponse
+	35, // 35: todo.v1.TODOService.ReorderTODO:output_type -> todo.v1.ReorderTODOResponse
+	36, // 36: todo.v1.TODOService.CompleteTODO:output_type -> todo.v1.CompleteTODOResponse
+	37, // 37: todo.v1.TODOService.ReopenTODO:output_type -> todo.v1.ReopenTODOResponse
+	38, // 38: todo.v1.TODOService.SkipOccurrence:output_type -> todo.v1.SkipOccurrenceResponse
+	39, // 39: todo.v1.TODOService.EndRecurrence:output_type -> todo.v1.EndRecurrenceResponse
+	40, // 40: todo.v1.TODOService.ListTODORevisions:output_type -> todo.v1.ListTODORevisionsResponse
+	41, // 41: todo.v1.TODOService.DiffTODORevisions:output_type -> todo.v1.DiffTODORevisionsResponse
+	42, // 42: todo.v1.TODOService.RestoreTODORevision:output_type -> todo.v1.RestoreTODORevisionResponse
+	43, // 43: todo.v1.TODOService.ListTrash:output_type -> todo.v1.ListTrashResponse
+	44, // 44: todo.v1.TODOService.RestoreTODO:output_type -> todo.v1.RestoreTODOResponse
+	45, // 45: todo.v1.TODOService.PurgeTODO:output_type -> todo.v1.PurgeTODOResponse
+	46, // 46: todo.v1.TODOService.AddTODODependency:output_type -> todo.v1.AddTODODependencyResponse
+	47, // 47: todo.v1.TODOService.RemoveTODODependency:output_type -> todo.v1.RemoveTODODependencyResponse
+	48, // 48: todo.v1.TODOService.ListTODODependencies:output_type -> todo.v1.ListTODODependenciesResponse
+	49, // 49: todo.v1.TODOService.GetTODOTree:output_type -> todo.v1.GetTODOTreeResponse
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
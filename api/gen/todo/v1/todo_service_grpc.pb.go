@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TODOService_CreateTODO_FullMethodName       = "/todo.v1.TODOService/CreateTODO"
-	TODOService_GetTODO_FullMethodName          = "/todo.v1.TODOService/GetTODO"
-	TODOService_UpdateTODO_FullMethodName       = "/todo.v1.TODOService/UpdateTODO"
-	TODOService_DeleteTODO_FullMethodName       = "/todo.v1.TODOService/DeleteTODO"
-	TODOService_ListTODOs_FullMethodName        = "/todo.v1.TODOService/ListTODOs"
-	TODOService_BulkUpdateStatus_FullMethodName = "/todo.v1.TODOService/BulkUpdateStatus"
-	TODOService_BulkDelete_FullMethodName       = "/todo.v1.TODOService/BulkDelete"
-	TODOService_MoveTODO_FullMethodName         = "/todo.v1.TODOService/MoveTODO"
-	TODOService_CompleteTODO_FullMethodName     = "/todo.v1.TODOService/CompleteTODO"
-	TODOService_ReopenTODO_FullMethodName       = "/todo.v1.TODOService/ReopenTODO"
+	TODOService_CreateTODO_FullMethodName           = "/todo.v1.TODOService/CreateTODO"
+	TODOService_GetTODO_FullMethodName              = "/todo.v1.TODOService/GetTODO"
+	TODOService_UpdateTODO_FullMethodName           = "/todo.v1.TODOService/UpdateTODO"
+	TODOService_PatchTODO_FullMethodName            = "/todo.v1.TODOService/PatchTODO"
+	TODOService_DeleteTODO_FullMethodName           = "/todo.v1.TODOService/DeleteTODO"
+	TODOService_ListTODOs_FullMethodName            = "/todo.v1.TODOService/ListTODOs"
+	TODOService_BulkUpdateStatus_FullMethodName     = "/todo.v1.TODOService/BulkUpdateStatus"
+	TODOService_BulkDelete_FullMethodName           = "/todo.v1.TODOService/BulkDelete"
+	TODOService_BulkUpdateTODOs_FullMethodName      = "/todo.v1.TODOService/BulkUpdateTODOs"
+	TODOService_MoveTODO_FullMethodName             = "/todo.v1.TODOService/MoveTODO"
+	TODOService_ReorderTODO_FullMethodName          = "/todo.v1.TODOService/ReorderTODO"
+	TODOService_CompleteTODO_FullMethodName         = "/todo.v1.TODOService/CompleteTODO"
+	TODOService_ReopenTODO_FullMethodName           = "/todo.v1.TODOService/ReopenTODO"
+	TODOService_SkipOccurrence_FullMethodName       = "/todo.v1.TODOService/SkipOccurrence"
+	TODOService_EndRecurrence_FullMethodName        = "/todo.v1.TODOService/EndRecurrence"
+	TODOService_ListTODORevisions_FullMethodName    = "/todo.v1.TODOService/ListTODORevisions"
+	TODOService_DiffTODORevisions_FullMethodName    = "/todo.v1.TODOService/DiffTODORevisions"
+	TODOService_RestoreTODORevision_FullMethodName  = "/todo.v1.TODOService/RestoreTODORevision"
+	TODOService_ListTrash_FullMethodName            = "/todo.v1.TODOService/ListTrash"
+	TODOService_RestoreTODO_FullMethodName          = "/todo.v1.TODOService/RestoreTODO"
+	TODOService_PurgeTODO_FullMethodName            = "/todo.v1.TODOService/PurgeTODO"
+	TODOService_AddTODODependency_FullMethodName    = "/todo.v1.TODOService/AddTODODependency"
+	TODOService_RemoveTODODependency_FullMethodName = "/todo.v1.TODOService/RemoveTODODependency"
+	TODOService_ListTODODependencies_FullMethodName = "/todo.v1.TODOService/ListTODODependencies"
+	TODOService_GetTODOTree_FullMethodName          = "/todo.v1.TODOService/GetTODOTree"
 )
 
 // TODOServiceClient is the client API for TODOService service.
@@ -43,20 +58,52 @@ type TODOServiceClient interface {
 	GetTODO(ctx context.Context, in *GetTODORequest, opts ...grpc.CallOption) (*GetTODOResponse, error)
 	// Update an existing TODO item.
 	UpdateTODO(ctx context.Context, in *UpdateTODORequest, opts ...grpc.CallOption) (*UpdateTODOResponse, error)
-	// Delete a TODO item by ID.
+	// Update the fields of a TODO item named in a field mask.
+	PatchTODO(ctx context.Context, in *PatchTODORequest, opts ...grpc.CallOption) (*PatchTODOResponse, error)
+	// Move a TODO item and its subtasks to the trash.
 	DeleteTODO(ctx context.Context, in *DeleteTODORequest, opts ...grpc.CallOption) (*DeleteTODOResponse, error)
 	// List TODO items with filtering, sorting, and pagination.
 	ListTODOs(ctx context.Context, in *ListTODOsRequest, opts ...grpc.CallOption) (*ListTODOsResponse, error)
-	// Update status of multiple TODO items.
+	// Update status of multiple TODO items, reporting the outcome for each.
 	BulkUpdateStatus(ctx context.Context, in *BulkUpdateStatusRequest, opts ...grpc.CallOption) (*BulkUpdateStatusResponse, error)
-	// Delete multiple TODO items.
+	// Move multiple TODO items and their subtasks to the trash, reporting the
+	// outcome for each.
 	BulkDelete(ctx context.Context, in *BulkDeleteRequest, opts ...grpc.CallOption) (*BulkDeleteResponse, error)
+	// Change the status, priority, tags, assignee, due date or parent of
+	// multiple TODO items, reporting the outcome for each.
+	BulkUpdateTODOs(ctx context.Context, in *BulkUpdateTODOsRequest, opts ...grpc.CallOption) (*BulkUpdateTODOsResponse, error)
 	// Move TODO item to new position or parent.
 	MoveTODO(ctx context.Context, in *MoveTODORequest, opts ...grpc.CallOption) (*MoveTODOResponse, error)
+	// Place a TODO item just before or after another TODO item.
+	ReorderTODO(ctx context.Context, in *ReorderTODORequest, opts ...grpc.CallOption) (*ReorderTODOResponse, error)
 	// Complete a TODO item.
 	CompleteTODO(ctx context.Context, in *CompleteTODORequest, opts ...grpc.CallOption) (*CompleteTODOResponse, error)
 	// Reopen a completed TODO item.
 	ReopenTODO(ctx context.Context, in *ReopenTODORequest, opts ...grpc.CallOption) (*ReopenTODOResponse, error)
+	// Skip the current occurrence of a recurring TODO item.
+	SkipOccurrence(ctx context.Context, in *SkipOccurrenceRequest, opts ...grpc.CallOption) (*SkipOccurrenceResponse, error)
+	// End the series of a recurring TODO item.
+	EndRecurrence(ctx context.Context, in *EndRecurrenceRequest, opts ...grpc.CallOption) (*EndRecurrenceResponse, error)
+	// List the revision history of a TODO item.
+	ListTODORevisions(ctx context.Context, in *ListTODORevisionsRequest, opts ...grpc.CallOption) (*ListTODORevisionsResponse, error)
+	// Show the changes between two revisions of a TODO item.
+	DiffTODORevisions(ctx context.Context, in *DiffTODORevisionsRequest, opts ...grpc.CallOption) (*DiffTODORevisionsResponse, error)
+	// Restore a TODO item to an earlier revision.
+	RestoreTODORevision(ctx context.Context, in *RestoreTODORevisionRequest, opts ...grpc.CallOption) (*RestoreTODORevisionResponse, error)
+	// List the caller's TODO items in the trash.
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// Restore a TODO item and the subtasks deleted with it from the trash.
+	RestoreTODO(ctx context.Context, in *RestoreTODORequest, opts ...grpc.CallOption) (*RestoreTODOResponse, error)
+	// Permanently delete a TODO item in the trash.
+	PurgeTODO(ctx context.Context, in *PurgeTODORequest, opts ...grpc.CallOption) (*PurgeTODOResponse, error)
+	// Record that a TODO item is blocked by another TODO item.
+	AddTODODependency(ctx context.Context, in *AddTODODependencyRequest, opts ...grpc.CallOption) (*AddTODODependencyResponse, error)
+	// Remove a dependency of a TODO item on another TODO item.
+	RemoveTODODependency(ctx context.Context, in *RemoveTODODependencyRequest, opts ...grpc.CallOption) (*RemoveTODODependencyResponse, error)
+	// List the TODO items that a TODO item is blocked by and those it blocks.
+	ListTODODependencies(ctx context.Context, in *ListTODODependenciesRequest, opts ...grpc.CallOption) (*ListTODODependenciesResponse, error)
+	// Get a TODO item with its subtasks, nested to a given depth.
+	GetTODOTree(ctx context.Context, in *GetTODOTreeRequest, opts ...grpc.CallOption) (*GetTODOTreeResponse, error)
 }
 
 type tODOServiceClient struct {
@@ -97,6 +144,16 @@ func (c *tODOServiceClient) UpdateTODO(ctx context.Context, in *UpdateTODOReques
 	return out, nil
 }
 
+func (c *tODOServiceClient) PatchTODO(ctx context.Context, in *PatchTODORequest, opts ...grpc.CallOption) (*PatchTODOResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchTODOResponse)
+	err := c.cc.Invoke(ctx, TODOService_PatchTODO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tODOServiceClient) DeleteTODO(ctx context.Context, in *DeleteTODORequest, opts ...grpc.CallOption) (*DeleteTODOResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteTODOResponse)
@@ -137,6 +194,16 @@ func (c *tODOServiceClient) BulkDelete(ctx context.Context, in *BulkDeleteReques
 	return out, nil
 }
 
+func (c *tODOServiceClient) BulkUpdateTODOs(ctx context.Context, in *BulkUpdateTODOsRequest, opts ...grpc.CallOption) (*BulkUpdateTODOsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateTODOsResponse)
+	err := c.cc.Invoke(ctx, TODOService_BulkUpdateTODOs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tODOServiceClient) MoveTODO(ctx context.Context, in *MoveTODORequest, opts ...grpc.CallOption) (*MoveTODOResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MoveTODOResponse)
@@ -147,6 +214,16 @@ func (c *tODOServiceClient) MoveTODO(ctx context.Context, in *MoveTODORequest, o
 	return out, nil
 }
 
+func (c *tODOServiceClient) ReorderTODO(ctx context.Context, in *ReorderTODORequest, opts ...grpc.CallOption) (*ReorderTODOResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderTODOResponse)
+	err := c.cc.Invoke(ctx, TODOService_ReorderTODO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tODOServiceClient) CompleteTODO(ctx context.Context, in *CompleteTODORequest, opts ...grpc.CallOption) (*CompleteTODOResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CompleteTODOResponse)
@@ -167,6 +244,126 @@ func (c *tODOServiceClient) ReopenTODO(ctx context.Context, in *ReopenTODOReques
 	return out, nil
 }
 
+func (c *tODOServiceClient) SkipOccurrence(ctx context.Context, in *SkipOccurrenceRequest, opts ...grpc.CallOption) (*SkipOccurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipOccurrenceResponse)
+	err := c.cc.Invoke(ctx, TODOService_SkipOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) EndRecurrence(ctx context.Context, in *EndRecurrenceRequest, opts ...grpc.CallOption) (*EndRecurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndRecurrenceResponse)
+	err := c.cc.Invoke(ctx, TODOService_EndRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) ListTODORevisions(ctx context.Context, in *ListTODORevisionsRequest, opts ...grpc.CallOption) (*ListTODORevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTODORevisionsResponse)
+	err := c.cc.Invoke(ctx, TODOService_ListTODORevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) DiffTODORevisions(ctx context.Context, in *DiffTODORevisionsRequest, opts ...grpc.CallOption) (*DiffTODORevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffTODORevisionsResponse)
+	err := c.cc.Invoke(ctx, TODOService_DiffTODORevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) RestoreTODORevision(ctx context.Context, in *RestoreTODORevisionRequest, opts ...grpc.CallOption) (*RestoreTODORevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTODORevisionResponse)
+	err := c.cc.Invoke(ctx, TODOService_RestoreTODORevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, TODOService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) RestoreTODO(ctx context.Context, in *RestoreTODORequest, opts ...grpc.CallOption) (*RestoreTODOResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTODOResponse)
+	err := c.cc.Invoke(ctx, TODOService_RestoreTODO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) PurgeTODO(ctx context.Context, in *PurgeTODORequest, opts ...grpc.CallOption) (*PurgeTODOResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTODOResponse)
+	err := c.cc.Invoke(ctx, TODOService_PurgeTODO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) AddTODODependency(ctx context.Context, in *AddTODODependencyRequest, opts ...grpc.CallOption) (*AddTODODependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTODODependencyResponse)
+	err := c.cc.Invoke(ctx, TODOService_AddTODODependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) RemoveTODODependency(ctx context.Context, in *RemoveTODODependencyRequest, opts ...grpc.CallOption) (*RemoveTODODependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTODODependencyResponse)
+	err := c.cc.Invoke(ctx, TODOService_RemoveTODODependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) ListTODODependencies(ctx context.Context, in *ListTODODependenciesRequest, opts ...grpc.CallOption) (*ListTODODependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTODODependenciesResponse)
+	err := c.cc.Invoke(ctx, TODOService_ListTODODependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tODOServiceClient) GetTODOTree(ctx context.Context, in *GetTODOTreeRequest, opts ...grpc.CallOption) (*GetTODOTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTODOTreeResponse)
+	err := c.cc.Invoke(ctx, TODOService_GetTODOTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TODOServiceServer is the server API for TODOService service.
 // All implementations should embed UnimplementedTODOServiceServer
 // for forward compatibility.
@@ -179,20 +376,52 @@ type TODOServiceServer interface {
 	GetTODO(context.Context, *GetTODORequest) (*GetTODOResponse, error)
 	// Update an existing TODO item.
 	UpdateTODO(context.Context, *UpdateTODORequest) (*UpdateTODOResponse, error)
-	// Delete a TODO item by ID.
+	// Update the fields of a TODO item named in a field mask.
+	PatchTODO(context.Context, *PatchTODORequest) (*PatchTODOResponse, error)
+	// Move a TODO item and its subtasks to the trash.
 	DeleteTODO(context.Context, *DeleteTODORequest) (*DeleteTODOResponse, error)
 	// List TODO items with filtering, sorting, and pagination.
 	ListTODOs(context.Context, *ListTODOsRequest) (*ListTODOsResponse, error)
-	// Update status of multiple TODO items.
+	// Update status of multiple TODO items, reporting the outcome for each.
 	BulkUpdateStatus(context.Context, *BulkUpdateStatusRequest) (*BulkUpdateStatusResponse, error)
-	// Delete multiple TODO items.
+	// Move multiple TODO items and their subtasks to the trash, reporting the
+	// outcome for each.
 	BulkDelete(context.Context, *BulkDeleteRequest) (*BulkDeleteResponse, error)
+	// Change the status, priority, tags, assignee, due date or parent of
+	// multiple TODO items, reporting the outcome for each.
+	BulkUpdateTODOs(context.Context, *BulkUpdateTODOsRequest) (*BulkUpdateTODOsResponse, error)
 	// Move TODO item to new position or parent.
 	MoveTODO(context.Context, *MoveTODORequest) (*MoveTODOResponse, error)
+	// Place a TODO item just before or after another TODO item.
+	ReorderTODO(context.Context, *ReorderTODORequest) (*ReorderTODOResponse, error)
 	// Complete a TODO item.
 	CompleteTODO(context.Context, *CompleteTODORequest) (*CompleteTODOResponse, error)
 	// Reopen a completed TODO item.
 	ReopenTODO(context.Context, *ReopenTODORequest) (*ReopenTODOResponse, error)
+	// Skip the current occurrence of a recurring TODO item.
+	SkipOccurrence(context.Context, *SkipOccurrenceRequest) (*SkipOccurrenceResponse, error)
+	// End the series of a recurring TODO item.
+	EndRecurrence(context.Context, *EndRecurrenceRequest) (*EndRecurrenceResponse, error)
+	// List the revision history of a TODO item.
+	ListTODORevisions(context.Context, *ListTODORevisionsRequest) (*ListTODORevisionsResponse, error)
+	// Show the changes between two revisions of a TODO item.
+	DiffTODORevisions(context.Context, *DiffTODORevisionsRequest) (*DiffTODORevisionsResponse, error)
+	// Restore a TODO item to an earlier revision.
+	RestoreTODORevision(context.Context, *RestoreTODORevisionRequest) (*RestoreTODORevisionResponse, error)
+	// List the caller's TODO items in the trash.
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// Restore a TODO item and the subtasks deleted with it from the trash.
+	RestoreTODO(context.Context, *RestoreTODORequest) (*RestoreTODOResponse, error)
+	// Permanently delete a TODO item in the trash.
+	PurgeTODO(context.Context, *PurgeTODORequest) (*PurgeTODOResponse, error)
+	// Record that a TODO item is blocked by another TODO item.
+	AddTODODependency(context.Context, *AddTODODependencyRequest) (*AddTODODependencyResponse, error)
+	// Remove a dependency of a TODO item on another TODO item.
+	RemoveTODODependency(context.Context, *RemoveTODODependencyRequest) (*RemoveTODODependencyResponse, error)
+	// List the TODO items that a TODO item is blocked by and those it blocks.
+	ListTODODependencies(context.Context, *ListTODODependenciesRequest) (*ListTODODependenciesResponse, error)
+	// Get a TODO item with its subtasks, nested to a given depth.
+	GetTODOTree(context.Context, *GetTODOTreeRequest) (*GetTODOTreeResponse, error)
 }
 
 // UnimplementedTODOServiceServer should be embedded to have
@@ -211,6 +440,9 @@ func (UnimplementedTODOServiceServer) GetTODO(context.Context, *GetTODORequest)
 func (UnimplementedTODOServiceServer) UpdateTODO(context.Context, *UpdateTODORequest) (*UpdateTODOResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateTODO not implemented")
 }
+func (UnimplementedTODOServiceServer) PatchTODO(context.Context, *PatchTODORequest) (*PatchTODOResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchTODO not implemented")
+}
 func (UnimplementedTODOServiceServer) DeleteTODO(context.Context, *DeleteTODORequest) (*DeleteTODOResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTODO not implemented")
 }
@@ -223,15 +455,57 @@ func (UnimplementedTODOServiceServer) BulkUpdateStatus(context.Context, *BulkUpd
 func (UnimplementedTODOServiceServer) BulkDelete(context.Context, *BulkDeleteRequest) (*BulkDeleteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BulkDelete not implemented")
 }
+func (UnimplementedTODOServiceServer) BulkUpdateTODOs(context.Context, *BulkUpdateTODOsRequest) (*BulkUpdateTODOsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkUpdateTODOs not implemented")
+}
 func (UnimplementedTODOServiceServer) MoveTODO(context.Context, *MoveTODORequest) (*MoveTODOResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MoveTODO not implemented")
 }
+func (UnimplementedTODOServiceServer) ReorderTODO(context.Context, *ReorderTODORequest) (*ReorderTODOResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderTODO not implemented")
+}
 func (UnimplementedTODOServiceServer) CompleteTODO(context.Context, *CompleteTODORequest) (*CompleteTODOResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteTODO not implemented")
 }
 func (UnimplementedTODOServiceServer) ReopenTODO(context.Context, *ReopenTODORequest) (*ReopenTODOResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReopenTODO not implemented")
 }
+func (UnimplementedTODOServiceServer) SkipOccurrence(context.Context, *SkipOccurrenceRequest) (*SkipOccurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipOccurrence not implemented")
+}
+func (UnimplementedTODOServiceServer) EndRecurrence(context.Context, *EndRecurrenceRequest) (*EndRecurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndRecurrence not implemented")
+}
+func (UnimplementedTODOServiceServer) ListTODORevisions(context.Context, *ListTODORevisionsRequest) (*ListTODORevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTODORevisions not implemented")
+}
+func (UnimplementedTODOServiceServer) DiffTODORevisions(context.Context, *DiffTODORevisionsRequest) (*DiffTODORevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffTODORevisions not implemented")
+}
+func (UnimplementedTODOServiceServer) RestoreTODORevision(context.Context, *RestoreTODORevisionRequest) (*RestoreTODORevisionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTODORevision not implemented")
+}
+func (UnimplementedTODOServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedTODOServiceServer) RestoreTODO(context.Context, *RestoreTODORequest) (*RestoreTODOResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTODO not implemented")
+}
+func (UnimplementedTODOServiceServer) PurgeTODO(context.Context, *PurgeTODORequest) (*PurgeTODOResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTODO not implemented")
+}
+func (UnimplementedTODOServiceServer) AddTODODependency(context.Context, *AddTODODependencyRequest) (*AddTODODependencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTODODependency not implemented")
+}
+func (UnimplementedTODOServiceServer) RemoveTODODependency(context.Context, *RemoveTODODependencyRequest) (*RemoveTODODependencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTODODependency not implemented")
+}
+func (UnimplementedTODOServiceServer) ListTODODependencies(context.Context, *ListTODODependenciesRequest) (*ListTODODependenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTODODependencies not implemented")
+}
+func (UnimplementedTODOServiceServer) GetTODOTree(context.Context, *GetTODOTreeRequest) (*GetTODOTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTODOTree not implemented")
+}
 func (UnimplementedTODOServiceServer) testEmbeddedByValue() {}
 
 // UnsafeTODOServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -306,6 +580,24 @@ func _TODOService_UpdateTODO_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TODOService_PatchTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchTODORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).PatchTODO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_PatchTODO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).PatchTODO(ctx, req.(*PatchTODORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TODOService_DeleteTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteTODORequest)
 	if err := dec(in); err != nil {
@@ -378,6 +670,24 @@ func _TODOService_BulkDelete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TODOService_BulkUpdateTODOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateTODOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).BulkUpdateTODOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_BulkUpdateTODOs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).BulkUpdateTODOs(ctx, req.(*BulkUpdateTODOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TODOService_MoveTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MoveTODORequest)
 	if err := dec(in); err != nil {
@@ -396,6 +706,24 @@ func _TODOService_MoveTODO_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TODOService_ReorderTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderTODORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).ReorderTODO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_ReorderTODO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).ReorderTODO(ctx, req.(*ReorderTODORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TODOService_CompleteTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteTODORequest)
 	if err := dec(in); err != nil {
@@ -432,6 +760,222 @@ func _TODOService_ReopenTODO_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TODOService_SkipOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).SkipOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_SkipOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).SkipOccurrence(ctx, req.(*SkipOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_EndRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).EndRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_EndRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).EndRecurrence(ctx, req.(*EndRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_ListTODORevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTODORevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).ListTODORevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_ListTODORevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).ListTODORevisions(ctx, req.(*ListTODORevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_DiffTODORevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTODORevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).DiffTODORevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_DiffTODORevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).DiffTODORevisions(ctx, req.(*DiffTODORevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_RestoreTODORevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTODORevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).RestoreTODORevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_RestoreTODORevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).RestoreTODORevision(ctx, req.(*RestoreTODORevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_RestoreTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTODORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).RestoreTODO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_RestoreTODO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).RestoreTODO(ctx, req.(*RestoreTODORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_PurgeTODO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTODORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).PurgeTODO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_PurgeTODO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).PurgeTODO(ctx, req.(*PurgeTODORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_AddTODODependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTODODependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).AddTODODependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_AddTODODependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).AddTODODependency(ctx, req.(*AddTODODependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_RemoveTODODependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTODODependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).RemoveTODODependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_RemoveTODODependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).RemoveTODODependency(ctx, req.(*RemoveTODODependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_ListTODODependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTODODependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).ListTODODependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_ListTODODependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).ListTODODependencies(ctx, req.(*ListTODODependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TODOService_GetTODOTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTODOTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TODOServiceServer).GetTODOTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TODOService_GetTODOTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TODOServiceServer).GetTODOTree(ctx, req.(*GetTODOTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TODOService_ServiceDesc is the grpc.ServiceDesc for TODOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -451,6 +995,10 @@ var TODOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTODO",
 			Handler:    _TODOService_UpdateTODO_Handler,
 		},
+		{
+			MethodName: "PatchTODO",
+			Handler:    _TODOService_PatchTODO_Handler,
+		},
 		{
 			MethodName: "DeleteTODO",
 			Handler:    _TODOService_DeleteTODO_Handler,
@@ -467,10 +1015,18 @@ var TODOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkDelete",
 			Handler:    _TODOService_BulkDelete_Handler,
 		},
+		{
+			MethodName: "BulkUpdateTODOs",
+			Handler:    _TODOService_BulkUpdateTODOs_Handler,
+		},
 		{
 			MethodName: "MoveTODO",
 			Handler:    _TODOService_MoveTODO_Handler,
 		},
+		{
+			MethodName: "ReorderTODO",
+			Handler:    _TODOService_ReorderTODO_Handler,
+		},
 		{
 			MethodName: "CompleteTODO",
 			Handler:    _TODOService_CompleteTODO_Handler,
@@ -479,6 +1035,54 @@ var TODOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReopenTODO",
 			Handler:    _TODOService_ReopenTODO_Handler,
 		},
+		{
+			MethodName: "SkipOccurrence",
+			Handler:    _TODOService_SkipOccurrence_Handler,
+		},
+		{
+			MethodName: "EndRecurrence",
+			Handler:    _TODOService_EndRecurrence_Handler,
+		},
+		{
+			MethodName: "ListTODORevisions",
+			Handler:    _TODOService_ListTODORevisions_Handler,
+		},
+		{
+			MethodName: "DiffTODORevisions",
+			Handler:    _TODOService_DiffTODORevisions_Handler,
+		},
+		{
+			MethodName: "RestoreTODORevision",
+			Handler:    _TODOService_RestoreTODORevision_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _TODOService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreTODO",
+			Handler:    _TODOService_RestoreTODO_Handler,
+		},
+		{
+			MethodName: "PurgeTODO",
+			Handler:    _TODOService_PurgeTODO_Handler,
+		},
+		{
+			MethodName: "AddTODODependency",
+			Handler:    _TODOService_AddTODODependency_Handler,
+		},
+		{
+			MethodName: "RemoveTODODependency",
+			Handler:    _TODOService_RemoveTODODependency_Handler,
+		},
+		{
+			MethodName: "ListTODODependencies",
+			Handler:    _TODOService_ListTODODependencies_Handler,
+		},
+		{
+			MethodName: "GetTODOTree",
+			Handler:    _TODOService_GetTODOTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "todo/v1/todo_service.proto",
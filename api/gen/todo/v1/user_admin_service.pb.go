@@ -0,0 +1,96 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: todo/v1/user_admin_service.proto
+
+package todov1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_todo_v1_user_admin_service_proto protoreflect.FileDescriptor
+
+const file_todo_v1_user_admin_service_proto_rawDesc = "" +
+	"\n" +
+	" todo/v1/user_admin_service.proto\x12\atodo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18todo/v1/user_admin.proto2\xf2\x05\n" +
+	"\x10UserAdminService\x12[\n" +
+	"\tListUsers\x12\x19.todo.v1.ListUsersRequest\x1a\x1a.todo.v1.ListUsersResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/users\x12r\n" +
+	"\n" +
+	"UnlockUser\x12\x1a.todo.v1.UnlockUserRequest\x1a\x1b.todo.v1.UnlockUserResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/admin/users/{user_id}/unlock\x12\x82\x01\n" +
+	"\x0eDeactivateUser\x12\x1e.todo.v1.DeactivateUserRequest\x1a\x1f.todo.v1.DeactivateUserResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/admin/users/{user_id}/deactivate\x12\x82\x01\n" +
+	"\x0eReactivateUser\x12\x1e.todo.v1.ReactivateUserRequest\x1a\x1f.todo.v1.ReactivateUserResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/admin/users/{user_id}/reactivate\x12\x98\x01\n" +
+	"\x12ForcePasswordReset\x12\".todo.v1.ForcePasswordResetRequest\x1a#.todo.v1.ForcePasswordResetResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/admin/users/{user_id}/force-password-reset\x12h\n" +
+	"\n" +
+	"DeleteUser\x12\x1a.todo.v1.DeleteUserRequest\x1a\x1b.todo.v1.DeleteUserResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/admin/users/{user_id}BA\n" +
+	"\atodo.v1P\x01Z4github.com/venslupro/todo-api/api/gen/todo/v1;todov1b\x06proto3"
+
+var file_todo_v1_user_admin_service_proto_goTypes = []any{
+	(*ListUsersRequest)(nil),           // 0: todo.v1.ListUsersRequest
+	(*UnlockUserRequest)(nil),          // 1: todo.v1.UnlockUserRequest
+	(*DeactivateUserRequest)(nil),      // 2: todo.v1.DeactivateUserRequest
+	(*ReactivateUserRequest)(nil),      // 3: todo.v1.ReactivateUserRequest
+	(*ForcePasswordResetRequest)(nil),  // 4: todo.v1.ForcePasswordResetRequest
+	(*DeleteUserRequest)(nil),          // 5: todo.v1.DeleteUserRequest
+	(*ListUsersResponse)(nil),          // 6: todo.v1.ListUsersResponse
+	(*UnlockUserResponse)(nil),         // 7: todo.v1.UnlockUserResponse
+	(*DeactivateUserResponse)(nil),     // 8: todo.v1.DeactivateUserResponse
+	(*ReactivateUserResponse)(nil),     // 9: todo.v1.ReactivateUserResponse
+	(*ForcePasswordResetResponse)(nil), // 10: todo.v1.ForcePasswordResetResponse
+	(*DeleteUserResponse)(nil),         // 11: todo.v1.DeleteUserResponse
+}
+var file_todo_v1_user_admin_service_proto_depIdxs = []int32{
+	0,  // 0: todo.v1.UserAdminService.ListUsers:input_type -> todo.v1.ListUsersRequest
+	1,  // 1: todo.v1.UserAdminService.UnlockUser:input_type -> todo.v1.UnlockUserRequest
+	2,  // 2: todo.v1.UserAdminService.DeactivateUser:input_type -> todo.v1.DeactivateUserRequest
+	3,  // 3: todo.v1.UserAdminService.ReactivateUser:input_type -> todo.v1.ReactivateUserRequest
+	4,  // 4: todo.v1.UserAdminService.ForcePasswordReset:input_type -> todo.v1.ForcePasswordResetRequest
+	5,  // 5: todo.v1.UserAdminService.DeleteUser:input_type -> todo.v1.DeleteUserRequest
+	6,  // 6: todo.v1.UserAdminService.ListUsers:output_type -> todo.v1.ListUsersResponse
+	7,  // 7: todo.v1.UserAdminService.UnlockUser:output_type -> todo.v1.UnlockUserResponse
+	8,  // 8: todo.v1.UserAdminService.DeactivateUser:output_type -> todo.v1.DeactivateUserResponse
+	9,  // 9: todo.v1.UserAdminService.ReactivateUser:output_type -> todo.v1.ReactivateUserResponse
+	10, // 10: todo.v1.UserAdminService.ForcePasswordReset:output_type -> todo.v1.ForcePasswordResetResponse
+	11, // 11: todo.v1.UserAdminService.DeleteUser:output_type -> todo.v1.DeleteUserResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_todo_v1_user_admin_service_proto_init() }
+func file_todo_v1_user_admin_service_proto_init() {
+	if File_todo_v1_user_admin_service_proto != nil {
+		return
+	}
+	file_todo_v1_user_admin_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_v1_user_admin_service_proto_rawDesc), len(file_todo_v1_user_admin_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_todo_v1_user_admin_service_proto_goTypes,
+		DependencyIndexes: file_todo_v1_user_admin_service_proto_depIdxs,
+	}.Build()
+	File_todo_v1_user_admin_service_proto = out.File
+	file_todo_v1_user_admin_service_proto_goTypes = nil
+	file_todo_v1_user_admin_service_proto_depIdxs = nil
+}
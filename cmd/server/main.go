@@ -15,7 +15,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
-	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
 	"github.com/venslupro/todo-api/internal/app/handlers"
 	"github.com/venslupro/todo-api/internal/app/routes"
 	"github.com/venslupro/todo-api/internal/app/service"
@@ -66,22 +65,126 @@ func main() {
 	// Initialize repositories
 	userRepo := database.NewPostgresUserRepository(dbRepo.DB())
 	teamRepo := database.NewPostgresTeamRepository(dbRepo.DB())
+	sessionRepo := database.NewPostgresSessionRepository(dbRepo.DB())
+	userTokenRepo := database.NewPostgresUserTokenRepository(dbRepo.DB())
+	userIdentityRepo := database.NewPostgresUserIdentityRepository(dbRepo.DB())
+	accessTokenRepo := database.NewPostgresPersonalAccessTokenRepository(dbRepo.DB())
+	twoFactorRepo := database.NewPostgresTwoFactorRepository(dbRepo.DB())
+	activityRepo := database.NewPostgresActivityRepository(dbRepo.DB())
 	todoRepo := dbRepo
-	_ = redis.NewCacheRepository(redisClient) // cacheRepo - will be used when caching is implemented
+	mediaRepo := database.NewPostgresMediaRepository(dbRepo.DB())
+	reminderRepo := database.NewPostgresReminderRepository(dbRepo.DB())
+	commentRepo := database.NewPostgresCommentRepository(dbRepo.DB())
+	revisionRepo := database.NewPostgresTODORevisionRepository(dbRepo.DB())
+	dependencyRepo := database.NewPostgresTODODependencyRepository(dbRepo.DB())
+	cacheRepo := redis.NewCacheRepository(redisClient)
+
+	// Initialize media storage
+	mediaStorage, err := newStorage(&cfg.Storage)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
+
+	// Initialize mailer
+	accountMailer, mailerCloser, err := newMailer(&cfg.Mail)
+	if err != nil {
+		log.Fatalf("Failed to initialize mailer: %v", err)
+	}
+	defer mailerCloser.Close()
 
 	// Initialize JWT manager
-	jwtMgr := auth.NewJWTManager(cfg.Auth.JWTSecret, cfg.Auth.AccessTokenExpiry)
+	jwtMgr, err := newJWTManager(&cfg.Auth, cfg.Server.Environment)
+	if err != nil {
+		log.Fatalf("Failed to initialize JWT manager: %v", err)
+	}
+	tokenDenylist := redis.NewTokenDenylist(cacheRepo, cfg.Auth.AccessTokenExpiry)
+
+	// Initialize password policy
+	passwordPolicy := &auth.PasswordPolicy{
+		MinLength:        cfg.Auth.PasswordMinLength,
+		RequireUppercase: cfg.Auth.PasswordRequireUppercase,
+		RequireLowercase: cfg.Auth.PasswordRequireLowercase,
+		RequireNumber:    cfg.Auth.PasswordRequireNumber,
+		RequireSpecial:   cfg.Auth.PasswordRequireSpecial,
+	}
+	if cfg.Auth.PasswordDenyListFile != "" {
+		if err := passwordPolicy.LoadDenyList(cfg.Auth.PasswordDenyListFile); err != nil {
+			log.Fatalf("Failed to load password deny list: %v", err)
+		}
+	}
+
+	// Initialize password hashing
+	passwordHasher := &auth.PasswordHasher{
+		Algorithm:     cfg.Auth.PasswordHashAlgorithm,
+		BcryptCost:    cfg.Auth.PasswordBcryptCost,
+		Argon2Time:    uint32(cfg.Auth.PasswordArgon2Time),
+		Argon2Memory:  uint32(cfg.Auth.PasswordArgon2Memory),
+		Argon2Threads: uint8(cfg.Auth.PasswordArgon2Threads),
+	}
+	if err := passwordHasher.Validate(); err != nil {
+		log.Fatalf("Invalid password hashing configuration: %v", err)
+	}
+
+	// Initialize login throttling
+	loginGuard := service.NewLoginGuard(redis.NewLoginAttemptTracker(cacheRepo), activityRepo,
+		auth.LockoutPolicy{
+			MaxFailures:     cfg.Auth.LoginMaxFailures,
+			Window:          cfg.Auth.LoginFailureWindow,
+			LockoutDuration: cfg.Auth.LoginLockoutDuration,
+			BaseDelay:       cfg.Auth.LoginBaseDelay,
+			MaxDelay:        cfg.Auth.LoginMaxDelay,
+		},
+		auth.LockoutPolicy{
+			MaxFailures:     cfg.Auth.LoginIPMaxFailures,
+			Window:          cfg.Auth.LoginFailureWindow,
+			LockoutDuration: cfg.Auth.LoginLockoutDuration,
+		},
+	)
+
+	// Initialize single sign-on providers
+	ssoProviders, err := newSSOProviders(context.Background(), &cfg.SSO)
+	if err != nil {
+		log.Fatalf("Failed to initialize single sign-on: %v", err)
+	}
 
 	// Initialize WebSocket service
 	websocketService := service.NewWebSocketService()
 
 	// Initialize services
-	authService := service.NewAuthService(userRepo, jwtMgr)
+	authService := service.NewAuthService(userRepo, sessionRepo, jwtMgr, tokenDenylist, passwordPolicy, passwordHasher, cfg.Auth.RefreshTokenExpiry, loginGuard)
+	accessTokenService := service.NewPersonalAccessTokenService(accessTokenRepo, userRepo)
+	accountService := service.NewAccountService(userRepo, userTokenRepo, authService, accessTokenService, accountMailer,
+		cfg.Mail.BaseURL, cfg.Auth.EmailVerificationExpiry, cfg.Auth.PasswordResetExpiry)
+	ssoService := service.NewSSOService(userRepo, userIdentityRepo, authService,
+		redis.NewSSOStateStore(cacheRepo), cfg.SSO.StateTTL, ssoProviders...)
+	twoFactorService := service.NewTwoFactorService(userRepo, twoFactorRepo, authService, cfg.Auth.TwoFactorIssuer)
+	accountDeletionService := service.NewAccountDeletionService(userRepo, authService, cfg.Auth.AccountDeletionGrace)
+	userAdminService := service.NewUserAdminService(userRepo, activityRepo, authService, accountService, loginGuard)
 	teamService := service.NewTeamService(teamRepo, websocketService)
-	todoService := service.NewTODOService(todoRepo, websocketService)
+	permissionService := service.NewPermissionService(todoRepo, teamRepo)
+	todoService := service.NewTODOService(todoRepo, revisionRepo, dependencyRepo, websocketService, permissionService)
+	mediaService := service.NewMediaService(mediaRepo, mediaStorage)
+	trashService := service.NewTrashService(todoRepo, mediaStorage, cfg.Server.TrashRetention, websocketService)
+	reminderService := service.NewReminderService(reminderRepo, todoRepo, permissionService, websocketService)
+	commentService := service.NewCommentService(commentRepo, todoRepo, permissionService, websocketService)
+	bulkService := service.NewBulkService(todoService, permissionService)
 
 	// Initialize handlers
-	todoHandler := handlers.NewTODOHandler(todoService)
+	todoHandler := handlers.NewTODOHandler(todoService, trashService, bulkService)
+	apiHandlers := &grpcHandlers{
+		auth:     handlers.NewAuthHandler(authService, accountService, ssoService, accessTokenService, twoFactorService, accountDeletionService, jwtMgr),
+		todo:     todoHandler,
+		team:     handlers.NewTeamHandler(teamService),
+		media:    handlers.NewMediaHandler(mediaService),
+		reminder: handlers.NewReminderHandler(reminderService),
+		comment:  handlers.NewCommentHandler(commentService),
+		realtime: handlers.NewRealtimeHandler(websocketService, permissionService),
+		admin:    handlers.NewUserAdminHandler(userAdminService),
+		system: handlers.NewSystemHandler(cfg.Server.Environment,
+			handlers.HealthCheck{Name: "database", Check: dbRepo.Ping},
+			handlers.HealthCheck{Name: "redis", Check: redisClient.Ping},
+		),
+	}
 	websocketHandler := handlers.NewWebSocketHandler(websocketService, authService, teamService)
 
 	// Start WebSocket service
@@ -93,8 +196,8 @@ func main() {
 		log.Fatalf("Failed to listen on gRPC port: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	todov1.RegisterTODOServiceServer(grpcServer, todoHandler)
+	grpcServer := newGRPCServer(jwtMgr, tokenDenylist, accessTokenService, teamRepo, userRepo,
+		redis.NewIdempotencyStore(cacheRepo), cfg.Server.IdempotencyKeyTTL, apiHandlers)
 
 	// Start gRPC server in a goroutine
 	go func() {
@@ -109,14 +212,36 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Rotate asymmetric signing keys in the background
+	if keys := jwtMgr.KeySet(); keys != nil && cfg.Auth.JWTKeyRotationInterval > 0 {
+		keys.StartRotation(ctx, cfg.Auth.JWTKeyRotationInterval, func(err error) {
+			log.Printf("Failed to rotate JWT signing key: %v", err)
+		})
+	}
+
+	// Delete accounts whose grace period has passed
+	if cfg.Auth.AccountPurgeInterval > 0 {
+		accountDeletionService.StartPurging(ctx, cfg.Auth.AccountPurgeInterval)
+	}
+
+	// Fire due TODO reminders
+	if cfg.Server.ReminderPollInterval > 0 {
+		reminderService.StartScheduler(ctx, cfg.Server.ReminderPollInterval)
+	}
+
+	// Purge TODOs whose retention period in the trash has passed
+	if cfg.Server.TrashPurgeInterval > 0 {
+		trashService.StartPurging(ctx, cfg.Server.TrashPurgeInterval)
+	}
+
 	// Create main HTTP mux
 	httpMux := http.NewServeMux()
 
 	// Create gRPC-Gateway mux
-	gatewayMux := runtime.NewServeMux()
+	gatewayMux := runtime.NewServeMux(gatewayOptions()...)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
-	err = todov1.RegisterTODOServiceHandlerFromEndpoint(ctx, gatewayMux, fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort), opts)
+	err = registerGatewayHandlers(ctx, gatewayMux, fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort), opts)
 	if err != nil {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
@@ -130,6 +255,11 @@ func main() {
 	// Register search routes
 	routes.RegisterSearchRoutes(httpMux, todoHandler)
 
+	// Publish the token verification keys when signing asymmetrically
+	if keys := jwtMgr.KeySet(); keys != nil {
+		routes.RegisterJWKSRoutes(httpMux, handlers.NewJWKSHandler(keys))
+	}
+
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.Server.HTTPPort),
 		Handler:           httpMux,
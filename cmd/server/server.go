@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/app/handlers"
+	"github.com/venslupro/todo-api/internal/app/service"
+	"github.com/venslupro/todo-api/internal/config"
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"github.com/venslupro/todo-api/internal/pkg/idempotency"
+	"github.com/venslupro/todo-api/internal/pkg/mailer"
+	"github.com/venslupro/todo-api/internal/pkg/middleware"
+	"github.com/venslupro/todo-api/internal/pkg/sso"
+	"github.com/venslupro/todo-api/internal/pkg/storage"
+)
+
+// grpcHandlers groups the gRPC service implementations exposed by the server
+type grpcHandlers struct {
+	auth     *handlers.AuthHandler
+	todo     *handlers.TODOHandler
+	team     *handlers.TeamHandler
+	media    *handlers.MediaHandler
+	reminder *handlers.ReminderHandler
+	comment  *handlers.CommentHandler
+	realtime *handlers.RealtimeHandler
+	admin    *handlers.UserAdminHandler
+	system   *handlers.SystemHandler
+}
+
+// newGRPCServer creates a gRPC server with the authentication,
+// authorization and idempotency interceptor chains installed and all
+// services registered
+func newGRPCServer(
+	jwtMgr *auth.JWTManager,
+	revocations auth.RevocationStore,
+	tokens auth.PersonalAccessTokenVerifier,
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	idempotencyStore idempotency.Store,
+	idempotencyTTL time.Duration,
+	h *grpcHandlers,
+) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			middleware.AuthInterceptor(jwtMgr, revocations, tokens),
+			middleware.AuthorizationInterceptor(teamRepo, userRepo),
+			middleware.IdempotencyInterceptor(idempotencyStore, idempotencyTTL),
+		),
+		grpc.ChainStreamInterceptor(
+			middleware.AuthStreamInterceptor(jwtMgr, revocations, tokens),
+			middleware.AuthorizationStreamInterceptor(),
+		),
+	)
+
+	todov1.RegisterAuthServiceServer(server, h.auth)
+	todov1.RegisterTODOServiceServer(server, h.todo)
+	todov1.RegisterTeamServiceServer(server, h.team)
+	todov1.RegisterMediaServiceServer(server, h.media)
+	todov1.RegisterReminderServiceServer(server, h.reminder)
+	todov1.RegisterCommentServiceServer(server, h.comment)
+	todov1.RegisterRealtimeServiceServer(server, h.realtime)
+	todov1.RegisterUserAdminServiceServer(server, h.admin)
+	todov1.RegisterSystemServiceServer(server, h.system)
+
+	return server
+}
+
+// registerGatewayHandlers registers every service on the gRPC-Gateway mux
+func registerGatewayHandlers(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	registrations := map[string]func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		"auth":     todov1.RegisterAuthServiceHandlerFromEndpoint,
+		"todo":     todov1.RegisterTODOServiceHandlerFromEndpoint,
+		"team":     todov1.RegisterTeamServiceHandlerFromEndpoint,
+		"media":    todov1.RegisterMediaServiceHandlerFromEndpoint,
+		"reminder": todov1.RegisterReminderServiceHandlerFromEndpoint,
+		"comment":  todov1.RegisterCommentServiceHandlerFromEndpoint,
+		"realtime": todov1.RegisterRealtimeServiceHandlerFromEndpoint,
+		"admin":    todov1.RegisterUserAdminServiceHandlerFromEndpoint,
+		"system":   todov1.RegisterSystemServiceHandlerFromEndpoint,
+	}
+
+	for name, register := range registrations {
+		if err := register(ctx, mux, endpoint, opts); err != nil {
+			return fmt.Errorf("failed to register %s gateway: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// gatewayOptions configures the gRPC-Gateway mux. The Idempotency-Key header
+// is passed on to the gRPC server. The ETag of a TODO and the marker of a
+// replayed response are sent as plain response headers, and version
+// conflicts, which clients provoke with a stale If-Match header, are answered
+// with 412 Precondition Failed rather than the 409 Conflict that ABORTED maps
+// to.
+func gatewayOptions() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, middleware.IdempotencyKeyHeader) {
+				return middleware.IdempotencyKeyHeader, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			switch key {
+			case "etag":
+				return "ETag", true
+			case middleware.IdempotentReplayedHeader:
+				return "Idempotent-Replayed", true
+			}
+			return runtime.MetadataHeaderPrefix + key, true
+		}),
+		runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			if isVersionConflict(err) {
+				w = &statusOverrideWriter{ResponseWriter: w, status: http.StatusPreconditionFailed}
+			}
+			runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+		}),
+	}
+}
+
+// isVersionConflict reports whether err is an ABORTED error carrying the
+// ERROR_CODE_RESOURCE_CONFLICT error code
+func isVersionConflict(err error) bool {
+	st, ok := grpcstatus.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if e, ok := detail.(*commonv1.Error); ok && e.Code == commonv1.ErrorCode_ERROR_CODE_RESOURCE_CONFLICT {
+			return true
+		}
+	}
+	return false
+}
+
+// statusOverrideWriter writes a fixed status code in place of the one given
+type statusOverrideWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader writes the fixed status code
+func (w *statusOverrideWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// fileStorage is implemented by the storage backends in internal/pkg/storage
+type fileStorage interface {
+	UploadFile(ctx context.Context, file multipart.File, header *multipart.FileHeader, userID string) (string, error)
+	DeleteFile(ctx context.Context, fileURL string) error
+	GetFileInfo(ctx context.Context, fileURL string) (*storage.FileInfo, error)
+}
+
+// storageAdapter adapts a storage backend to service.StorageService
+type storageAdapter struct {
+	fileStorage
+}
+
+// GetFileInfo retrieves file metadata in the form expected by the media service
+func (a *storageAdapter) GetFileInfo(ctx context.Context, fileURL string) (*service.FileInfo, error) {
+	info, err := a.fileStorage.GetFileInfo(ctx, fileURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.FileInfo{
+		URL:      fileURL,
+		Size:     info.Size,
+		MimeType: info.ContentType,
+	}, nil
+}
+
+// newStorage creates the media storage backend selected by configuration
+func newStorage(cfg *config.StorageConfig) (service.StorageService, error) {
+	switch cfg.Type {
+	case "s3":
+		s3Storage, err := storage.NewS3Storage(&storage.S3Config{
+			Region:          cfg.S3Region,
+			AccessKeyID:     cfg.S3Key,
+			SecretAccessKey: cfg.S3Secret,
+			BucketName:      cfg.S3Bucket,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &storageAdapter{fileStorage: s3Storage}, nil
+	case "local", "":
+		localStorage, err := storage.NewLocalStorage(cfg.LocalPath)
+		if err != nil {
+			return nil, err
+		}
+		return &storageAdapter{fileStorage: localStorage}, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage type: %s", cfg.Type)
+	}
+}
+
+// newMailer creates the mailer selected by configuration. The returned closer
+// releases resources held by the mailer and must be called on shutdown.
+func newMailer(cfg *config.MailConfig) (mailer.Mailer, io.Closer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return mailer.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From), io.NopCloser(nil), nil
+	case "log", "":
+		if cfg.LogFile == "" {
+			return mailer.NewLogMailer(os.Stdout, cfg.From), io.NopCloser(nil), nil
+		}
+		file, err := os.OpenFile(cfg.LogFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open mail log file: %w", err)
+		}
+		return mailer.NewLogMailer(file, cfg.From), file, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported mail driver: %s", cfg.Driver)
+	}
+}
+
+// newJWTManager creates the JWT manager for the configured signing algorithm.
+// Asymmetric algorithms sign with a key set whose retired keys stay available
+// for verification for as long as the tokens they signed are valid. Keys are
+// loaded from the key directory, which instances share and rotate, or from a
+// single key file. Keys generated in memory, which other instances cannot
+// verify and restarts lose, are only allowed in development.
+func newJWTManager(cfg *config.AuthConfig, environment string) (*auth.JWTManager, error) {
+	switch cfg.JWTSigningAlgorithm {
+	case "HS256", "":
+		return auth.NewJWTManager(cfg.JWTSecret, cfg.AccessTokenExpiry), nil
+	case auth.AlgorithmRS256, auth.AlgorithmEdDSA:
+		if cfg.JWTKeyDir != "" {
+			keys, err := auth.LoadKeySet(cfg.JWTKeyDir, cfg.JWTSigningAlgorithm, cfg.AccessTokenExpiry)
+			if err != nil {
+				return nil, err
+			}
+			return auth.NewJWTManagerWithKeySet(keys, cfg.AccessTokenExpiry), nil
+		}
+
+		development := environment == "development"
+		if cfg.JWTKeyRotationInterval > 0 && !development {
+			return nil, fmt.Errorf("rotating JWT signing keys requires JWT_KEY_DIR outside development")
+		}
+
+		var key *auth.SigningKey
+		var err error
+		switch {
+		case cfg.JWTPrivateKeyFile != "":
+			key, err = auth.LoadSigningKey(cfg.JWTPrivateKeyFile)
+		case development:
+			key, err = auth.GenerateSigningKey(cfg.JWTSigningAlgorithm)
+		default:
+			return nil, fmt.Errorf("%s signing requires JWT_KEY_DIR or JWT_PRIVATE_KEY_FILE outside development", cfg.JWTSigningAlgorithm)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != cfg.JWTSigningAlgorithm {
+			return nil, fmt.Errorf("signing key is %s, but %s is configured", key.Algorithm, cfg.JWTSigningAlgorithm)
+		}
+		keys := auth.NewKeySet(key, cfg.AccessTokenExpiry)
+		return auth.NewJWTManagerWithKeySet(keys, cfg.AccessTokenExpiry), nil
+	default:
+		return nil, fmt.Errorf("unsupported JWT signing algorithm: %s", cfg.JWTSigningAlgorithm)
+	}
+}
+
+// newSSOProviders discovers the configured OpenID Connect providers
+func newSSOProviders(ctx context.Context, cfg *config.SSOConfig) ([]*sso.Provider, error) {
+	providers := make([]*sso.Provider, 0, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		provider, err := sso.NewProvider(ctx, sso.ProviderConfig{
+			Name:         p.Name,
+			IssuerURL:    p.IssuerURL,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		})
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
@@ -2,6 +2,9 @@ package handlers
 
 import (
 	"context"
+	"log"
+	"net"
+	"strings"
 	"time"
 
 	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
@@ -10,22 +13,42 @@ import (
 	"github.com/venslupro/todo-api/internal/pkg/auth"
 	"github.com/venslupro/todo-api/internal/pkg/middleware"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AuthHandler handles authentication gRPC requests
 type AuthHandler struct {
-	authService *service.AuthService
-	jwtMgr      *auth.JWTManager
+	authService      *service.AuthService
+	accountService   *service.AccountService
+	ssoService       *service.SSOService
+	tokenService     *service.PersonalAccessTokenService
+	twoFactorService *service.TwoFactorService
+	deletionService  *service.AccountDeletionService
+	jwtMgr           *auth.JWTManager
 	todov1.UnimplementedAuthServiceServer
 }
 
 // NewAuthHandler creates a new auth handler
-func NewAuthHandler(authService *service.AuthService, jwtMgr *auth.JWTManager) *AuthHandler {
+func NewAuthHandler(
+	authService *service.AuthService,
+	accountService *service.AccountService,
+	ssoService *service.SSOService,
+	tokenService *service.PersonalAccessTokenService,
+	twoFactorService *service.TwoFactorService,
+	deletionService *service.AccountDeletionService,
+	jwtMgr *auth.JWTManager,
+) *AuthHandler {
 	return &AuthHandler{
-		authService: authService,
-		jwtMgr:      jwtMgr,
+		authService:      authService,
+		accountService:   accountService,
+		ssoService:       ssoService,
+		tokenService:     tokenService,
+		twoFactorService: twoFactorService,
+		deletionService:  deletionService,
+		jwtMgr:           jwtMgr,
 	}
 }
 
@@ -46,8 +69,13 @@ func (h *AuthHandler) Register(ctx context.Context, req *todov1.RegisterRequest)
 		return nil, err
 	}
 
+	// The account is usable without verification; the user can ask for a new link later
+	if err := h.accountService.SendVerificationEmail(ctx, user); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", user.ID, err)
+	}
+
 	return &todov1.RegisterResponse{
-		User: h.domainUserToProto(user),
+		User: domainUserToProto(user),
 	}, nil
 }
 
@@ -60,33 +88,40 @@ func (h *AuthHandler) Login(ctx context.Context, req *todov1.LoginRequest) (*tod
 		return nil, grpcstatus.Error(codes.InvalidArgument, "password is required")
 	}
 
-	user, accessToken, err := h.authService.Login(ctx, req.Email, req.Password)
+	result, err := h.authService.Login(ctx, req.Email, req.Password, clientInfoFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate refresh token
-	refreshToken, err := h.jwtMgr.Generate(user.ID, user.Username, user.Email)
-	if err != nil {
-		return nil, grpcstatus.Error(codes.Internal, "failed to generate refresh token")
+	if result.Challenge != nil {
+		return &todov1.LoginResponse{
+			TwoFactorRequired:  true,
+			ChallengeToken:     result.Challenge.Token,
+			ChallengeExpiresAt: timestamppb.New(result.Challenge.ExpiresAt),
+		}, nil
 	}
 
-	accessTokenExpires := time.Now().Add(24 * time.Hour)      // 24 hours
-	refreshTokenExpires := time.Now().Add(7 * 24 * time.Hour) // 7 days
-
+	tokens := result.Tokens
 	return &todov1.LoginResponse{
-		AccessToken:           accessToken,
-		RefreshToken:          refreshToken,
-		AccessTokenExpiresAt:  timestamppb.New(accessTokenExpires),
-		RefreshTokenExpiresAt: timestamppb.New(refreshTokenExpires),
-		User:                  h.domainUserToProto(user),
+		AccessToken:           tokens.AccessToken,
+		RefreshToken:          tokens.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+		User:                  domainUserToProto(result.User),
 	}, nil
 }
 
 // Logout handles user logout
 func (h *AuthHandler) Logout(ctx context.Context, req *todov1.LogoutRequest) (*todov1.LogoutResponse, error) {
-	// In a real implementation, you might want to blacklist the token
-	// For now, we'll just return success
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.Logout(ctx, claims, req.RefreshToken, req.AllDevices); err != nil {
+		return nil, err
+	}
+
 	return &todov1.LogoutResponse{}, nil
 }
 
@@ -96,22 +131,17 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, req *todov1.RefreshToken
 		return nil, grpcstatus.Error(codes.InvalidArgument, "refresh token is required")
 	}
 
-	claims, err := h.authService.ValidateToken(ctx, req.RefreshToken)
-	if err != nil {
-		return nil, grpcstatus.Error(codes.Unauthenticated, "invalid refresh token")
-	}
-
-	// Generate new access token
-	accessToken, err := h.jwtMgr.Generate(claims.UserID, claims.Username, claims.Email)
+	user, tokens, err := h.authService.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
-		return nil, grpcstatus.Error(codes.Internal, "failed to generate access token")
+		return nil, err
 	}
 
-	accessTokenExpires := time.Now().Add(24 * time.Hour)
-
 	return &todov1.RefreshTokenResponse{
-		AccessToken:          accessToken,
-		AccessTokenExpiresAt: timestamppb.New(accessTokenExpires),
+		AccessToken:           tokens.AccessToken,
+		RefreshToken:          tokens.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+		User:                  domainUserToProto(user),
 	}, nil
 }
 
@@ -129,7 +159,7 @@ func (h *AuthHandler) GetProfile(ctx context.Context, req *todov1.GetProfileRequ
 	}
 
 	return &todov1.GetProfileResponse{
-		User: h.domainUserToProto(user),
+		User: domainUserToProto(user),
 	}, nil
 }
 
@@ -161,7 +191,7 @@ func (h *AuthHandler) UpdateProfile(ctx context.Context, req *todov1.UpdateProfi
 	_ = user
 
 	return &todov1.UpdateProfileResponse{
-		User: h.domainUserToProto(user),
+		User: domainUserToProto(user),
 	}, nil
 }
 
@@ -174,26 +204,55 @@ func (h *AuthHandler) ChangePassword(ctx context.Context, req *todov1.ChangePass
 		return nil, grpcstatus.Error(codes.InvalidArgument, "new password is required")
 	}
 
-	// TODO: Implement password change functionality
-	// For now, we'll return an error indicating this feature is not yet implemented
-	return nil, grpcstatus.Error(codes.Unimplemented, "password change functionality not yet implemented")
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.ChangePassword(ctx, claims, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	return &todov1.ChangePasswordResponse{}, nil
 }
 
 // VerifyEmail handles email verification
 func (h *AuthHandler) VerifyEmail(ctx context.Context, req *todov1.VerifyEmailRequest) (*todov1.VerifyEmailResponse, error) {
-	// For now, we'll just return success
-	// In a real implementation, you would validate the token and mark email as verified
+	if req.Token == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.accountService.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, err
+	}
+
 	return &todov1.VerifyEmailResponse{}, nil
 }
 
+// ResendVerificationEmail sends a new verification email to the current user
+func (h *AuthHandler) ResendVerificationEmail(ctx context.Context, req *todov1.ResendVerificationEmailRequest) (*todov1.ResendVerificationEmailResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.accountService.ResendVerificationEmail(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &todov1.ResendVerificationEmailResponse{}, nil
+}
+
 // RequestPasswordReset handles password reset request
 func (h *AuthHandler) RequestPasswordReset(ctx context.Context, req *todov1.RequestPasswordResetRequest) (*todov1.RequestPasswordResetResponse, error) {
 	if req.Email == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "email is required")
 	}
 
-	// For now, we'll just return success
-	// In a real implementation, you would send a password reset email
+	if err := h.accountService.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
 	return &todov1.RequestPasswordResetResponse{}, nil
 }
 
@@ -206,27 +265,351 @@ func (h *AuthHandler) ConfirmPasswordReset(ctx context.Context, req *todov1.Conf
 		return nil, grpcstatus.Error(codes.InvalidArgument, "new password is required")
 	}
 
-	// For now, we'll just return success
-	// In a real implementation, you would validate the token and update the password
+	if err := h.accountService.ConfirmPasswordReset(ctx, req.Token, req.NewPassword); err != nil {
+		return nil, err
+	}
+
 	return &todov1.ConfirmPasswordResetResponse{}, nil
 }
 
+// ListSessions lists the current user's active sessions
+func (h *AuthHandler) ListSessions(ctx context.Context, req *todov1.ListSessionsRequest) (*todov1.ListSessionsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.authService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSessionID := middleware.GetSessionIDFromContext(ctx)
+	protoSessions := make([]*todov1.Session, len(sessions))
+	for i, session := range sessions {
+		protoSessions[i] = &todov1.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+			Current:    session.ID == currentSessionID,
+		}
+	}
+
+	return &todov1.ListSessionsResponse{
+		Sessions: protoSessions,
+	}, nil
+}
+
+// RevokeSession revokes one of the current user's sessions
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *todov1.RevokeSessionRequest) (*todov1.RevokeSessionResponse, error) {
+	if req.SessionId == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.RevokeSession(ctx, userID, req.SessionId); err != nil {
+		return nil, err
+	}
+
+	return &todov1.RevokeSessionResponse{}, nil
+}
+
+// ListSSOProviders lists the configured single sign-on providers
+func (h *AuthHandler) ListSSOProviders(ctx context.Context, req *todov1.ListSSOProvidersRequest) (*todov1.ListSSOProvidersResponse, error) {
+	return &todov1.ListSSOProvidersResponse{
+		Providers: h.ssoService.Providers(),
+	}, nil
+}
+
+// StartSSOLogin starts a login with a single sign-on provider
+func (h *AuthHandler) StartSSOLogin(ctx context.Context, req *todov1.StartSSOLoginRequest) (*todov1.StartSSOLoginResponse, error) {
+	if req.Provider == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	authURL, state, err := h.ssoService.StartLogin(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.StartSSOLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+	}, nil
+}
+
+// CompleteSSOLogin completes a single sign-on login and issues tokens or a
+// two-factor challenge
+func (h *AuthHandler) CompleteSSOLogin(ctx context.Context, req *todov1.CompleteSSOLoginRequest) (*todov1.CompleteSSOLoginResponse, error) {
+	if req.Provider == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	result, err := h.ssoService.CompleteLogin(ctx, req.Provider, req.Code, req.State, clientInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Challenge != nil {
+		return &todov1.CompleteSSOLoginResponse{
+			TwoFactorRequired:  true,
+			ChallengeToken:     result.Challenge.Token,
+			ChallengeExpiresAt: timestamppb.New(result.Challenge.ExpiresAt),
+		}, nil
+	}
+
+	tokens := result.Tokens
+	return &todov1.CompleteSSOLoginResponse{
+		AccessToken:           tokens.AccessToken,
+		RefreshToken:          tokens.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+		User:                  domainUserToProto(result.User),
+	}, nil
+}
+
+// CreatePersonalAccessToken creates a personal access token for the current user
+func (h *AuthHandler) CreatePersonalAccessToken(ctx context.Context, req *todov1.CreatePersonalAccessTokenRequest) (*todov1.CreatePersonalAccessTokenResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	token, secret, err := h.tokenService.Create(ctx, userID, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.CreatePersonalAccessTokenResponse{
+		Token:  personalAccessTokenToProto(token),
+		Secret: secret,
+	}, nil
+}
+
+// ListPersonalAccessTokens lists the current user's personal access tokens
+func (h *AuthHandler) ListPersonalAccessTokens(ctx context.Context, req *todov1.ListPersonalAccessTokensRequest) (*todov1.ListPersonalAccessTokensResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := h.tokenService.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTokens := make([]*todov1.PersonalAccessToken, len(tokens))
+	for i, token := range tokens {
+		protoTokens[i] = personalAccessTokenToProto(token)
+	}
+
+	return &todov1.ListPersonalAccessTokensResponse{
+		Tokens: protoTokens,
+	}, nil
+}
+
+// RevokePersonalAccessToken revokes one of the current user's personal access tokens
+func (h *AuthHandler) RevokePersonalAccessToken(ctx context.Context, req *todov1.RevokePersonalAccessTokenRequest) (*todov1.RevokePersonalAccessTokenResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.tokenService.Revoke(ctx, userID, req.TokenId); err != nil {
+		return nil, err
+	}
+
+	return &todov1.RevokePersonalAccessTokenResponse{}, nil
+}
+
+// VerifyTwoFactorLogin completes a two-factor login and issues tokens
+func (h *AuthHandler) VerifyTwoFactorLogin(ctx context.Context, req *todov1.VerifyTwoFactorLoginRequest) (*todov1.VerifyTwoFactorLoginResponse, error) {
+	user, tokens, err := h.twoFactorService.CompleteLogin(ctx, req.ChallengeToken, req.Code, clientInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.VerifyTwoFactorLoginResponse{
+		AccessToken:           tokens.AccessToken,
+		RefreshToken:          tokens.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+		User:                  domainUserToProto(user),
+	}, nil
+}
+
+// EnrollTwoFactor starts TOTP enrollment for the current user
+func (h *AuthHandler) EnrollTwoFactor(ctx context.Context, req *todov1.EnrollTwoFactorRequest) (*todov1.EnrollTwoFactorResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := h.twoFactorService.BeginEnrollment(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.EnrollTwoFactorResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+	}, nil
+}
+
+// ConfirmTwoFactor enables two-factor authentication for the current user
+func (h *AuthHandler) ConfirmTwoFactor(ctx context.Context, req *todov1.ConfirmTwoFactorRequest) (*todov1.ConfirmTwoFactorResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Code == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := h.twoFactorService.ConfirmEnrollment(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.ConfirmTwoFactorResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableTwoFactor disables two-factor authentication for the current user
+func (h *AuthHandler) DisableTwoFactor(ctx context.Context, req *todov1.DisableTwoFactorRequest) (*todov1.DisableTwoFactorResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Code == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if err := h.twoFactorService.Disable(ctx, userID, req.Code); err != nil {
+		return nil, err
+	}
+
+	return &todov1.DisableTwoFactorResponse{}, nil
+}
+
+// DeleteAccount schedules deletion of the current user's account
+func (h *AuthHandler) DeleteAccount(ctx context.Context, req *todov1.DeleteAccountRequest) (*todov1.DeleteAccountResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.deletionService.RequestDeletion(ctx, userID, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.DeleteAccountResponse{
+		DeletionScheduledAt: timestamppb.New(*user.DeletionScheduledAt),
+	}, nil
+}
+
+// CancelAccountDeletion cancels a scheduled deletion of the current user's account
+func (h *AuthHandler) CancelAccountDeletion(ctx context.Context, req *todov1.CancelAccountDeletionRequest) (*todov1.CancelAccountDeletionResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.deletionService.CancelDeletion(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &todov1.CancelAccountDeletionResponse{}, nil
+}
+
+// clientInfoFromContext extracts the caller's user agent and IP address,
+// preferring the values forwarded by the gRPC gateway. The forwarded address
+// is only trusted from the gateway, which connects over loopback, and only
+// its rightmost entry: the gateway appends the address of its own peer, while
+// entries to the left of it are supplied by the client.
+func clientInfoFromContext(ctx context.Context) service.ClientInfo {
+	var info service.ClientInfo
+
+	var peerIP net.IP
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		info.IPAddress = host
+		peerIP = net.ParseIP(host)
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("grpcgateway-user-agent"); len(values) > 0 {
+			info.UserAgent = values[0]
+		} else if values := md.Get("user-agent"); len(values) > 0 {
+			info.UserAgent = values[0]
+		}
+		if values := md.Get("x-forwarded-for"); len(values) > 0 && peerIP != nil && peerIP.IsLoopback() {
+			entries := strings.Split(values[len(values)-1], ",")
+			if forwarded := strings.TrimSpace(entries[len(entries)-1]); forwarded != "" {
+				info.IPAddress = forwarded
+			}
+		}
+	}
+
+	return info
+}
+
 // domainUserToProto converts domain User to protobuf User
-func (h *AuthHandler) domainUserToProto(user *domain.User) *todov1.User {
-	var lastLoginAt *timestamppb.Timestamp
+func domainUserToProto(user *domain.User) *todov1.User {
+	var lastLoginAt, deletionScheduledAt *timestamppb.Timestamp
 	if user.LastLoginAt != nil {
 		lastLoginAt = timestamppb.New(*user.LastLoginAt)
 	}
+	if user.DeletionScheduledAt != nil {
+		deletionScheduledAt = timestamppb.New(*user.DeletionScheduledAt)
+	}
 
 	return &todov1.User{
-		Id:            user.ID,
-		Email:         user.Email,
-		Username:      user.Username,
-		DisplayName:   user.FullName,
-		AvatarUrl:     user.AvatarURL,
-		CreatedAt:     timestamppb.New(user.CreatedAt),
-		UpdatedAt:     timestamppb.New(user.UpdatedAt),
-		LastLoginAt:   lastLoginAt,
-		EmailVerified: true, // For now, assume email is verified
+		Id:                  user.ID,
+		Email:               user.Email,
+		Username:            user.Username,
+		DisplayName:         user.FullName,
+		AvatarUrl:           user.AvatarURL,
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+		UpdatedAt:           timestamppb.New(user.UpdatedAt),
+		LastLoginAt:         lastLoginAt,
+		EmailVerified:       user.EmailVerified,
+		TwoFactorEnabled:    user.TwoFactorEnabled,
+		IsActive:            user.IsActive,
+		IsAdmin:             user.IsAdmin,
+		DeletionScheduledAt: deletionScheduledAt,
+	}
+}
+
+// personalAccessTokenToProto converts a personal access token to protobuf, without its hash
+func personalAccessTokenToProto(token *domain.PersonalAccessToken) *todov1.PersonalAccessToken {
+	protoToken := &todov1.PersonalAccessToken{
+		Id:        token.ID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+	}
+	if token.ExpiresAt != nil {
+		protoToken.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+	if token.LastUsedAt != nil {
+		protoToken.LastUsedAt = timestamppb.New(*token.LastUsedAt)
 	}
+	return protoToken
 }
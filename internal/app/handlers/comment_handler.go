@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/app/service"
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/middleware"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CommentHandler handles gRPC requests for TODO comments
+type CommentHandler struct {
+	todov1.UnimplementedCommentServiceServer
+	commentService *service.CommentService
+}
+
+// NewCommentHandler creates a new CommentHandler
+func NewCommentHandler(commentService *service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// CreateComment adds a comment or a reply to a TODO
+func (h *CommentHandler) CreateComment(ctx context.Context, req *todov1.CreateCommentRequest) (*todov1.CreateCommentResponse, error) {
+	if req.TodoId == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "todo_id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	comment, err := h.commentService.CreateComment(ctx, userID, req.TodoId, req.Content, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.CreateCommentResponse{
+		Comment: domainCommentToProto(comment),
+	}, nil
+}
+
+// ListComments lists the comments of a TODO or the replies to a comment
+func (h *CommentHandler) ListComments(ctx context.Context, req *todov1.ListCommentsRequest) (*todov1.ListCommentsResponse, error) {
+	if req.TodoId == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "todo_id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	comments, pagination, err := h.commentService.ListComments(ctx, userID, req.TodoId, req.ParentId,
+		req.GetPagination().GetPage(), req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	protoComments := make([]*todov1.Comment, 0, len(comments))
+	for _, comment := range comments {
+		protoComments = append(protoComments, domainCommentToProto(comment))
+	}
+
+	return &todov1.ListCommentsResponse{
+		Comments: protoComments,
+		Pagination: &commonv1.PaginationResponse{
+			TotalItems:  pagination.TotalItems,
+			TotalPages:  pagination.TotalPages,
+			CurrentPage: pagination.CurrentPage,
+			PageSize:    pagination.PageSize,
+			HasNext:     pagination.HasNext,
+			HasPrev:     pagination.HasPrev,
+		},
+	}, nil
+}
+
+// UpdateComment edits a comment
+func (h *CommentHandler) UpdateComment(ctx context.Context, req *todov1.UpdateCommentRequest) (*todov1.UpdateCommentResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	comment, err := h.commentService.UpdateComment(ctx, userID, req.Id, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.UpdateCommentResponse{
+		Comment: domainCommentToProto(comment),
+	}, nil
+}
+
+// DeleteComment deletes a comment
+func (h *CommentHandler) DeleteComment(ctx context.Context, req *todov1.DeleteCommentRequest) (*todov1.DeleteCommentResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	if err := h.commentService.DeleteComment(ctx, userID, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &todov1.DeleteCommentResponse{}, nil
+}
+
+// domainCommentToProto converts a domain comment to its protobuf message
+func domainCommentToProto(comment *domain.Comment) *todov1.Comment {
+	protoComment := &todov1.Comment{
+		Id:         comment.ID,
+		TodoId:     comment.TODOID,
+		UserId:     comment.UserID,
+		ParentId:   comment.ParentID,
+		Content:    comment.Content,
+		ReplyCount: comment.ReplyCount,
+		Deleted:    comment.IsDeleted(),
+		CreatedAt:  timestamppb.New(comment.CreatedAt),
+		UpdatedAt:  timestamppb.New(comment.UpdatedAt),
+	}
+	if comment.EditedAt != nil {
+		protoComment.EditedAt = timestamppb.New(*comment.EditedAt)
+	}
+	return protoComment
+}
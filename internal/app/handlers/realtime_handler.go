@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/app/service"
+	"github.com/venslupro/todo-api/internal/pkg/middleware"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RealtimeHandler handles real-time collaboration gRPC requests
+type RealtimeHandler struct {
+	todov1.UnimplementedRealtimeServiceServer
+	websocketService *service.WebSocketService
+	permissions      *service.PermissionService
+}
+
+// NewRealtimeHandler creates a new realtime handler
+func NewRealtimeHandler(websocketService *service.WebSocketService, permissions *service.PermissionService) *RealtimeHandler {
+	return &RealtimeHandler{
+		websocketService: websocketService,
+		permissions:      permissions,
+	}
+}
+
+// PublishEvent publishes an event to connected WebSocket clients. Events
+// about a team or a TODO can only be published by users who can view it.
+func (h *RealtimeHandler) PublishEvent(ctx context.Context, req *todov1.PublishEventRequest) (*todov1.PublishEventResponse, error) {
+	if req.Event == nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "event is required")
+	}
+	if req.Event.Type == commonv1.EventType_EVENT_TYPE_UNSPECIFIED {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "event type is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Event.TeamId != "" {
+		if err := h.permissions.CheckTeamPermission(ctx, userID, req.Event.TeamId, "view"); err != nil {
+			return nil, err
+		}
+	}
+	if req.Event.TodoId != "" {
+		if err := h.permissions.CanViewTODO(ctx, userID, req.Event.TodoId); err != nil {
+			return nil, err
+		}
+	}
+
+	event := req.Event
+	event.Id = uuid.New().String()
+	event.Timestamp = timestamppb.Now()
+	event.UserId = userID
+	event.Username = middleware.GetUsernameFromContext(ctx)
+
+	payload := map[string]interface{}{
+		"event": event,
+	}
+	if event.TodoId != "" {
+		payload["todo_id"] = event.TodoId
+	}
+	if event.TeamId != "" {
+		payload["team_id"] = event.TeamId
+	}
+
+	h.websocketService.BroadcastEvent(ctx, "realtime_event", payload, userID)
+
+	return &todov1.PublishEventResponse{
+		Event: event,
+	}, nil
+}
+
+// GetOnlineUsers lists the members of one of the caller's teams with an open
+// real-time connection
+func (h *RealtimeHandler) GetOnlineUsers(ctx context.Context, req *todov1.GetOnlineUsersRequest) (*todov1.GetOnlineUsersResponse, error) {
+	if req.GetTeamId() == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "team_id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.permissions.CheckTeamPermission(ctx, userID, req.GetTeamId(), "view"); err != nil {
+		return nil, err
+	}
+
+	userIDs := h.websocketService.ConnectedUserIDs(req.GetTeamId())
+
+	users := make([]*todov1.OnlineUser, len(userIDs))
+	for i, userID := range userIDs {
+		users[i] = &todov1.OnlineUser{
+			UserId: userID,
+		}
+	}
+
+	return &todov1.GetOnlineUsersResponse{
+		Users: users,
+	}, nil
+}
+
+// Heartbeat acknowledges that the caller is still active
+func (h *RealtimeHandler) Heartbeat(ctx context.Context, req *todov1.HeartbeatRequest) (*todov1.HeartbeatResponse, error) {
+	return &todov1.HeartbeatResponse{
+		ServerTime: timestamppb.Now(),
+	}, nil
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/app/service"
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/middleware"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReminderHandler handles gRPC requests for TODO reminders
+type ReminderHandler struct {
+	todov1.UnimplementedReminderServiceServer
+	reminderService *service.ReminderService
+}
+
+// NewReminderHandler creates a new ReminderHandler
+func NewReminderHandler(reminderService *service.ReminderService) *ReminderHandler {
+	return &ReminderHandler{
+		reminderService: reminderService,
+	}
+}
+
+// CreateReminder attaches a reminder to a TODO
+func (h *ReminderHandler) CreateReminder(ctx context.Context, req *todov1.CreateReminderRequest) (*todov1.CreateReminderResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	var remindAt *time.Time
+	var beforeDue *time.Duration
+	switch trigger := req.Trigger.(type) {
+	case *todov1.CreateReminderRequest_RemindAt:
+		t := trigger.RemindAt.AsTime()
+		remindAt = &t
+	case *todov1.CreateReminderRequest_BeforeDue:
+		d := trigger.BeforeDue.AsDuration()
+		beforeDue = &d
+	}
+
+	reminder, err := h.reminderService.CreateReminder(ctx, userID, req.TodoId, remindAt, beforeDue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.CreateReminderResponse{
+		Reminder: domainReminderToProto(reminder),
+	}, nil
+}
+
+// ListReminders lists the caller's reminders on a TODO
+func (h *ReminderHandler) ListReminders(ctx context.Context, req *todov1.ListRemindersRequest) (*todov1.ListRemindersResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	reminders, err := h.reminderService.ListReminders(ctx, userID, req.TodoId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoReminders := make([]*todov1.Reminder, 0, len(reminders))
+	for _, reminder := range reminders {
+		protoReminders = append(protoReminders, domainReminderToProto(reminder))
+	}
+
+	return &todov1.ListRemindersResponse{
+		Reminders: protoReminders,
+	}, nil
+}
+
+// SnoozeReminder makes a reminder fire again later
+func (h *ReminderHandler) SnoozeReminder(ctx context.Context, req *todov1.SnoozeReminderRequest) (*todov1.SnoozeReminderResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+	if req.Duration == nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "duration is required")
+	}
+
+	reminder, err := h.reminderService.SnoozeReminder(ctx, userID, req.Id, req.Duration.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.SnoozeReminderResponse{
+		Reminder: domainReminderToProto(reminder),
+	}, nil
+}
+
+// DismissReminder stops a reminder from firing
+func (h *ReminderHandler) DismissReminder(ctx context.Context, req *todov1.DismissReminderRequest) (*todov1.DismissReminderResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	reminder, err := h.reminderService.DismissReminder(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.DismissReminderResponse{
+		Reminder: domainReminderToProto(reminder),
+	}, nil
+}
+
+// DeleteReminder deletes a reminder
+func (h *ReminderHandler) DeleteReminder(ctx context.Context, req *todov1.DeleteReminderRequest) (*todov1.DeleteReminderResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	if err := h.reminderService.DeleteReminder(ctx, userID, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &todov1.DeleteReminderResponse{}, nil
+}
+
+// domainReminderToProto converts a domain reminder to its protobuf message
+func domainReminderToProto(reminder *domain.Reminder) *todov1.Reminder {
+	protoReminder := &todov1.Reminder{
+		Id:        reminder.ID,
+		TodoId:    reminder.TODOID,
+		UserId:    reminder.UserID,
+		CreatedAt: timestamppb.New(reminder.CreatedAt),
+	}
+	if reminder.BeforeDue != nil {
+		protoReminder.BeforeDue = durationpb.New(*reminder.BeforeDue)
+	}
+	if reminder.FireAt != nil {
+		protoReminder.RemindAt = timestamppb.New(*reminder.FireAt)
+	}
+	if reminder.FiredAt != nil {
+		protoReminder.FiredAt = timestamppb.New(*reminder.FiredAt)
+	}
+	if reminder.DismissedAt != nil {
+		protoReminder.DismissedAt = timestamppb.New(*reminder.DismissedAt)
+	}
+	return protoReminder
+}
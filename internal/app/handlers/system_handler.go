@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Version is the API version reported by the system service
+const Version = "1.0.0"
+
+// HealthCheck is a named dependency check used by the system handler
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// SystemHandler handles system gRPC requests
+type SystemHandler struct {
+	todov1.UnimplementedSystemServiceServer
+	environment string
+	startTime   time.Time
+	checks      []HealthCheck
+}
+
+// NewSystemHandler creates a new system handler
+func NewSystemHandler(environment string, checks ...HealthCheck) *SystemHandler {
+	return &SystemHandler{
+		environment: environment,
+		startTime:   time.Now(),
+		checks:      checks,
+	}
+}
+
+// HealthCheck reports the health of the server and its dependencies
+func (h *SystemHandler) HealthCheck(ctx context.Context, req *todov1.HealthCheckRequest) (*todov1.HealthCheckResponse, error) {
+	return h.health(ctx), nil
+}
+
+// GetSystemStatus reports detailed system status
+func (h *SystemHandler) GetSystemStatus(ctx context.Context, req *todov1.GetSystemStatusRequest) (*todov1.GetSystemStatusResponse, error) {
+	now := time.Now()
+	health := h.health(ctx)
+
+	services := make([]*todov1.ServiceStatus, 0, len(h.checks))
+	for _, check := range h.checks {
+		serviceStatus := &todov1.ServiceStatus{
+			Name:      check.Name,
+			Status:    commonv1.ServingStatus_SERVING_STATUS_SERVING,
+			Message:   health.Details[check.Name],
+			LastCheck: timestamppb.New(now),
+		}
+		if health.Details[check.Name] != "ok" {
+			serviceStatus.Status = commonv1.ServingStatus_SERVING_STATUS_NOT_SERVING
+		}
+		services = append(services, serviceStatus)
+	}
+
+	return &todov1.GetSystemStatusResponse{
+		Status: &todov1.SystemStatus{
+			Health: health,
+			Info: &todov1.SystemInfo{
+				Version:     Version,
+				Environment: h.environment,
+				StartTime:   timestamppb.New(h.startTime),
+				Uptime:      durationpb.New(now.Sub(h.startTime)),
+			},
+			Services: services,
+		},
+	}, nil
+}
+
+// health runs all dependency checks and aggregates the result
+func (h *SystemHandler) health(ctx context.Context) *todov1.HealthCheckResponse {
+	status := commonv1.ServingStatus_SERVING_STATUS_SERVING
+	details := make(map[string]string, len(h.checks))
+
+	for _, check := range h.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		err := check.Check(checkCtx)
+		cancel()
+
+		if err != nil {
+			details[check.Name] = err.Error()
+			status = commonv1.ServingStatus_SERVING_STATUS_NOT_SERVING
+			continue
+		}
+		details[check.Name] = "ok"
+	}
+
+	return &todov1.HealthCheckResponse{
+		Status:    status,
+		Version:   Version,
+		Timestamp: timestamppb.Now(),
+		Details:   details,
+	}
+}
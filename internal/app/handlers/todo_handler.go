@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
@@ -13,6 +14,11 @@ import (
 	"github.com/venslupro/todo-api/internal/app/service"
 	"github.com/venslupro/todo-api/internal/domain"
 	"github.com/venslupro/todo-api/internal/pkg/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,12 +26,16 @@ import (
 type TODOHandler struct {
 	todov1.UnimplementedTODOServiceServer
 	service *service.TODOService
+	trash   *service.TrashService
+	bulk    *service.BulkService
 }
 
 // NewTODOHandler creates a new TODO handler.
-func NewTODOHandler(svc *service.TODOService) *TODOHandler {
+func NewTODOHandler(svc *service.TODOService, trash *service.TrashService, bulk *service.BulkService) *TODOHandler {
 	return &TODOHandler{
 		service: svc,
+		trash:   trash,
+		bulk:    bulk,
 	}
 }
 
@@ -72,11 +82,12 @@ func (h *TODOHandler) CreateTODO(ctx context.Context, req *todov1.CreateTODORequ
 		parentID = req.ParentId
 	}
 
-	todo, err := h.service.CreateTODO(ctx, userID, req.Title, description, status, priority, dueDate, req.Tags, assignedTo, parentID)
+	todo, err := h.service.CreateTODO(ctx, userID, req.Title, description, status, priority, dueDate, req.Tags, assignedTo, parentID, req.RecurrenceRule)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, todo)
 	return &todov1.CreateTODOResponse{
 		Todo: convertToProto(todo),
 	}, nil
@@ -89,6 +100,7 @@ func (h *TODOHandler) GetTODO(ctx context.Context, req *todov1.GetTODORequest) (
 		return nil, err
 	}
 
+	setETag(ctx, todo)
 	return &todov1.GetTODOResponse{
 		Todo: convertToProto(todo),
 	}, nil
@@ -96,6 +108,11 @@ func (h *TODOHandler) GetTODO(ctx context.Context, req *todov1.GetTODORequest) (
 
 // UpdateTODO updates an existing TODO.
 func (h *TODOHandler) UpdateTODO(ctx context.Context, req *todov1.UpdateTODORequest) (*todov1.UpdateTODOResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var title, description *string
 	if req.Title != nil {
 		title = req.Title
@@ -139,16 +156,47 @@ func (h *TODOHandler) UpdateTODO(ctx context.Context, req *todov1.UpdateTODORequ
 		position = req.Position
 	}
 
-	todo, err := h.service.UpdateTODO(ctx, req.Id, title, description, status, priority, dueDate, req.Tags, assignedTo, parentID, position)
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	todo, err := h.service.UpdateTODO(ctx, userID, req.Id, title, description, status, priority, dueDate, req.Tags, assignedTo, parentID, position, req.RecurrenceRule, req.Force, version)
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, todo)
+
 	return &todov1.UpdateTODOResponse{
 		Todo: convertToProto(todo),
 	}, nil
 }
 
+// PatchTODO updates the fields of a TODO named in the request's field mask.
+func (h *TODOHandler) PatchTODO(ctx context.Context, req *todov1.PatchTODORequest) (*todov1.PatchTODOResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.PatchTODO(ctx, userID, req.GetTodo().GetId(), convertPatchFromProto(req.GetTodo()), req.GetUpdateMask().GetPaths(), req.Force, version)
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, todo)
+
+	return &todov1.PatchTODOResponse{
+		Todo: convertToProto(todo),
+	}, nil
+}
+
 // DeleteTODO deletes a TODO by ID.
 func (h *TODOHandler) DeleteTODO(ctx context.Context, req *todov1.DeleteTODORequest) (*todov1.DeleteTODOResponse, error) {
 	if err := h.service.DeleteTODO(ctx, req.Id); err != nil {
@@ -240,25 +288,80 @@ func (h *TODOHandler) ListTODOs(ctx context.Context, req *todov1.ListTODOsReques
 
 // BulkUpdateStatus updates status for multiple TODOs.
 func (h *TODOHandler) BulkUpdateStatus(ctx context.Context, req *todov1.BulkUpdateStatusRequest) (*todov1.BulkUpdateStatusResponse, error) {
-	status := req.GetStatus()
-	if err := h.service.BulkUpdateStatus(ctx, req.Ids, status); err != nil {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	return &todov1.BulkUpdateStatusResponse{}, nil
+	results, err := h.bulk.BulkUpdateStatus(ctx, userID, req.Ids, req.GetStatus(), req.Force)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &todov1.BulkUpdateStatusResponse{}
+	resp.Results, resp.SucceededCount, resp.FailedCount = convertBulkResultsToProto(results)
+	return resp, nil
 }
 
 // BulkDelete deletes multiple TODOs.
 func (h *TODOHandler) BulkDelete(ctx context.Context, req *todov1.BulkDeleteRequest) (*todov1.BulkDeleteResponse, error) {
-	if err := h.service.BulkDelete(ctx, req.Ids); err != nil {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := h.bulk.BulkDelete(ctx, userID, req.Ids)
+	if err != nil {
 		return nil, err
 	}
 
-	return &todov1.BulkDeleteResponse{}, nil
+	resp := &todov1.BulkDeleteResponse{}
+	resp.Results, resp.SucceededCount, resp.FailedCount = convertBulkResultsToProto(results)
+	return resp, nil
+}
+
+// BulkUpdateTODOs makes the same changes to multiple TODOs.
+func (h *TODOHandler) BulkUpdateTODOs(ctx context.Context, req *todov1.BulkUpdateTODOsRequest) (*todov1.BulkUpdateTODOsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &domain.BulkChanges{
+		AddTags:      req.AddTags,
+		RemoveTags:   req.RemoveTags,
+		AssignedTo:   req.AssignedTo,
+		ClearDueDate: req.ClearDueDate,
+		ParentID:     req.ParentId,
+	}
+	if req.Status != nil && req.GetStatus() != commonv1.Status_STATUS_UNSPECIFIED {
+		changes.Status = req.Status
+	}
+	if req.Priority != nil && req.GetPriority() != commonv1.Priority_PRIORITY_UNSPECIFIED {
+		changes.Priority = req.Priority
+	}
+	if req.DueDate != nil {
+		dueDate := req.DueDate.AsTime()
+		changes.DueDate = &dueDate
+	}
+
+	results, err := h.bulk.BulkUpdateTODOs(ctx, userID, req.Ids, changes, req.Transactional, req.Force)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &todov1.BulkUpdateTODOsResponse{}
+	resp.Results, resp.SucceededCount, resp.FailedCount = convertBulkResultsToProto(results)
+	return resp, nil
 }
 
 // MoveTODO moves a TODO to a new position or parent.
 func (h *TODOHandler) MoveTODO(ctx context.Context, req *todov1.MoveTODORequest) (*todov1.MoveTODOResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var parentID *string
 	if req.ParentId != nil {
 		parentID = req.ParentId
@@ -269,53 +372,378 @@ func (h *TODOHandler) MoveTODO(ctx context.Context, req *todov1.MoveTODORequest)
 		position = req.Position
 	}
 
-	todo, err := h.service.MoveTODO(ctx, req.Id, parentID, position)
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.MoveTODO(ctx, userID, req.Id, parentID, position, version)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, todo)
+
 	return &todov1.MoveTODOResponse{
 		Todo: convertToProto(todo),
 	}, nil
 }
 
+// ReorderTODO places a TODO just before or just after another TODO.
+func (h *TODOHandler) ReorderTODO(ctx context.Context, req *todov1.ReorderTODORequest) (*todov1.ReorderTODOResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.ReorderTODO(ctx, userID, req.Id, req.GetBeforeId(), req.GetAfterId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.ReorderTODOResponse{
+		Todo: convertToProto(todo),
+	}, nil
+}
+
 // CompleteTODO marks a TODO as completed.
 func (h *TODOHandler) CompleteTODO(ctx context.Context, req *todov1.CompleteTODORequest) (*todov1.CompleteTODOResponse, error) {
-	todo, err := h.service.CompleteTODO(ctx, req.Id)
+	userID, err := middleware.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &todov1.CompleteTODOResponse{
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, next, err := h.service.CompleteTODO(ctx, userID, req.Id, req.Force, version)
+	if err != nil {
+		return nil, err
+	}
+
+	setETag(ctx, todo)
+
+	resp := &todov1.CompleteTODOResponse{
 		Todo: convertToProto(todo),
-	}, nil
+	}
+	if next != nil {
+		resp.NextOccurrence = convertToProto(next)
+	}
+	return resp, nil
 }
 
 // ReopenTODO reopens a completed TODO.
 func (h *TODOHandler) ReopenTODO(ctx context.Context, req *todov1.ReopenTODORequest) (*todov1.ReopenTODOResponse, error) {
-	todo, err := h.service.ReopenTODO(ctx, req.Id)
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.ReopenTODO(ctx, userID, req.Id, version)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, todo)
+
 	return &todov1.ReopenTODOResponse{
 		Todo: convertToProto(todo),
 	}, nil
 }
 
+// SkipOccurrence skips the current occurrence of a recurring TODO.
+func (h *TODOHandler) SkipOccurrence(ctx context.Context, req *todov1.SkipOccurrenceRequest) (*todov1.SkipOccurrenceResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.SkipOccurrence(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.SkipOccurrenceResponse{
+		Todo: convertToProto(todo),
+	}, nil
+}
+
+// EndRecurrence ends the series of a recurring TODO.
+func (h *TODOHandler) EndRecurrence(ctx context.Context, req *todov1.EndRecurrenceRequest) (*todov1.EndRecurrenceResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.EndRecurrence(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.EndRecurrenceResponse{
+		Todo: convertToProto(todo),
+	}, nil
+}
+
+// ListTODORevisions lists the revision history of a TODO.
+func (h *TODOHandler) ListTODORevisions(ctx context.Context, req *todov1.ListTODORevisionsRequest) (*todov1.ListTODORevisionsResponse, error) {
+	revisions, pagination, err := h.service.ListRevisions(ctx, req.Id,
+		req.GetPagination().GetPage(), req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	protoRevisions := make([]*todov1.TODORevision, 0, len(revisions))
+	for _, revision := range revisions {
+		protoRevisions = append(protoRevisions, convertRevisionToProto(revision))
+	}
+
+	return &todov1.ListTODORevisionsResponse{
+		Revisions: protoRevisions,
+		Pagination: &commonv1.PaginationResponse{
+			TotalItems:  pagination.TotalItems,
+			TotalPages:  pagination.TotalPages,
+			CurrentPage: pagination.CurrentPage,
+			PageSize:    pagination.PageSize,
+			HasNext:     pagination.HasNext,
+			HasPrev:     pagination.HasPrev,
+		},
+	}, nil
+}
+
+// DiffTODORevisions shows the changes between two revisions of a TODO.
+func (h *TODOHandler) DiffTODORevisions(ctx context.Context, req *todov1.DiffTODORevisionsRequest) (*todov1.DiffTODORevisionsResponse, error) {
+	changes, err := h.service.DiffRevisions(ctx, req.Id, req.FromRevision, req.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	protoChanges := make([]*todov1.FieldChange, 0, len(changes))
+	for _, change := range changes {
+		oldValue, err := convertFieldValue(change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := convertFieldValue(change.NewValue)
+		if err != nil {
+			return nil, err
+		}
+		protoChanges = append(protoChanges, &todov1.FieldChange{
+			Field:    change.Field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	return &todov1.DiffTODORevisionsResponse{
+		Changes: protoChanges,
+	}, nil
+}
+
+// RestoreTODORevision restores a TODO to an earlier revision.
+func (h *TODOHandler) RestoreTODORevision(ctx context.Context, req *todov1.RestoreTODORevisionRequest) (*todov1.RestoreTODORevisionResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.service.RestoreRevision(ctx, userID, req.Id, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.RestoreTODORevisionResponse{
+		Todo: convertToProto(todo),
+	}, nil
+}
+
+// ListTrash lists the caller's TODOs in the trash.
+func (h *TODOHandler) ListTrash(ctx context.Context, req *todov1.ListTrashRequest) (*todov1.ListTrashResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todos, pagination, err := h.trash.ListTrash(ctx, userID,
+		req.GetPagination().GetPage(), req.GetPagination().GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	protoTodos := make([]*todov1.TODO, 0, len(todos))
+	for _, todo := range todos {
+		protoTodos = append(protoTodos, convertToProto(todo))
+	}
+
+	return &todov1.ListTrashResponse{
+		Todos: protoTodos,
+		Pagination: &commonv1.PaginationResponse{
+			TotalItems:  pagination.TotalItems,
+			TotalPages:  pagination.TotalPages,
+			CurrentPage: pagination.CurrentPage,
+			PageSize:    pagination.PageSize,
+			HasNext:     pagination.HasNext,
+			HasPrev:     pagination.HasPrev,
+		},
+	}, nil
+}
+
+// RestoreTODO restores a TODO from the trash.
+func (h *TODOHandler) RestoreTODO(ctx context.Context, req *todov1.RestoreTODORequest) (*todov1.RestoreTODOResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := h.trash.RestoreTODO(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.RestoreTODOResponse{
+		Todo: convertToProto(todo),
+	}, nil
+}
+
+// PurgeTODO permanently deletes a TODO in the trash.
+func (h *TODOHandler) PurgeTODO(ctx context.Context, req *todov1.PurgeTODORequest) (*todov1.PurgeTODOResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.trash.PurgeTODO(ctx, userID, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &todov1.PurgeTODOResponse{}, nil
+}
+
+// AddTODODependency records that a TODO is blocked by another TODO.
+func (h *TODOHandler) AddTODODependency(ctx context.Context, req *todov1.AddTODODependencyRequest) (*todov1.AddTODODependencyResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.AddDependency(ctx, userID, req.Id, req.BlockedById); err != nil {
+		return nil, err
+	}
+
+	return &todov1.AddTODODependencyResponse{}, nil
+}
+
+// RemoveTODODependency removes a dependency of a TODO on another TODO.
+func (h *TODOHandler) RemoveTODODependency(ctx context.Context, req *todov1.RemoveTODODependencyRequest) (*todov1.RemoveTODODependencyResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RemoveDependency(ctx, userID, req.Id, req.BlockedById); err != nil {
+		return nil, err
+	}
+
+	return &todov1.RemoveTODODependencyResponse{}, nil
+}
+
+// ListTODODependencies lists the TODOs that a TODO is blocked by and blocks.
+func (h *TODOHandler) ListTODODependencies(ctx context.Context, req *todov1.ListTODODependenciesRequest) (*todov1.ListTODODependenciesResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedBy, blocking, err := h.service.ListDependencies(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &todov1.ListTODODependenciesResponse{
+		BlockedBy: make([]*todov1.TODO, 0, len(blockedBy)),
+		Blocking:  make([]*todov1.TODO, 0, len(blocking)),
+	}
+	for _, todo := range blockedBy {
+		resp.BlockedBy = append(resp.BlockedBy, convertToProto(todo))
+	}
+	for _, todo := range blocking {
+		resp.Blocking = append(resp.Blocking, convertToProto(todo))
+	}
+	return resp, nil
+}
+
+// GetTODOTree gets a TODO with its subtasks, nested to the requested depth.
+func (h *TODOHandler) GetTODOTree(ctx context.Context, req *todov1.GetTODOTreeRequest) (*todov1.GetTODOTreeResponse, error) {
+	tree, err := h.service.GetTODOTree(ctx, req.Id, req.Depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.GetTODOTreeResponse{Root: convertTreeNodeToProto(tree)}, nil
+}
+
 // Helper functions
 
+// expectedVersion returns the version that a write to a TODO is conditional
+// on: the version in the request if set, or else the ETag in an If-Match
+// header forwarded by the gateway. Zero means the write is unconditional, as
+// with If-Match: *.
+func expectedVersion(ctx context.Context, requested int64) (int64, error) {
+	if requested != 0 {
+		return requested, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	values := md.Get("grpcgateway-if-match")
+	if len(values) == 0 {
+		values = md.Get("if-match")
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	tag := strings.TrimSpace(values[0])
+	if tag == "*" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(tag, "W/"), `"`), 10, 64)
+	if err != nil || version < 1 {
+		return 0, grpcstatus.Error(codes.InvalidArgument, "If-Match must be the ETag of the todo")
+	}
+	return version, nil
+}
+
+// setETag sends the TODO's version as the ETag response header. Outside of a
+// gRPC call there is nowhere to send it, so errors are ignored.
+func setETag(ctx context.Context, todo *domain.TODO) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(strconv.FormatInt(todo.Version, 10))))
+}
+
 // convertToProto converts a domain TODO to a proto TODO message.
 func convertToProto(todo *domain.TODO) *todov1.TODO {
 	pb := &todov1.TODO{
-		Id:          todo.ID,
-		UserId:      todo.UserID,
-		Title:       todo.Title,
-		Description: todo.Description,
-		Status:      todo.Status,
-		Priority:    todo.Priority,
-		Tags:        todo.Tags,
-		Position:    todo.Position,
+		Id:             todo.ID,
+		UserId:         todo.UserID,
+		Title:          todo.Title,
+		Description:    todo.Description,
+		Status:         todo.Status,
+		Priority:       todo.Priority,
+		Tags:           todo.Tags,
+		Position:       todo.Position,
+		OrderKey:       todo.OrderKey,
+		RecurrenceRule: todo.RecurrenceRule,
+		Occurrence:     todo.Occurrence,
+		Version:        todo.Version,
 	}
 
 	if todo.DueDate != nil {
@@ -330,6 +758,9 @@ func convertToProto(todo *domain.TODO) *todov1.TODO {
 	if todo.CompletedAt != nil {
 		pb.CompletedAt = timestamppb.New(*todo.CompletedAt)
 	}
+	if todo.DeletedAt != nil {
+		pb.DeletedAt = timestamppb.New(*todo.DeletedAt)
+	}
 	if todo.AssignedTo != nil {
 		pb.AssignedTo = *todo.AssignedTo
 	}
@@ -343,6 +774,136 @@ func convertToProto(todo *domain.TODO) *todov1.TODO {
 	return pb
 }
 
+// errorCodes maps gRPC status codes to the error codes reported for the
+// items of bulk operations
+var errorCodes = map[codes.Code]commonv1.ErrorCode{
+	codes.InvalidArgument:    commonv1.ErrorCode_ERROR_CODE_INVALID_INPUT,
+	codes.FailedPrecondition: commonv1.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	codes.NotFound:           commonv1.ErrorCode_ERROR_CODE_RESOURCE_NOT_FOUND,
+	codes.PermissionDenied:   commonv1.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+	codes.AlreadyExists:      commonv1.ErrorCode_ERROR_CODE_RESOURCE_ALREADY_EXISTS,
+	codes.Aborted:            commonv1.ErrorCode_ERROR_CODE_RESOURCE_CONFLICT,
+}
+
+// convertErrorToProto converts a gRPC error to a proto error message.
+func convertErrorToProto(err error) *commonv1.Error {
+	st := grpcstatus.Convert(err)
+	code, ok := errorCodes[st.Code()]
+	if !ok {
+		code = commonv1.ErrorCode_ERROR_CODE_INTERNAL_ERROR
+	}
+
+	return &commonv1.Error{
+		Code:    code,
+		Message: st.Message(),
+	}
+}
+
+// convertBulkResultsToProto converts the results of a bulk operation to
+// proto format and counts the TODOs that succeeded and failed.
+func convertBulkResultsToProto(results []*service.BulkUpdateResult) (pbs []*todov1.BulkUpdateResult, succeeded, failed int32) {
+	pbs = make([]*todov1.BulkUpdateResult, 0, len(results))
+	for _, result := range results {
+		pb := &todov1.BulkUpdateResult{Id: result.ID}
+		if result.Err != nil {
+			pb.Error = convertErrorToProto(result.Err)
+			failed++
+		} else {
+			pb.Success = true
+			if result.TODO != nil {
+				pb.Todo = convertToProto(result.TODO)
+			}
+			succeeded++
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, succeeded, failed
+}
+
+// convertPatchFromProto converts the field values of a patch to a domain
+// TODO. Empty IDs become nil, so that masking them clears the field.
+func convertPatchFromProto(pb *todov1.TODO) *domain.TODO {
+	todo := &domain.TODO{
+		Title:          pb.GetTitle(),
+		Description:    pb.GetDescription(),
+		Status:         pb.GetStatus(),
+		Priority:       pb.GetPriority(),
+		Tags:           pb.GetTags(),
+		Position:       pb.GetPosition(),
+		RecurrenceRule: pb.GetRecurrenceRule(),
+	}
+
+	if pb.GetDueDate() != nil {
+		dueDate := pb.GetDueDate().AsTime()
+		todo.DueDate = &dueDate
+	}
+	if assignedTo := pb.GetAssignedTo(); assignedTo != "" {
+		todo.AssignedTo = &assignedTo
+	}
+	if parentID := pb.GetParentId(); parentID != "" {
+		todo.ParentID = &parentID
+	}
+
+	return todo
+}
+
+// convertTreeNodeToProto converts a domain TODO tree node and its children to
+// a proto message.
+func convertTreeNodeToProto(node *domain.TODOTreeNode) *todov1.TODOTreeNode {
+	pb := &todov1.TODOTreeNode{
+		Todo:              convertToProto(node.TODO),
+		Children:          make([]*todov1.TODOTreeNode, 0, len(node.Children)),
+		CompletedSubtasks: node.CompletedSubtasks,
+		TotalSubtasks:     node.TotalSubtasks,
+		Progress:          node.Progress(),
+	}
+	for _, child := range node.Children {
+		pb.Children = append(pb.Children, convertTreeNodeToProto(child))
+	}
+	return pb
+}
+
+// convertRevisionToProto converts a domain TODO revision to a proto message.
+// The snapshot is given as a TODO holding the fields recorded in it.
+func convertRevisionToProto(revision *domain.TODORevision) *todov1.TODORevision {
+	snapshot := revision.Snapshot
+	return &todov1.TODORevision{
+		TodoId:        revision.TODOID,
+		Revision:      revision.Revision,
+		ActorId:       revision.ActorID,
+		Action:        revision.Action,
+		ChangedFields: revision.ChangedFields,
+		Snapshot: convertToProto(&domain.TODO{
+			ID:             revision.TODOID,
+			Title:          snapshot.Title,
+			Description:    snapshot.Description,
+			Status:         snapshot.Status,
+			Priority:       snapshot.Priority,
+			DueDate:        snapshot.DueDate,
+			Tags:           snapshot.Tags,
+			AssignedTo:     snapshot.AssignedTo,
+			ParentID:       snapshot.ParentID,
+			Position:       snapshot.Position,
+			OrderKey:       snapshot.OrderKey,
+			RecurrenceRule: snapshot.RecurrenceRule,
+			Occurrence:     snapshot.Occurrence,
+		}),
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
+
+// convertFieldValue converts the value of a field change to a proto value.
+func convertFieldValue(value interface{}) (*structpb.Value, error) {
+	if tags, ok := value.([]string); ok {
+		list := make([]interface{}, len(tags))
+		for i, tag := range tags {
+			list[i] = tag
+		}
+		value = list
+	}
+	return structpb.NewValue(value)
+}
+
 // convertFilter converts a proto ListTODOsRequest to a domain TODOFilter.
 // The userID parameter is the authenticated user's ID, which will be used as the default
 // filter unless overridden by req.UserId.
@@ -414,6 +975,8 @@ func convertFilter(req *todov1.ListTODOsRequest, userID string) domain.TODOFilte
 		filter.SearchFields = []string{"title", "description", "tags"}
 	}
 
+	filter.ReadyToStart = req.ReadyToStart
+
 	return filter
 }
 
@@ -525,6 +1088,11 @@ func (h *TODOHandler) HandleAdvancedSearch(w http.ResponseWriter, r *http.Reques
 		filter.IsShared = &shared
 	}
 
+	// Ready to start filter
+	if ready := query.Get("ready_to_start"); ready != "" {
+		filter.ReadyToStart = ready == "true" || ready == "1"
+	}
+
 	// Search query
 	if searchQuery := query.Get("q"); searchQuery != "" {
 		filter.SearchQuery = &searchQuery
@@ -612,6 +1180,9 @@ func convertTODOsToMap(todos []*domain.TODO) []map[string]interface{} {
 			"priority":    todo.Priority.String(),
 			"tags":        todo.Tags,
 			"position":    todo.Position,
+			"order_key":   todo.OrderKey,
+			"version":     todo.Version,
+			"occurrence":  todo.Occurrence,
 			"created_at":  todo.CreatedAt.Format(time.RFC3339),
 			"updated_at":  todo.UpdatedAt.Format(time.RFC3339),
 		}
@@ -631,6 +1202,9 @@ func convertTODOsToMap(todos []*domain.TODO) []map[string]interface{} {
 		if todo.TeamID != nil {
 			todoMap["team_id"] = *todo.TeamID
 		}
+		if todo.RecurrenceRule != "" {
+			todoMap["recurrence_rule"] = todo.RecurrenceRule
+		}
 		todoMap["is_shared"] = todo.IsShared
 
 		result = append(result, todoMap)
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/app/service"
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/middleware"
+)
+
+// UserAdminHandler handles user administration gRPC requests
+type UserAdminHandler struct {
+	userAdminService *service.UserAdminService
+	todov1.UnimplementedUserAdminServiceServer
+}
+
+// NewUserAdminHandler creates a new user admin handler
+func NewUserAdminHandler(userAdminService *service.UserAdminService) *UserAdminHandler {
+	return &UserAdminHandler{
+		userAdminService: userAdminService,
+	}
+}
+
+// ListUsers lists and searches users
+func (h *UserAdminHandler) ListUsers(ctx context.Context, req *todov1.ListUsersRequest) (*todov1.ListUsersResponse, error) {
+	options := domain.UserListOptions{
+		Query:    req.Query,
+		IsActive: req.IsActive,
+		Page:     req.GetPagination().GetPage(),
+		PageSize: req.GetPagination().GetPageSize(),
+	}
+
+	users, pagination, err := h.userAdminService.ListUsers(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	protoUsers := make([]*todov1.User, 0, len(users))
+	for _, user := range users {
+		protoUsers = append(protoUsers, domainUserToProto(user))
+	}
+
+	return &todov1.ListUsersResponse{
+		Users: protoUsers,
+		Pagination: &commonv1.PaginationResponse{
+			TotalItems:  pagination.TotalItems,
+			TotalPages:  pagination.TotalPages,
+			CurrentPage: pagination.CurrentPage,
+			PageSize:    pagination.PageSize,
+			HasNext:     pagination.HasNext,
+			HasPrev:     pagination.HasPrev,
+		},
+	}, nil
+}
+
+// UnlockUser lifts a login lockout of a user's account
+func (h *UserAdminHandler) UnlockUser(ctx context.Context, req *todov1.UnlockUserRequest) (*todov1.UnlockUserResponse, error) {
+	adminID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.userAdminService.UnlockUser(ctx, adminID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &todov1.UnlockUserResponse{}, nil
+}
+
+// DeactivateUser prevents a user from signing in and ends their sessions
+func (h *UserAdminHandler) DeactivateUser(ctx context.Context, req *todov1.DeactivateUserRequest) (*todov1.DeactivateUserResponse, error) {
+	adminID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userAdminService.DeactivateUser(ctx, adminID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.DeactivateUserResponse{User: domainUserToProto(user)}, nil
+}
+
+// ReactivateUser allows a deactivated user to sign in again
+func (h *UserAdminHandler) ReactivateUser(ctx context.Context, req *todov1.ReactivateUserRequest) (*todov1.ReactivateUserResponse, error) {
+	adminID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userAdminService.ReactivateUser(ctx, adminID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.ReactivateUserResponse{User: domainUserToProto(user)}, nil
+}
+
+// ForcePasswordReset invalidates a user's password and emails them a reset link
+func (h *UserAdminHandler) ForcePasswordReset(ctx context.Context, req *todov1.ForcePasswordResetRequest) (*todov1.ForcePasswordResetResponse, error) {
+	adminID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.userAdminService.ForcePasswordReset(ctx, adminID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &todov1.ForcePasswordResetResponse{}, nil
+}
+
+// DeleteUser permanently deletes a user
+func (h *UserAdminHandler) DeleteUser(ctx context.Context, req *todov1.DeleteUserRequest) (*todov1.DeleteUserResponse, error) {
+	adminID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.userAdminService.DeleteUser(ctx, adminID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &todov1.DeleteUserResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/venslupro/todo-api/internal/app/handlers"
+)
+
+// RegisterJWKSRoutes registers the JSON Web Key Set discovery route
+func RegisterJWKSRoutes(mux *http.ServeMux, jwksHandler *handlers.JWKSHandler) {
+	mux.HandleFunc("/.well-known/jwks.json", jwksHandler.HandleJWKS)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// purgeBatchSize is the maximum number of accounts deleted per purge run
+const purgeBatchSize = 100
+
+// AccountDeletionService handles the deletion of accounts by their owners. A
+// deletion is carried out once a grace period has passed, during which the
+// user can still sign in and cancel it.
+type AccountDeletionService struct {
+	userRepo    domain.UserRepository
+	authService *AuthService
+	gracePeriod time.Duration
+}
+
+// NewAccountDeletionService creates a new account deletion service
+func NewAccountDeletionService(userRepo domain.UserRepository, authService *AuthService, gracePeriod time.Duration) *AccountDeletionService {
+	return &AccountDeletionService{
+		userRepo:    userRepo,
+		authService: authService,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// RequestDeletion schedules the deletion of the user's account once the grace
+// period has passed. The user must confirm their password; accounts without
+// one, such as those provisioned through SSO, must first set a password
+// through a password reset. Requesting a deletion that is already scheduled
+// keeps the original schedule.
+func (s *AccountDeletionService) RequestDeletion(ctx context.Context, userID, password string) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "user not found")
+	}
+
+	if user.PasswordHash == "" {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "account has no password; set one with a password reset before deleting the account")
+	}
+	if !s.authService.password.CheckPassword(password, user.PasswordHash) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "password is incorrect")
+	}
+	if user.DeletionScheduledAt != nil {
+		return user, nil
+	}
+
+	user.ScheduleDeletion(time.Now().Add(s.gracePeriod))
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to schedule deletion: %v", err))
+	}
+
+	return user, nil
+}
+
+// CancelDeletion keeps an account whose deletion is scheduled
+func (s *AccountDeletionService) CancelDeletion(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "user not found")
+	}
+	if user.DeletionScheduledAt == nil {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "account deletion is not scheduled")
+	}
+
+	user.CancelDeletion()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to cancel deletion: %v", err))
+	}
+
+	return user, nil
+}
+
+// PurgeDue deletes the accounts whose grace period has passed and returns how
+// many were deleted. An account that fails to be deleted is logged and retried
+// on the next run.
+func (s *AccountDeletionService) PurgeDue(ctx context.Context) (int, error) {
+	users, err := s.userRepo.ListDueForDeletion(ctx, time.Now(), purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list accounts due for deletion: %w", err)
+	}
+
+	deleted := 0
+	for _, user := range users {
+		if err := s.authService.revokeAllSessions(ctx, user.ID); err != nil {
+			log.Printf("Failed to revoke sessions of user %s before deletion: %v", user.ID, err)
+			continue
+		}
+		if err := s.userRepo.Delete(ctx, user.ID); err != nil {
+			log.Printf("Failed to delete user %s: %v", user.ID, err)
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// StartPurging runs PurgeDue every interval until ctx is done
+func (s *AccountDeletionService) StartPurging(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				deleted, err := s.PurgeDue(ctx)
+				if err != nil {
+					log.Printf("Failed to purge deleted accounts: %v", err)
+				} else if deleted > 0 {
+					log.Printf("Deleted %d accounts after their grace period", deleted)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func newTestAccountDeletionService(t *testing.T, gracePeriod time.Duration) (*AccountDeletionService, *AuthService) {
+	t.Helper()
+	authService := newTestAuthService(t, nil)
+	return NewAccountDeletionService(authService.userRepo, authService, gracePeriod), authService
+}
+
+func TestAccountDeletionService_RequestAndCancel(t *testing.T) {
+	ctx := context.Background()
+	deletionService, authService := newTestAccountDeletionService(t, 30*24*time.Hour)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	if _, err := deletionService.CancelDeletion(ctx, user.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition without a scheduled deletion, got %v", err)
+	}
+	if _, err := deletionService.RequestDeletion(ctx, user.ID, "wrongpassword"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for wrong password, got %v", err)
+	}
+
+	before := time.Now()
+	user, err = deletionService.RequestDeletion(ctx, user.ID, "password123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.DeletionScheduledAt == nil || user.DeletionScheduledAt.Before(before.Add(30*24*time.Hour)) {
+		t.Fatalf("deletion scheduled at %v, want after the grace period", user.DeletionScheduledAt)
+	}
+	scheduledAt := *user.DeletionScheduledAt
+
+	if again, err := deletionService.RequestDeletion(ctx, user.ID, "password123"); err != nil || !again.DeletionScheduledAt.Equal(scheduledAt) {
+		t.Errorf("expected a repeated request to keep the schedule, got %v, %v", again, err)
+	}
+
+	// The user can still sign in during the grace period
+	if _, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{}); err != nil {
+		t.Errorf("expected login during the grace period, got %v", err)
+	}
+	if deleted, err := deletionService.PurgeDue(ctx); err != nil || deleted != 0 {
+		t.Errorf("PurgeDue() = %d, %v; want nothing deleted during the grace period", deleted, err)
+	}
+
+	user, err = deletionService.CancelDeletion(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.DeletionScheduledAt != nil {
+		t.Error("expected the scheduled deletion to be cleared")
+	}
+}
+
+func TestAccountDeletionService_RequiresPassword(t *testing.T) {
+	ctx := context.Background()
+	deletionService, authService := newTestAccountDeletionService(t, 30*24*time.Hour)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	// Like an account provisioned through SSO or after a forced password reset
+	user.PasswordHash = ""
+
+	if _, err := deletionService.RequestDeletion(ctx, user.ID, ""); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for an account without a password, got %v", err)
+	}
+	if user.DeletionScheduledAt != nil {
+		t.Error("expected no deletion to be scheduled")
+	}
+}
+
+func TestAccountDeletionService_PurgeDue(t *testing.T) {
+	ctx := context.Background()
+	deletionService, authService := newTestAccountDeletionService(t, 0)
+
+	doomed, err := authService.Register(ctx, "doomed@example.com", "doomed", "password123", "")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	kept, err := authService.Register(ctx, "kept@example.com", "kept", "password123", "")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	result, err := authService.Login(ctx, "doomed@example.com", "password123", ClientInfo{})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	if _, err := deletionService.RequestDeletion(ctx, doomed.ID, "password123"); err != nil {
+		t.Fatalf("failed to request deletion: %v", err)
+	}
+
+	deleted, err := deletionService.PurgeDue(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("deleted %d accounts, want 1", deleted)
+	}
+	if _, err := authService.GetUserByID(ctx, doomed.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("expected deleted user to be gone, got %v", err)
+	}
+	if _, err := authService.GetUserByID(ctx, kept.ID); err != nil {
+		t.Errorf("expected other user to be kept, got %v", err)
+	}
+	if _, err := authService.ValidateToken(ctx, result.Tokens.AccessToken); err == nil {
+		t.Error("expected access token of deleted user to be rejected")
+	}
+}
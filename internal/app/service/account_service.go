@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"github.com/venslupro/todo-api/internal/pkg/mailer"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// AccountService handles the email verification and password reset flows
+type AccountService struct {
+	userRepo           domain.UserRepository
+	tokenRepo          domain.UserTokenRepository
+	authService        *AuthService
+	accessTokens       *PersonalAccessTokenService
+	mailer             mailer.Mailer
+	baseURL            string
+	verificationExpiry time.Duration
+	resetExpiry        time.Duration
+}
+
+// NewAccountService creates a new account service. baseURL is the address of
+// the web app that hosts the verification and reset pages.
+func NewAccountService(
+	userRepo domain.UserRepository,
+	tokenRepo domain.UserTokenRepository,
+	authService *AuthService,
+	accessTokens *PersonalAccessTokenService,
+	m mailer.Mailer,
+	baseURL string,
+	verificationExpiry time.Duration,
+	resetExpiry time.Duration,
+) *AccountService {
+	return &AccountService{
+		userRepo:           userRepo,
+		tokenRepo:          tokenRepo,
+		authService:        authService,
+		accessTokens:       accessTokens,
+		mailer:             m,
+		baseURL:            strings.TrimRight(baseURL, "/"),
+		verificationExpiry: verificationExpiry,
+		resetExpiry:        resetExpiry,
+	}
+}
+
+// SendVerificationEmail emails the user a link to verify their email address
+func (s *AccountService) SendVerificationEmail(ctx context.Context, user *domain.User) error {
+	if user.EmailVerified {
+		return grpcstatus.Error(codes.FailedPrecondition, "email is already verified")
+	}
+
+	token, err := s.issueToken(ctx, user.ID, domain.UserTokenEmailVerification, s.verificationExpiry)
+	if err != nil {
+		return err
+	}
+
+	msg, err := mailer.VerificationEmail(user.Email, mailer.LinkData{
+		Name:      displayName(user),
+		Link:      s.link("/verify-email", token),
+		ExpiresIn: s.verificationExpiry.String(),
+	})
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return grpcstatus.Error(codes.Unavailable, fmt.Sprintf("failed to send verification email: %v", err))
+	}
+
+	return nil
+}
+
+// ResendVerificationEmail sends a new verification link to a user
+func (s *AccountService) ResendVerificationEmail(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return grpcstatus.Error(codes.NotFound, "user not found")
+	}
+
+	return s.SendVerificationEmail(ctx, user)
+}
+
+// VerifyEmail marks the email address of the token's owner as verified
+func (s *AccountService) VerifyEmail(ctx context.Context, token string) error {
+	if token == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "token is required")
+	}
+
+	userToken, err := s.consumeToken(ctx, token, domain.UserTokenEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userToken.UserID)
+	if err != nil {
+		return grpcstatus.Error(codes.NotFound, "user not found")
+	}
+
+	user.MarkEmailVerified()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to verify email: %v", err))
+	}
+
+	return nil
+}
+
+// RequestPasswordReset emails a password reset link to the account with the
+// given email address. It succeeds even if no such account exists, or if the
+// email cannot be sent, so that callers cannot probe for registered addresses.
+func (s *AccountService) RequestPasswordReset(ctx context.Context, email string) error {
+	if email == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "email is required")
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil || !user.IsActive {
+		return nil
+	}
+
+	if err := s.SendPasswordReset(ctx, user); err != nil {
+		log.Printf("Failed to send password reset email to user %s: %v", user.ID, err)
+	}
+	return nil
+}
+
+// SendPasswordReset emails the user a password reset link
+func (s *AccountService) SendPasswordReset(ctx context.Context, user *domain.User) error {
+	token, err := s.issueToken(ctx, user.ID, domain.UserTokenPasswordReset, s.resetExpiry)
+	if err != nil {
+		return err
+	}
+
+	msg, err := mailer.PasswordResetEmail(user.Email, mailer.LinkData{
+		Name:      displayName(user),
+		Link:      s.link("/reset-password", token),
+		ExpiresIn: s.resetExpiry.String(),
+	})
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return grpcstatus.Error(codes.Unavailable, fmt.Sprintf("failed to send password reset email: %v", err))
+	}
+
+	return nil
+}
+
+// ConfirmPasswordReset sets a new password for the token's owner, ends all
+// of their sessions and revokes their personal access tokens
+func (s *AccountService) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	if token == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "token is required")
+	}
+
+	userToken, err := s.lookupToken(ctx, token, domain.UserTokenPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userToken.UserID)
+	if err != nil {
+		return grpcstatus.Error(codes.NotFound, "user not found")
+	}
+
+	// Check the new password first so that a rejected password does not burn the token
+	if err := s.authService.checkNewPassword(user, newPassword); err != nil {
+		return err
+	}
+	if _, err := s.consumeToken(ctx, token, domain.UserTokenPasswordReset); err != nil {
+		return err
+	}
+
+	if err := s.authService.setPassword(ctx, user, newPassword); err != nil {
+		return err
+	}
+
+	// Receiving the reset link proves ownership of the address
+	if !user.EmailVerified {
+		user.MarkEmailVerified()
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to verify email: %v", err))
+		}
+	}
+
+	return s.revokeCredentials(ctx, user.ID)
+}
+
+// revokeCredentials ends every session of the user and revokes their
+// personal access tokens, which would otherwise keep working after a
+// password reset
+func (s *AccountService) revokeCredentials(ctx context.Context, userID string) error {
+	if err := s.authService.revokeAllSessions(ctx, userID); err != nil {
+		return err
+	}
+	return s.accessTokens.RevokeAll(ctx, userID)
+}
+
+// issueToken creates and stores a new single-use token and returns its plain value
+func (s *AccountService) issueToken(ctx context.Context, userID string, purpose domain.UserTokenPurpose, expiry time.Duration) (string, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", grpcstatus.Error(codes.Internal, err.Error())
+	}
+
+	userToken := domain.NewUserToken(userID, auth.HashOpaqueToken(token), purpose, time.Now().Add(expiry))
+	if err := s.tokenRepo.Create(ctx, userToken); err != nil {
+		return "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to store token: %v", err))
+	}
+
+	return token, nil
+}
+
+// lookupToken checks a single-use token without redeeming it
+func (s *AccountService) lookupToken(ctx context.Context, token string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	userToken, err := s.tokenRepo.Get(ctx, auth.HashOpaqueToken(token), purpose)
+	if errors.Is(err, domain.ErrUserTokenInvalid) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to look up token: %v", err))
+	}
+
+	return userToken, nil
+}
+
+// consumeToken redeems a single-use token
+func (s *AccountService) consumeToken(ctx context.Context, token string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	userToken, err := s.tokenRepo.Consume(ctx, auth.HashOpaqueToken(token), purpose)
+	if errors.Is(err, domain.ErrUserTokenInvalid) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to redeem token: %v", err))
+	}
+
+	return userToken, nil
+}
+
+// link builds a web app link carrying a token
+func (s *AccountService) link(path, token string) string {
+	return s.baseURL + path + "?token=" + url.QueryEscape(token)
+}
+
+// displayName returns the name to greet a user with
+func displayName(user *domain.User) string {
+	if user.FullName != "" {
+		return user.FullName
+	}
+	return user.Username
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"github.com/venslupro/todo-api/internal/pkg/mailer"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockUserTokenRepository is a mock implementation of UserTokenRepository for testing
+type MockUserTokenRepository struct {
+	tokens map[string]*domain.UserToken
+}
+
+func NewMockUserTokenRepository() *MockUserTokenRepository {
+	return &MockUserTokenRepository{
+		tokens: make(map[string]*domain.UserToken),
+	}
+}
+
+func (m *MockUserTokenRepository) Create(ctx context.Context, token *domain.UserToken) error {
+	for hash, existing := range m.tokens {
+		if existing.UserID == token.UserID && existing.Purpose == token.Purpose && existing.UsedAt == nil {
+			delete(m.tokens, hash)
+		}
+	}
+	m.tokens[token.TokenHash] = token
+	return nil
+}
+
+func (m *MockUserTokenRepository) Get(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	token, ok := m.tokens[tokenHash]
+	if !ok || token.Purpose != purpose || token.UsedAt != nil || time.Now().After(token.ExpiresAt) {
+		return nil, domain.ErrUserTokenInvalid
+	}
+	return token, nil
+}
+
+func (m *MockUserTokenRepository) Consume(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	token, err := m.Get(ctx, tokenHash, purpose)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	token.UsedAt = &now
+	return token, nil
+}
+
+// MockMailer records sent messages for testing
+type MockMailer struct {
+	sent []*mailer.Message
+	err  error // Returned by Send instead of recording the message
+}
+
+func (m *MockMailer) Send(ctx context.Context, msg *mailer.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// lastToken extracts the token from the link in the most recently sent message
+func (m *MockMailer) lastToken(t *testing.T) string {
+	t.Helper()
+	if len(m.sent) == 0 {
+		t.Fatal("no email was sent")
+	}
+	for _, field := range strings.Fields(m.sent[len(m.sent)-1].Body) {
+		if u, err := url.Parse(field); err == nil && u.Query().Get("token") != "" {
+			return u.Query().Get("token")
+		}
+	}
+	t.Fatal("sent email does not contain a token link")
+	return ""
+}
+
+func newTestAccountService(t *testing.T) (*AccountService, *AuthService, *MockMailer) {
+	t.Helper()
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
+	m := &MockMailer{}
+	accessTokens := NewPersonalAccessTokenService(NewMockPersonalAccessTokenRepository(), authService.userRepo)
+	accountService := NewAccountService(authService.userRepo, NewMockUserTokenRepository(), authService, accessTokens, m,
+		"https://app.example.com/", 24*time.Hour, time.Hour)
+	return accountService, authService, m
+}
+
+func TestAccountService_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	accountService, authService, m := newTestAccountService(t)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	if err := accountService.SendVerificationEmail(ctx, user); err != nil {
+		t.Fatalf("failed to send verification email: %v", err)
+	}
+	if !strings.Contains(m.sent[0].Body, "https://app.example.com/verify-email?token=") {
+		t.Errorf("unexpected verification link in body:\n%s", m.sent[0].Body)
+	}
+	token := m.lastToken(t)
+
+	if err := accountService.VerifyEmail(ctx, "bogus"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for unknown token, got %v", err)
+	}
+	if err := accountService.VerifyEmail(ctx, token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	verified, _ := authService.GetUserByID(ctx, user.ID)
+	if !verified.EmailVerified {
+		t.Error("expected email to be verified")
+	}
+
+	if err := accountService.VerifyEmail(ctx, token); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected token to be single-use, got %v", err)
+	}
+	if err := accountService.SendVerificationEmail(ctx, verified); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for verified email, got %v", err)
+	}
+}
+
+func TestAccountService_PasswordReset(t *testing.T) {
+	ctx := context.Background()
+	accountService, authService, m := newTestAccountService(t)
+
+	if _, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User"); err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	sessionResult, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	session := sessionResult.Tokens
+	_, secret, err := accountService.accessTokens.Create(ctx, sessionResult.User.ID, "ci", []string{auth.ScopeTODOsRead}, nil)
+	if err != nil {
+		t.Fatalf("failed to create personal access token: %v", err)
+	}
+
+	// Unknown addresses are accepted silently
+	if err := accountService.RequestPasswordReset(ctx, "unknown@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(m.sent) != 0 {
+		t.Fatalf("expected no email for unknown address, got %d", len(m.sent))
+	}
+
+	// A mail failure for a registered address looks like an unknown address
+	m.err = errors.New("mail server unavailable")
+	if err := accountService.RequestPasswordReset(ctx, "test@example.com"); err != nil {
+		t.Fatalf("expected mail failure to be hidden, got %v", err)
+	}
+	m.err = nil
+
+	if err := accountService.RequestPasswordReset(ctx, "test@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token := m.lastToken(t)
+
+	if err := accountService.ConfirmPasswordReset(ctx, token, "short"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected policy violation, got %v", err)
+	}
+	if err := accountService.ConfirmPasswordReset(ctx, token, "password123"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected reuse of the current password to be rejected, got %v", err)
+	}
+	if err := accountService.ConfirmPasswordReset(ctx, token, "newpassword123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := accountService.ConfirmPasswordReset(ctx, token, "otherpassword123"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected token to be single-use, got %v", err)
+	}
+
+	if _, err := authService.Login(ctx, "test@example.com", "newpassword123", ClientInfo{}); err != nil {
+		t.Errorf("expected login with new password to succeed, got %v", err)
+	}
+	if _, _, err := authService.RefreshToken(ctx, session.RefreshToken); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected existing sessions to be revoked, got %v", err)
+	}
+	if _, _, err := accountService.accessTokens.VerifyPersonalAccessToken(ctx, secret); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected personal access tokens to be revoked, got %v", err)
+	}
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/venslupro/todo-api/internal/domain"
 	"github.com/venslupro/todo-api/internal/pkg/auth"
@@ -12,17 +15,73 @@ import (
 
 // AuthService provides authentication and authorization services
 type AuthService struct {
-	userRepo domain.UserRepository
-	jwtMgr   *auth.JWTManager
-	password *PasswordManager
+	userRepo           domain.UserRepository
+	sessionRepo        domain.SessionRepository
+	jwtMgr             *auth.JWTManager
+	revocations        auth.RevocationStore
+	password           *PasswordManager
+	refreshTokenExpiry time.Duration
+	loginGuard         *LoginGuard
+}
+
+// ClientInfo describes the device a session is opened from
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// TokenPair holds the tokens issued for a session
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// TwoFactorChallenge is issued instead of tokens when a user who has
+// two-factor authentication enabled passes the password step
+type TwoFactorChallenge struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// LoginResult is the outcome of a password or single sign-on login. Exactly
+// one of Tokens and Challenge is set.
+type LoginResult struct {
+	User      *domain.User
+	Tokens    *TokenPair
+	Challenge *TwoFactorChallenge
 }
 
 // PasswordManager wraps password operations
-type PasswordManager struct{}
+type PasswordManager struct {
+	policy *auth.PasswordPolicy
+	hasher *auth.PasswordHasher
+}
+
+// Validate checks a new password against the password policy and the
+// longest password the hasher can hash
+func (p *PasswordManager) Validate(password string) error {
+	if maxLength := p.hasher.MaxPasswordLength(); maxLength > 0 && len(password) > maxLength {
+		return grpcstatus.Error(codes.InvalidArgument, fmt.Sprintf("password must be at most %d bytes long", maxLength))
+	}
+	if p.policy == nil {
+		return nil
+	}
+	if err := p.policy.Validate(password); err != nil {
+		return grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
 
 // HashPassword hashes a password
 func (p *PasswordManager) HashPassword(password string) (string, error) {
-	return auth.HashPassword(password)
+	return p.hasher.Hash(password)
+}
+
+// NeedsRehash reports whether a hash is outdated
+func (p *PasswordManager) NeedsRehash(hash string) bool {
+	return p.hasher.NeedsRehash(hash)
 }
 
 // CheckPassword checks if a password matches a hash
@@ -30,12 +89,31 @@ func (p *PasswordManager) CheckPassword(password, hash string) bool {
 	return auth.CheckPassword(password, hash)
 }
 
-// NewAuthService creates a new auth service
-func NewAuthService(userRepo domain.UserRepository, jwtMgr *auth.JWTManager) *AuthService {
+// NewAuthService creates a new auth service. passwordHasher may be nil to use
+// auth.DefaultPasswordHasher, and loginGuard may be nil to leave password
+// logins unthrottled.
+func NewAuthService(
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	jwtMgr *auth.JWTManager,
+	revocations auth.RevocationStore,
+	passwordPolicy *auth.PasswordPolicy,
+	passwordHasher *auth.PasswordHasher,
+	refreshTokenExpiry time.Duration,
+	loginGuard *LoginGuard,
+) *AuthService {
+	if passwordHasher == nil {
+		passwordHasher = auth.DefaultPasswordHasher()
+	}
+
 	return &AuthService{
-		userRepo: userRepo,
-		jwtMgr:   jwtMgr,
-		password: &PasswordManager{},
+		userRepo:           userRepo,
+		sessionRepo:        sessionRepo,
+		jwtMgr:             jwtMgr,
+		revocations:        revocations,
+		password:           &PasswordManager{policy: passwordPolicy, hasher: passwordHasher},
+		refreshTokenExpiry: refreshTokenExpiry,
+		loginGuard:         loginGuard,
 	}
 }
 
@@ -51,6 +129,9 @@ func (s *AuthService) Register(ctx context.Context, email, username, password, f
 	if password == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "password is required")
 	}
+	if err := s.password.Validate(password); err != nil {
+		return nil, err
+	}
 
 	// Check if email already exists
 	exists, err := s.userRepo.ExistsByEmail(ctx, email)
@@ -89,22 +170,97 @@ func (s *AuthService) Register(ctx context.Context, email, username, password, f
 	return user, nil
 }
 
-// Login authenticates a user and returns a JWT token
-func (s *AuthService) Login(ctx context.Context, email, password string) (*domain.User, string, error) {
+// Login authenticates a user and opens a new session for the client. Users
+// with two-factor authentication enabled get a challenge instead, which is
+// exchanged for tokens by TwoFactorService.CompleteLogin.
+func (s *AuthService) Login(ctx context.Context, email, password string, client ClientInfo) (*LoginResult, error) {
+	if s.loginGuard != nil {
+		if err := s.loginGuard.Check(ctx, email, client); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get user by email
 	user, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return nil, "", grpcstatus.Error(codes.Unauthenticated, "invalid email or password")
+		s.recordLoginFailure(ctx, email, nil, client)
+		return nil, grpcstatus.Error(codes.Unauthenticated, "invalid email or password")
 	}
 
 	// Check password
 	if !s.password.CheckPassword(password, user.PasswordHash) {
-		return nil, "", grpcstatus.Error(codes.Unauthenticated, "invalid email or password")
+		s.recordLoginFailure(ctx, email, user, client)
+		return nil, grpcstatus.Error(codes.Unauthenticated, "invalid email or password")
+	}
+
+	if s.loginGuard != nil {
+		s.loginGuard.RecordSuccess(ctx, email)
+	}
+
+	// The plain password is only available now, so outdated hashes are
+	// upgraded here
+	if s.password.NeedsRehash(user.PasswordHash) {
+		s.rehashPassword(ctx, user, password)
+	}
+
+	return s.completeFirstFactor(ctx, user, client)
+}
+
+// completeFirstFactor finishes a login in which the user has passed the first
+// factor: a session is opened, or a challenge is issued if the user has
+// two-factor authentication enabled
+func (s *AuthService) completeFirstFactor(ctx context.Context, user *domain.User, client ClientInfo) (*LoginResult, error) {
+	if user.TwoFactorEnabled {
+		if !user.IsActive {
+			return nil, grpcstatus.Error(codes.PermissionDenied, "user account is inactive")
+		}
+
+		token, expiresAt, err := s.jwtMgr.GeneratePurpose(user.ID, twoFactorChallengePurpose, twoFactorChallengeTTL)
+		if err != nil {
+			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to generate challenge: %v", err))
+		}
+
+		return &LoginResult{
+			User:      user,
+			Challenge: &TwoFactorChallenge{Token: token, ExpiresAt: expiresAt},
+		}, nil
+	}
+
+	tokens, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResult{User: user, Tokens: tokens}, nil
+}
+
+// rehashPassword stores a new hash of a verified password made with the
+// current hashing settings. Failures are logged and do not fail the login.
+func (s *AuthService) rehashPassword(ctx context.Context, user *domain.User, password string) {
+	passwordHash, err := s.password.HashPassword(password)
+	if err != nil {
+		log.Printf("Failed to rehash password of user %s: %v", user.ID, err)
+		return
 	}
 
+	user.PasswordHash = passwordHash
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Printf("Failed to store rehashed password of user %s: %v", user.ID, err)
+	}
+}
+
+// recordLoginFailure counts a failed password attempt if logins are throttled
+func (s *AuthService) recordLoginFailure(ctx context.Context, email string, user *domain.User, client ClientInfo) {
+	if s.loginGuard != nil {
+		s.loginGuard.RecordFailure(ctx, email, user, client)
+	}
+}
+
+// startSession signs in an authenticated user
+func (s *AuthService) startSession(ctx context.Context, user *domain.User, client ClientInfo) (*TokenPair, error) {
 	// Check if user is active
 	if !user.IsActive {
-		return nil, "", grpcstatus.Error(codes.PermissionDenied, "user account is inactive")
+		return nil, grpcstatus.Error(codes.PermissionDenied, "user account is inactive")
 	}
 
 	// Update last login
@@ -114,13 +270,272 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*domai
 		_ = err
 	}
 
-	// Generate token
-	token, err := s.jwtMgr.Generate(user.ID, user.Username, user.Email)
+	return s.createSession(ctx, user, client)
+}
+
+// RefreshToken rotates a refresh token and issues a new token pair. Presenting
+// a refresh token that has already been rotated revokes the whole session.
+func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*domain.User, *TokenPair, error) {
+	if refreshToken == "" {
+		return nil, nil, grpcstatus.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	tokenHash := auth.HashOpaqueToken(refreshToken)
+	token, err := s.sessionRepo.GetRefreshToken(ctx, tokenHash)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, token.SessionID)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	if !session.IsActive() {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "session has been revoked or expired")
+	}
+
+	if token.UsedAt != nil {
+		return nil, nil, s.revokeReusedSession(ctx, session)
+	}
+	if time.Now().After(token.ExpiresAt) {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "refresh token has expired")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "user not found")
+	}
+	if !user.IsActive {
+		return nil, nil, grpcstatus.Error(codes.PermissionDenied, "user account is inactive")
+	}
+
+	nextToken, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to generate refresh token: %v", err))
+	}
+	expiresAt := time.Now().Add(s.refreshTokenExpiry)
+
+	next := domain.NewRefreshToken(session.ID, auth.HashOpaqueToken(nextToken), expiresAt)
+	if err := s.sessionRepo.Rotate(ctx, tokenHash, next); err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			return nil, nil, s.revokeReusedSession(ctx, session)
+		}
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to rotate refresh token: %v", err))
+	}
+
+	tokens, err := s.issueTokens(user, session.ID, nextToken, expiresAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// Logout revokes the caller's access token and ends the session identified
+// by the refresh token, or the session the access token belongs to when no
+// refresh token is given. With allDevices set, every token and session of the
+// user is revoked instead.
+func (s *AuthService) Logout(ctx context.Context, claims *auth.Claims, refreshToken string, allDevices bool) error {
+	if allDevices {
+		return s.revokeAllSessions(ctx, claims.UserID)
+	}
+
+	if claims.ExpiresAt != nil {
+		if err := s.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+			return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke token: %v", err))
+		}
+	}
+
+	sessionID := claims.SessionID
+	if refreshToken != "" {
+		token, err := s.sessionRepo.GetRefreshToken(ctx, auth.HashOpaqueToken(refreshToken))
+		if err != nil {
+			return grpcstatus.Error(codes.InvalidArgument, "invalid refresh token")
+		}
+		sessionID = token.SessionID
+	}
+
+	if sessionID == "" {
+		return nil
+	}
+
+	return s.RevokeSession(ctx, claims.UserID, sessionID)
+}
+
+// ListSessions retrieves the active sessions of a user
+func (s *AuthService) ListSessions(ctx context.Context, userID string) ([]*domain.Session, error) {
+	if userID == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	sessions, err := s.sessionRepo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list sessions: %v", err))
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes one of the user's sessions
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if sessionID == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return grpcstatus.Error(codes.NotFound, "session not found")
+	}
+	if session.RevokedAt != nil {
+		return nil
+	}
+
+	if err := s.sessionRepo.Revoke(ctx, sessionID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke session: %v", err))
+	}
+	if err := s.revocations.RevokeSession(ctx, sessionID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke session tokens: %v", err))
+	}
+
+	return nil
+}
+
+// ChangePassword changes the user's password after verifying the current one
+// and ends all of the user's other sessions
+func (s *AuthService) ChangePassword(ctx context.Context, claims *auth.Claims, currentPassword, newPassword string) error {
+	user, err := s.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return grpcstatus.Error(codes.NotFound, "user not found")
+	}
+
+	if !s.password.CheckPassword(currentPassword, user.PasswordHash) {
+		return grpcstatus.Error(codes.InvalidArgument, "current password is incorrect")
+	}
+	if err := s.setPassword(ctx, user, newPassword); err != nil {
+		return err
+	}
+
+	return s.revokeOtherSessions(ctx, user.ID, claims.SessionID)
+}
+
+// setPassword validates a new password against the policy and stores its hash
+func (s *AuthService) setPassword(ctx context.Context, user *domain.User, newPassword string) error {
+	if err := s.checkNewPassword(user, newPassword); err != nil {
+		return err
+	}
+
+	passwordHash, err := s.password.HashPassword(newPassword)
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to hash password: %v", err))
+	}
+
+	user.PasswordHash = passwordHash
+	user.UpdatedAt = time.Now()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to update password: %v", err))
+	}
+
+	return nil
+}
+
+// checkNewPassword checks that a new password of the user satisfies the
+// policy and differs from the current password
+func (s *AuthService) checkNewPassword(user *domain.User, newPassword string) error {
+	if err := s.password.Validate(newPassword); err != nil {
+		return err
+	}
+	if s.password.CheckPassword(newPassword, user.PasswordHash) {
+		return grpcstatus.Error(codes.InvalidArgument, "new password must differ from the current password")
+	}
+	return nil
+}
+
+// revokeAllSessions revokes every session and access token of the user. The
+// revocation cutoff may only have second precision, so the tokens of active
+// sessions are also revoked by session, which covers tokens issued in the
+// same second as the cutoff.
+func (s *AuthService) revokeAllSessions(ctx context.Context, userID string) error {
+	if err := s.revocations.RevokeAllBefore(ctx, userID, time.Now()); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke tokens: %v", err))
+	}
+
+	sessions, err := s.sessionRepo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list sessions: %v", err))
+	}
+	for _, session := range sessions {
+		if err := s.revocations.RevokeSession(ctx, session.ID); err != nil {
+			return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke session tokens: %v", err))
+		}
+	}
+
+	if err := s.sessionRepo.RevokeAllByUser(ctx, userID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke sessions: %v", err))
+	}
+	return nil
+}
+
+// revokeOtherSessions revokes every active session of the user except keepSessionID
+func (s *AuthService) revokeOtherSessions(ctx context.Context, userID, keepSessionID string) error {
+	sessions, err := s.sessionRepo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list sessions: %v", err))
+	}
+
+	for _, session := range sessions {
+		if session.ID == keepSessionID {
+			continue
+		}
+		if err := s.RevokeSession(ctx, userID, session.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createSession opens a new session for the user and issues its first tokens
+func (s *AuthService) createSession(ctx context.Context, user *domain.User, client ClientInfo) (*TokenPair, error) {
+	refreshToken, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to generate refresh token: %v", err))
+	}
+	expiresAt := time.Now().Add(s.refreshTokenExpiry)
+
+	session := domain.NewSession(user.ID, client.UserAgent, client.IPAddress, expiresAt)
+	token := domain.NewRefreshToken(session.ID, auth.HashOpaqueToken(refreshToken), expiresAt)
+	if err := s.sessionRepo.Create(ctx, session, token); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create session: %v", err))
+	}
+
+	return s.issueTokens(user, session.ID, refreshToken, expiresAt)
+}
+
+// issueTokens signs an access token for the session and pairs it with the refresh token
+func (s *AuthService) issueTokens(user *domain.User, sessionID, refreshToken string, refreshExpiresAt time.Time) (*TokenPair, error) {
+	accessToken, err := s.jwtMgr.GenerateForSession(user.ID, user.Username, user.Email, sessionID)
 	if err != nil {
-		return nil, "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to generate token: %v", err))
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to generate token: %v", err))
 	}
 
-	return user, token, nil
+	return &TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  time.Now().Add(s.jwtMgr.TokenDuration()),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+// revokeReusedSession revokes a session whose refresh token was replayed,
+// along with the access tokens issued for it
+func (s *AuthService) revokeReusedSession(ctx context.Context, session *domain.Session) error {
+	if err := s.sessionRepo.Revoke(ctx, session.ID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke session: %v", err))
+	}
+	if err := s.revocations.RevokeSession(ctx, session.ID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke session tokens: %v", err))
+	}
+	return grpcstatus.Error(codes.Unauthenticated, "refresh token reuse detected, session revoked")
 }
 
 // ValidateToken validates a JWT token and returns user information
@@ -130,6 +545,14 @@ func (s *AuthService) ValidateToken(ctx context.Context, token string) (*auth.Cl
 		return nil, grpcstatus.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
 	}
 
+	revoked, err := s.revocations.IsRevoked(ctx, claims)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unavailable, "failed to check token revocation")
+	}
+	if revoked {
+		return nil, grpcstatus.Error(codes.Unauthenticated, "token has been revoked")
+	}
+
 	// Optionally verify user still exists and is active
 	user, err := s.userRepo.GetByID(ctx, claims.UserID)
 	if err != nil {
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -69,6 +71,58 @@ func (m *MockUserRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockUserRepository) List(ctx context.Context, options domain.UserListOptions) ([]*domain.User, *domain.PaginationResult, error) {
+	query := strings.ToLower(options.Query)
+	var users []*domain.User
+	for _, user := range m.users {
+		if options.IsActive != nil && user.IsActive != *options.IsActive {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(user.Email+" "+user.Username+" "+user.FullName), query) {
+			continue
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Email < users[j].Email })
+
+	pageSize := options.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	page := options.Page
+	if page < 1 {
+		page = 1
+	}
+	total := int32(len(users))
+	start, end := (page-1)*pageSize, page*pageSize
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	totalPages := (total + pageSize - 1) / pageSize
+
+	return users[start:end], &domain.PaginationResult{
+		TotalItems:  total,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+		HasNext:     page < totalPages,
+		HasPrev:     page > 1,
+	}, nil
+}
+
+func (m *MockUserRepository) ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	var users []*domain.User
+	for _, user := range m.users {
+		if user.DeletionScheduledAt != nil && !user.DeletionScheduledAt.After(before) && len(users) < limit {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
 func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	for _, user := range m.users {
 		if user.Email == email {
@@ -87,11 +141,134 @@ func (m *MockUserRepository) ExistsByUsername(ctx context.Context, username stri
 	return false, nil
 }
 
+// MockSessionRepository is a mock implementation of SessionRepository for testing
+type MockSessionRepository struct {
+	sessions map[string]*domain.Session
+	tokens   map[string]*domain.RefreshToken
+}
+
+func NewMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{
+		sessions: make(map[string]*domain.Session),
+		tokens:   make(map[string]*domain.RefreshToken),
+	}
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, session *domain.Session, token *domain.RefreshToken) error {
+	m.sessions[session.ID] = session
+	m.tokens[token.TokenHash] = token
+	return nil
+}
+
+func (m *MockSessionRepository) GetByID(ctx context.Context, id string) (*domain.Session, error) {
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	return session, nil
+}
+
+func (m *MockSessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	token, ok := m.tokens[tokenHash]
+	if !ok {
+		return nil, &NotFoundError{ID: tokenHash}
+	}
+	return token, nil
+}
+
+func (m *MockSessionRepository) Rotate(ctx context.Context, oldTokenHash string, next *domain.RefreshToken) error {
+	old, ok := m.tokens[oldTokenHash]
+	if !ok {
+		return &NotFoundError{ID: oldTokenHash}
+	}
+	if old.UsedAt != nil {
+		return domain.ErrRefreshTokenReused
+	}
+	now := time.Now()
+	old.UsedAt = &now
+	m.tokens[next.TokenHash] = next
+	return nil
+}
+
+func (m *MockSessionRepository) ListActiveByUser(ctx context.Context, userID string) ([]*domain.Session, error) {
+	var sessions []*domain.Session
+	for _, session := range m.sessions {
+		if session.UserID == userID && session.IsActive() {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (m *MockSessionRepository) Revoke(ctx context.Context, id string) error {
+	session, ok := m.sessions[id]
+	if !ok {
+		return &NotFoundError{ID: id}
+	}
+	now := time.Now()
+	session.RevokedAt = &now
+	return nil
+}
+
+func (m *MockSessionRepository) RevokeAllByUser(ctx context.Context, userID string) error {
+	now := time.Now()
+	for _, session := range m.sessions {
+		if session.UserID == userID && session.RevokedAt == nil {
+			session.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// MockRevocationStore is a mock implementation of auth.RevocationStore for testing
+type MockRevocationStore struct {
+	revokedTokens map[string]bool
+	revokedBefore map[string]time.Time
+}
+
+func NewMockRevocationStore() *MockRevocationStore {
+	return &MockRevocationStore{
+		revokedTokens: make(map[string]bool),
+		revokedBefore: make(map[string]time.Time),
+	}
+}
+
+func (m *MockRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	m.revokedTokens[tokenID] = true
+	return nil
+}
+
+func (m *MockRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	m.revokedTokens[sessionID] = true
+	return nil
+}
+
+// RevokeAllBefore truncates the cutoff to the second, like the token
+// denylist, since token issue times have second precision
+func (m *MockRevocationStore) RevokeAllBefore(ctx context.Context, userID string, before time.Time) error {
+	m.revokedBefore[userID] = before.Truncate(time.Second)
+	return nil
+}
+
+func (m *MockRevocationStore) IsRevoked(ctx context.Context, claims *auth.Claims) (bool, error) {
+	if m.revokedTokens[claims.ID] || m.revokedTokens[claims.SessionID] {
+		return true, nil
+	}
+	before, ok := m.revokedBefore[claims.UserID]
+	return ok && claims.IssuedAt.Time.Before(before), nil
+}
+
+// newTestAuthService creates an auth service backed by mocks. policy may be
+// nil to accept any password.
+func newTestAuthService(t *testing.T, policy *auth.PasswordPolicy) *AuthService {
+	t.Helper()
+	return NewAuthService(NewMockUserRepository(), NewMockSessionRepository(), auth.NewJWTManager("test-secret", 24*time.Hour),
+		NewMockRevocationStore(), policy, nil, 7*24*time.Hour, nil)
+}
+
 func TestAuthService_Register(t *testing.T) {
 	ctx := context.Background()
-	userRepo := NewMockUserRepository()
-	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
-	authService := NewAuthService(userRepo, jwtMgr)
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
 
 	tests := []struct {
 		name        string
@@ -128,6 +305,15 @@ func TestAuthService_Register(t *testing.T) {
 			expectError: true,
 			errorCode:   codes.AlreadyExists,
 		},
+		{
+			name:        "password violates policy",
+			email:       "test3@example.com",
+			username:    "testuser3",
+			password:    "short",
+			fullName:    "Test User 3",
+			expectError: true,
+			errorCode:   codes.InvalidArgument,
+		},
 		{
 			name:        "empty email",
 			email:       "",
@@ -172,9 +358,7 @@ func TestAuthService_Register(t *testing.T) {
 
 func TestAuthService_Login(t *testing.T) {
 	ctx := context.Background()
-	userRepo := NewMockUserRepository()
-	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
-	authService := NewAuthService(userRepo, jwtMgr)
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
 
 	// First register a user
 	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
@@ -213,7 +397,7 @@ func TestAuthService_Login(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, token, err := authService.Login(ctx, tt.email, tt.password)
+			result, err := authService.Login(ctx, tt.email, tt.password, ClientInfo{})
 
 			if tt.expectError {
 				if err == nil {
@@ -228,12 +412,16 @@ func TestAuthService_Login(t *testing.T) {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if token == "" {
+				tokens := result.Tokens
+				if tokens.AccessToken == "" {
 					t.Error("expected token but got empty string")
 				}
+				if tokens.RefreshToken == "" {
+					t.Error("expected refresh token but got empty string")
+				}
 
 				// Validate the token
-				claims, err := jwtMgr.Validate(token)
+				claims, err := authService.jwtMgr.Validate(tokens.AccessToken)
 				if err != nil {
 					t.Fatalf("failed to validate token: %v", err)
 				}
@@ -245,11 +433,67 @@ func TestAuthService_Login(t *testing.T) {
 	}
 }
 
-func TestAuthService_ValidateToken(t *testing.T) {
+func TestAuthService_LoginRehashesPassword(t *testing.T) {
 	ctx := context.Background()
 	userRepo := NewMockUserRepository()
 	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
-	authService := NewAuthService(userRepo, jwtMgr)
+
+	bcryptHasher := &auth.PasswordHasher{Algorithm: auth.PasswordAlgorithmBcrypt, BcryptCost: 4}
+	legacyService := NewAuthService(userRepo, NewMockSessionRepository(), jwtMgr, NewMockRevocationStore(), nil, bcryptHasher, 7*24*time.Hour, nil)
+	user, err := legacyService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	if !strings.HasPrefix(user.PasswordHash, "$2") {
+		t.Fatalf("expected a bcrypt hash, got %q", user.PasswordHash)
+	}
+
+	authService := NewAuthService(userRepo, NewMockSessionRepository(), jwtMgr, NewMockRevocationStore(), nil, nil, 7*24*time.Hour, nil)
+	if _, err := authService.Login(ctx, "test@example.com", "wrongpassword", ClientInfo{}); err == nil {
+		t.Fatal("expected wrong password to be rejected")
+	}
+	if !strings.HasPrefix(user.PasswordHash, "$2") {
+		t.Fatal("expected hash to be kept after a failed login")
+	}
+
+	if _, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{}); err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	if !strings.HasPrefix(user.PasswordHash, "$argon2id$") {
+		t.Errorf("expected password to be rehashed with argon2id, got %q", user.PasswordHash)
+	}
+	if _, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{}); err != nil {
+		t.Errorf("expected login with rehashed password, got %v", err)
+	}
+}
+
+func TestAuthService_RegisterLongPassword(t *testing.T) {
+	ctx := context.Background()
+	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
+	long := "password123" + strings.Repeat("x", 80)
+
+	tests := []struct {
+		name     string
+		hasher   *auth.PasswordHasher
+		wantCode codes.Code
+	}{
+		{name: "argon2id has no limit", hasher: nil, wantCode: codes.OK},
+		{name: "bcrypt limit", hasher: &auth.PasswordHasher{Algorithm: auth.PasswordAlgorithmBcrypt, BcryptCost: 4}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService := NewAuthService(NewMockUserRepository(), NewMockSessionRepository(), jwtMgr, NewMockRevocationStore(), &auth.PasswordPolicy{MinLength: 8}, tt.hasher, 7*24*time.Hour, nil)
+			if _, err := authService.Register(ctx, "test@example.com", "testuser", long, "Test User"); grpcstatus.Code(err) != tt.wantCode {
+				t.Errorf("expected %v, got %v", tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_ValidateToken(t *testing.T) {
+	ctx := context.Background()
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
 
 	// Register a user
 	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
@@ -258,7 +502,7 @@ func TestAuthService_ValidateToken(t *testing.T) {
 	}
 
 	// Generate a valid token
-	token, err := jwtMgr.Generate(user.ID, user.Username, user.Email)
+	token, err := authService.jwtMgr.Generate(user.ID, user.Username, user.Email)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
@@ -318,9 +562,7 @@ func TestAuthService_ValidateToken(t *testing.T) {
 
 func TestAuthService_GetUserByID(t *testing.T) {
 	ctx := context.Background()
-	userRepo := NewMockUserRepository()
-	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
-	authService := NewAuthService(userRepo, jwtMgr)
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
 
 	// Register a user
 	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
@@ -377,3 +619,222 @@ func TestAuthService_GetUserByID(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
+
+	if _, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User"); err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	result, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{UserAgent: "test"})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	tokens := result.Tokens
+
+	// A valid refresh token is rotated
+	_, rotated, err := authService.RefreshToken(ctx, tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rotated.RefreshToken == tokens.RefreshToken {
+		t.Error("expected refresh token to be rotated")
+	}
+
+	claims, err := authService.jwtMgr.Validate(rotated.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate token: %v", err)
+	}
+	if claims.SessionID == "" {
+		t.Error("expected access token to carry the session ID")
+	}
+
+	// Replaying the old token revokes the session
+	_, _, err = authService.RefreshToken(ctx, tokens.RefreshToken)
+	if status, _ := grpcstatus.FromError(err); status.Code() != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated on reuse, got %v", err)
+	}
+
+	// The newest token of the revoked session no longer works either
+	_, _, err = authService.RefreshToken(ctx, rotated.RefreshToken)
+	if status, _ := grpcstatus.FromError(err); status.Code() != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated after revocation, got %v", err)
+	}
+
+	// Nor does the access token issued with it
+	if _, err := authService.ValidateToken(ctx, rotated.AccessToken); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected access token of the revoked session to be rejected, got %v", err)
+	}
+
+	sessions, err := authService.ListSessions(ctx, claims.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("expected no active sessions, got %d", len(sessions))
+	}
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	ctx := context.Background()
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
+
+	if _, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User"); err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	login := func() (*auth.Claims, *TokenPair) {
+		result, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+		if err != nil {
+			t.Fatalf("failed to login: %v", err)
+		}
+		tokens := result.Tokens
+		claims, err := authService.ValidateToken(ctx, tokens.AccessToken)
+		if err != nil {
+			t.Fatalf("failed to validate token: %v", err)
+		}
+		return claims, tokens
+	}
+
+	t.Run("revokes access token and session", func(t *testing.T) {
+		claims, tokens := login()
+		if err := authService.Logout(ctx, claims, "", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := authService.ValidateToken(ctx, tokens.AccessToken); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected revoked access token to be rejected, got %v", err)
+		}
+		if _, _, err := authService.RefreshToken(ctx, tokens.RefreshToken); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected refresh token of ended session to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("all devices", func(t *testing.T) {
+		claims, _ := login()
+		_, other := login()
+
+		if err := authService.Logout(ctx, claims, "", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := authService.ValidateToken(ctx, other.AccessToken); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected other device's access token to be rejected, got %v", err)
+		}
+		if _, _, err := authService.RefreshToken(ctx, other.RefreshToken); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected other device's refresh token to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("all devices rejects tokens issued in the same second", func(t *testing.T) {
+		claims, _ := login()
+		if err := authService.Logout(ctx, claims, "", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, sameSecond := login()
+		sameSecondClaims, err := authService.ValidateToken(ctx, sameSecond.AccessToken)
+		if err != nil {
+			t.Fatalf("expected token issued after logout to be valid, got %v", err)
+		}
+
+		other, _ := login()
+		if err := authService.Logout(ctx, other, "", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := authService.ValidateToken(ctx, sameSecond.AccessToken); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected access token of session %s to be rejected, got %v", sameSecondClaims.SessionID, err)
+		}
+	})
+}
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
+
+	if _, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User"); err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	currentResult, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	current := currentResult.Tokens
+	otherResult, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	other := otherResult.Tokens
+	claims, err := authService.jwtMgr.Validate(current.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to validate token: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		currentPassword string
+		newPassword     string
+		expectError     bool
+		errorCode       codes.Code
+	}{
+		{
+			name:            "wrong current password",
+			currentPassword: "wrongpassword",
+			newPassword:     "newpassword123",
+			expectError:     true,
+			errorCode:       codes.InvalidArgument,
+		},
+		{
+			name:            "new password violates policy",
+			currentPassword: "password123",
+			newPassword:     "short",
+			expectError:     true,
+			errorCode:       codes.InvalidArgument,
+		},
+		{
+			name:            "new password equals current",
+			currentPassword: "password123",
+			newPassword:     "password123",
+			expectError:     true,
+			errorCode:       codes.InvalidArgument,
+		},
+		{
+			name:            "successful change",
+			currentPassword: "password123",
+			newPassword:     "newpassword123",
+			expectError:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authService.ChangePassword(ctx, claims, tt.currentPassword, tt.newPassword)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if status, ok := grpcstatus.FromError(err); ok {
+					if status.Code() != tt.errorCode {
+						t.Errorf("expected error code %v, got %v", tt.errorCode, status.Code())
+					}
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	if _, err := authService.Login(ctx, "test@example.com", "newpassword123", ClientInfo{}); err != nil {
+		t.Errorf("expected login with new password to succeed, got %v", err)
+	}
+	if _, err := authService.ValidateToken(ctx, current.AccessToken); err != nil {
+		t.Errorf("expected current session to stay valid, got %v", err)
+	}
+	if _, err := authService.ValidateToken(ctx, other.AccessToken); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected other session's access token to be rejected, got %v", err)
+	}
+	if _, _, err := authService.RefreshToken(ctx, other.RefreshToken); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected other session's refresh token to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/orderkey"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// maxBulkUpdateSize is the maximum number of TODOs changed by one bulk update
+const maxBulkUpdateSize = 100
+
+// BulkUpdateResult is the outcome of a bulk update for one TODO
+type BulkUpdateResult struct {
+	ID   string
+	TODO *domain.TODO // The updated TODO, if Err is nil
+	Err  error        // A gRPC error saying why the TODO was not updated
+}
+
+// BulkService makes the same changes to many TODOs at once, checking each
+// TODO as if it were updated on its own
+type BulkService struct {
+	todos       *TODOService
+	permissions *PermissionService
+}
+
+// NewBulkService creates a new bulk update service
+func NewBulkService(todos *TODOService, permissions *PermissionService) *BulkService {
+	return &BulkService{
+		todos:       todos,
+		permissions: permissions,
+	}
+}
+
+// BulkUpdateTODOs makes changes to the TODOs with the given IDs on behalf of
+// userID, who must be allowed to edit each of them. In transactional mode
+// either every TODO is updated or none is, including the TODOs rebalanced to
+// make room for TODOs moved to a list; otherwise each TODO is updated on its
+// own. The results are in the order of ids, without duplicates. A
+// revision is recorded and an update broadcast for every TODO that changed.
+func (s *BulkService) BulkUpdateTODOs(ctx context.Context, userID string, ids []string, changes *domain.BulkChanges, transactional, force bool) ([]*BulkUpdateResult, error) {
+	ids = uniqueIDs(ids)
+	if len(ids) == 0 {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "ids are required")
+	}
+	if len(ids) > maxBulkUpdateSize {
+		return nil, grpcstatus.Error(codes.InvalidArgument, fmt.Sprintf("at most %d todos can be updated at once", maxBulkUpdateSize))
+	}
+	if changes.IsEmpty() {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "no changes given")
+	}
+	if changes.DueDate != nil && changes.ClearDueDate {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "due_date and clear_due_date cannot both be set")
+	}
+
+	results := make([]*BulkUpdateResult, len(ids))
+	todos := make([]*domain.TODO, len(ids))
+	failed := false
+	for i, id := range ids {
+		results[i] = &BulkUpdateResult{ID: id}
+		todos[i], results[i].Err = s.checkTODO(ctx, userID, id, changes, force)
+		failed = failed || results[i].Err != nil
+	}
+	if transactional && failed {
+		return abortRemaining(results), nil
+	}
+
+	// Change copies, so that TODOs that fail to save are left as they were
+	previous := make([]domain.TODOSnapshot, len(ids))
+	var changed, moved []int
+	order := newBulkOrder(s.todos)
+	for i, todo := range todos {
+		if results[i].Err != nil {
+			continue
+		}
+
+		updated := *todo
+		previous[i] = todo.Snapshot()
+		if changes.ParentID != nil && !sameParent(todo.ParentID, changes.ParentID) {
+			if results[i].Err = order.place(ctx, &updated, changes.ParentID); results[i].Err != nil {
+				if transactional {
+					return abortRemaining(results), nil
+				}
+				continue
+			}
+			moved = append(moved, i)
+		}
+		updated.ApplyBulkChanges(changes)
+
+		results[i].TODO = &updated
+		if len(domain.DiffSnapshots(previous[i], updated.Snapshot())) > 0 {
+			changed = append(changed, i)
+		}
+	}
+	changed = order.applyTo(results, changed)
+
+	if transactional {
+		if err := s.saveAll(ctx, results, changed, order.keys); err != nil {
+			return abortRemaining(results), nil
+		}
+	} else {
+		var err error
+		if len(order.keys) > 0 {
+			err = s.todos.repo.SetOrderKeys(ctx, order.keys)
+		}
+		if err != nil {
+			// Without room at the end of their list the moved TODOs would
+			// collide with the TODOs already there
+			for _, i := range moved {
+				results[i].TODO = nil
+				results[i].Err = grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to rebalance order keys: %v", err))
+			}
+			changed = slices.DeleteFunc(changed, func(i int) bool { return results[i].Err != nil })
+		}
+
+		saved := changed[:0]
+		for _, i := range changed {
+			if err := s.todos.repo.Update(ctx, results[i].TODO); err != nil {
+				results[i].TODO = nil
+				results[i].Err = saveError(err, "failed to update todo")
+				continue
+			}
+			saved = append(saved, i)
+		}
+		changed = saved
+	}
+
+	for _, i := range changed {
+		todo := results[i].TODO
+		s.todos.recordRevision(ctx, userID, domain.RevisionActionUpdated, &previous[i], todo)
+		if s.todos.websocketService != nil {
+			s.todos.websocketService.BroadcastTODOUpdate(ctx, todo, "updated")
+		}
+	}
+
+	return results, nil
+}
+
+// BulkUpdateStatus changes the status of the TODOs with the given IDs on
+// behalf of userID, updating each TODO on its own; see BulkUpdateTODOs
+func (s *BulkService) BulkUpdateStatus(ctx context.Context, userID string, ids []string, status commonv1.Status, force bool) ([]*BulkUpdateResult, error) {
+	if status == commonv1.Status_STATUS_UNSPECIFIED {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "status is required")
+	}
+
+	return s.BulkUpdateTODOs(ctx, userID, ids, &domain.BulkChanges{Status: &status}, false, force)
+}
+
+// BulkDelete moves the TODOs with the given IDs and their subtasks to the
+// trash on behalf of userID, who must be allowed to delete each of them.
+// TODOs that cannot be deleted do not stop the others from being deleted.
+// The results are in the order of ids, without duplicates. A deletion is
+// broadcast for every TODO moved to the trash.
+func (s *BulkService) BulkDelete(ctx context.Context, userID string, ids []string) ([]*BulkUpdateResult, error) {
+	ids = uniqueIDs(ids)
+	if len(ids) == 0 {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "ids are required")
+	}
+	if len(ids) > maxBulkUpdateSize {
+		return nil, grpcstatus.Error(codes.InvalidArgument, fmt.Sprintf("at most %d todos can be deleted at once", maxBulkUpdateSize))
+	}
+
+	results := make([]*BulkUpdateResult, len(ids))
+	todos := make([]*domain.TODO, len(ids))
+	var deletable []string
+	for i, id := range ids {
+		results[i] = &BulkUpdateResult{ID: id}
+		if err := s.permissions.CanDeleteTODO(ctx, userID, id); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		todo, err := s.todos.repo.GetByID(ctx, id)
+		if err != nil {
+			results[i].Err = grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
+			continue
+		}
+		todos[i] = todo
+		deletable = append(deletable, id)
+	}
+	if len(deletable) == 0 {
+		return results, nil
+	}
+
+	if err := s.todos.repo.BulkDelete(ctx, deletable); err != nil {
+		for i, todo := range todos {
+			if todo != nil {
+				results[i].Err = grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to delete todo: %v", err))
+			}
+		}
+		return results, nil
+	}
+
+	if s.todos.websocketService != nil {
+		for _, todo := range todos {
+			if todo != nil {
+				s.todos.websocketService.BroadcastTODOUpdate(ctx, todo, "deleted")
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// checkTODO loads a TODO of a bulk update and checks that userID may make
+// the changes to it
+func (s *BulkService) checkTODO(ctx context.Context, userID, id string, changes *domain.BulkChanges, force bool) (*domain.TODO, error) {
+	if err := s.permissions.CanEditTODO(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	todo, err := s.todos.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
+	}
+
+	if changes.ParentID != nil && *changes.ParentID != "" {
+		if err := s.todos.validateParent(ctx, id, *changes.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	if changes.ClearDueDate && todo.IsRecurring() {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "recurring todos require a due date")
+	}
+	if changes.Status != nil {
+		if err := checkCompletion(todo, *changes.Status, todo.IsRecurring()); err != nil {
+			return nil, err
+		}
+		if !force {
+			if err := s.todos.checkBlockers(ctx, todo, *changes.Status); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return todo, nil
+}
+
+// bulkOrder places the TODOs moved by a bulk update at the end of their new
+// lists, one after another in the order they are given. When a list has run
+// out of room it is rebalanced in memory, so that the new order keys can be
+// saved together with the update.
+type bulkOrder struct {
+	todos    *TODOService
+	appended map[string][]*domain.TODO // List -> TODOs moved to its end
+	keys     map[string]string         // TODO ID -> order key given by a rebalance
+}
+
+func newBulkOrder(todos *TODOService) *bulkOrder {
+	return &bulkOrder{
+		todos:    todos,
+		appended: make(map[string][]*domain.TODO),
+		keys:     make(map[string]string),
+	}
+}
+
+// place gives a TODO moved to the list of parentID an order key after every
+// TODO in it
+func (o *bulkOrder) place(ctx context.Context, todo *domain.TODO, parentID *string) error {
+	list := optionalID(parentID)
+	if list == "" {
+		list = "user:" + todo.UserID
+	}
+	appended := o.appended[list]
+
+	var last string
+	if len(appended) > 0 {
+		last = appended[len(appended)-1].OrderKey
+	} else {
+		var err error
+		if last, err = o.todos.repo.LastOrderKey(ctx, todo.UserID, parentID); err != nil {
+			return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to get order key: %v", err))
+		}
+	}
+
+	key, err := orderkey.Between(last, "")
+	if err != nil || len(key) > orderkey.MaxLength {
+		siblings, err := o.todos.repo.ListSiblings(ctx, todo.UserID, parentID)
+		if err != nil {
+			return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list siblings: %v", err))
+		}
+		// The TODOs moved to the list so far are not saved yet, so they are
+		// rebalanced after the TODOs already in it
+		rebalanced := append(siblings, appended...)
+		for i, key := range orderkey.Spread(len(rebalanced)) {
+			rebalanced[i].OrderKey = key
+		}
+		for _, sibling := range siblings {
+			o.keys[sibling.ID] = sibling.OrderKey
+		}
+		key = orderKeyAfter(rebalanced)
+	}
+
+	todo.OrderKey = key
+	o.appended[list] = append(appended, todo)
+	return nil
+}
+
+// applyTo gives the TODOs of a bulk update that were rebalanced their new
+// order keys, so that they are saved with the rest of their changes rather
+// than separately, and returns changed with them added. Only the keys of
+// TODOs outside the bulk update are left to be set.
+func (o *bulkOrder) applyTo(results []*BulkUpdateResult, changed []int) []int {
+	for i, result := range results {
+		if result.TODO == nil {
+			continue
+		}
+		key, ok := o.keys[result.ID]
+		if !ok {
+			continue
+		}
+		delete(o.keys, result.ID)
+		if result.TODO.OrderKey != key {
+			result.TODO.OrderKey = key
+			if !slices.Contains(changed, i) {
+				changed = append(changed, i)
+			}
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// saveAll saves the changed TODOs of a transactional bulk update together,
+// along with the order keys of TODOs rebalanced to make room for them. If
+// they cannot be saved, the TODO that failed is given the error.
+func (s *BulkService) saveAll(ctx context.Context, results []*BulkUpdateResult, changed []int, orderKeys map[string]string) error {
+	if len(changed) == 0 && len(orderKeys) == 0 {
+		return nil
+	}
+
+	todos := make([]*domain.TODO, len(changed))
+	for j, i := range changed {
+		todos[j] = results[i].TODO
+	}
+
+	err := s.todos.repo.UpdateMany(ctx, todos, orderKeys)
+	if err == nil {
+		return nil
+	}
+
+	var batchErr *domain.BatchSaveError
+	if errors.As(err, &batchErr) {
+		for _, result := range results {
+			if result.ID == batchErr.ID {
+				result.Err = saveError(batchErr.Err, "failed to update todo")
+			}
+		}
+		return err
+	}
+	for _, i := range changed {
+		results[i].Err = saveError(err, "failed to update todos")
+	}
+	return err
+}
+
+// abortRemaining marks the results of a transactional bulk update that did
+// not fail themselves as aborted, since no TODO was updated
+func abortRemaining(results []*BulkUpdateResult) []*BulkUpdateResult {
+	for _, result := range results {
+		result.TODO = nil
+		if result.Err == nil {
+			result.Err = grpcstatus.Error(codes.Aborted, "not updated because another todo in the transaction failed")
+		}
+	}
+	return results
+}
+
+// uniqueIDs returns ids without empty and repeated IDs, keeping their order
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
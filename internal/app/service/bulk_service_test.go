@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/orderkey"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func TestBulkService_BulkUpdateTODOs(t *testing.T) {
+	repo := NewMockRepository()
+	revisions := NewMockTODORevisionRepository()
+	todoService := NewTODOService(repo, revisions, nil, nil, nil)
+	bulkService := NewBulkService(todoService, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	first, _ := todoService.CreateTODO(ctx, "user-123", "First", nil, nil, nil, nil, []string{"home", "urgent"}, nil, nil, nil)
+	second, _ := todoService.CreateTODO(ctx, "user-123", "Second", nil, nil, nil, nil, []string{"home"}, nil, nil, nil)
+	other, _ := todoService.CreateTODO(ctx, "user-456", "Other", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	status := commonv1.Status_STATUS_COMPLETED
+	changes := &domain.BulkChanges{
+		Status:     &status,
+		AddTags:    []string{"work"},
+		RemoveTags: []string{"home"},
+		AssignedTo: stringPtr("user-789"),
+	}
+	results, err := bulkService.BulkUpdateTODOs(ctx, "user-123", []string{first.ID, other.ID, "missing", second.ID, first.ID}, changes, false, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []struct {
+		id   string
+		code codes.Code
+	}{
+		{first.ID, codes.OK},
+		{other.ID, codes.PermissionDenied},
+		{"missing", codes.NotFound},
+		{second.ID, codes.OK},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("Expected %d results, got %d", len(want), len(results))
+	}
+	for i, w := range want {
+		if results[i].ID != w.id || grpcstatus.Code(results[i].Err) != w.code {
+			t.Errorf("Expected result %d to be %s with %v, got %s with %v", i, w.id, w.code, results[i].ID, results[i].Err)
+		}
+	}
+
+	updated, _ := todoService.GetTODO(ctx, first.ID)
+	if !updated.IsCompleted() || updated.CompletedAt == nil {
+		t.Error("Expected the TODO to be completed")
+	}
+	if len(updated.Tags) != 2 || updated.Tags[0] != "urgent" || updated.Tags[1] != "work" {
+		t.Errorf("Expected tags [urgent work], got %v", updated.Tags)
+	}
+	if updated.AssignedTo == nil || *updated.AssignedTo != "user-789" {
+		t.Error("Expected the TODO to be assigned")
+	}
+	if got, _ := todoService.GetTODO(ctx, other.ID); got.IsCompleted() {
+		t.Error("Expected the TODO of another user to be unchanged")
+	}
+	if len(revisions.revisions[first.ID]) != 2 || len(revisions.revisions[other.ID]) != 1 {
+		t.Error("Expected a revision for each updated TODO only")
+	}
+
+	// TODOs the changes leave as they are are not saved again
+	version := updated.Version
+	if _, err := bulkService.BulkUpdateTODOs(ctx, "user-123", []string{first.ID}, changes, false, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, _ := todoService.GetTODO(ctx, first.ID); got.Version != version {
+		t.Error("Expected an unchanged TODO not to be saved")
+	}
+}
+
+func TestBulkService_BulkUpdateTODOs_Transactional(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	bulkService := NewBulkService(todoService, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	parent, _ := todoService.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	first, _ := todoService.CreateTODO(ctx, "user-123", "First", nil, nil, nil, nil, nil, nil, nil, nil)
+	second, _ := todoService.CreateTODO(ctx, "user-123", "Second", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Moving the parent under itself fails, so nothing is moved
+	changes := &domain.BulkChanges{ParentID: &parent.ID}
+	results, err := bulkService.BulkUpdateTODOs(ctx, "user-123", []string{first.ID, parent.ID, second.ID}, changes, true, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantCodes := []codes.Code{codes.Aborted, codes.InvalidArgument, codes.Aborted}
+	for i, want := range wantCodes {
+		if grpcstatus.Code(results[i].Err) != want {
+			t.Errorf("Expected result %d to fail with %v, got %v", i, want, results[i].Err)
+		}
+	}
+	if got, _ := todoService.GetTODO(ctx, first.ID); got.ParentID != nil {
+		t.Error("Expected no TODO to be moved")
+	}
+
+	results, err = bulkService.BulkUpdateTODOs(ctx, "user-123", []string{first.ID, second.ID}, changes, true, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("Unexpected error for %s: %v", result.ID, result.Err)
+		}
+		if result.TODO.ParentID == nil || *result.TODO.ParentID != parent.ID {
+			t.Errorf("Expected %s to be moved under the parent", result.ID)
+		}
+	}
+	if results[0].TODO.OrderKey >= results[1].TODO.OrderKey {
+		t.Error("Expected the moved TODOs to keep the order they were given in")
+	}
+}
+
+func TestBulkService_BulkUpdateTODOs_Rebalance(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	bulkService := NewBulkService(todoService, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	parent, _ := todoService.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	child, _ := todoService.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &parent.ID, nil)
+	first, _ := todoService.CreateTODO(ctx, "user-123", "First", nil, nil, nil, nil, nil, nil, nil, nil)
+	second, _ := todoService.CreateTODO(ctx, "user-123", "Second", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// There is no room after the child, so the parent's subtasks are rebalanced
+	longKey := strings.Repeat("z", orderkey.MaxLength)
+	repo.todos[child.ID].OrderKey = longKey
+	changes := &domain.BulkChanges{ParentID: &parent.ID}
+
+	// The rebalanced keys are not saved when the transaction fails
+	repo.versions[second.ID] = second.Version + 1
+	results, err := bulkService.BulkUpdateTODOs(ctx, "user-123", []string{first.ID, second.ID}, changes, true, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if grpcstatus.Code(results[0].Err) != codes.Aborted || grpcstatus.Code(results[1].Err) != codes.Aborted {
+		t.Fatalf("Expected the transaction to be aborted, got %v and %v", results[0].Err, results[1].Err)
+	}
+	if got, _ := todoService.GetTODO(ctx, child.ID); got.OrderKey != longKey {
+		t.Errorf("Expected the child to keep its order key, got %q", got.OrderKey)
+	}
+
+	// The child is part of the update, so its new key is saved with it
+	repo.versions[second.ID] = second.Version
+	results, err = bulkService.BulkUpdateTODOs(ctx, "user-123", []string{child.ID, first.ID, second.ID}, changes, true, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var keys []string
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("Unexpected error for %s: %v", result.ID, result.Err)
+		}
+		got, _ := todoService.GetTODO(ctx, result.ID)
+		if got.OrderKey != result.TODO.OrderKey || len(got.OrderKey) > orderkey.MaxLength {
+			t.Errorf("Expected %s to be saved with a short order key, got %q", result.ID, got.OrderKey)
+		}
+		keys = append(keys, got.OrderKey)
+	}
+	if !sort.StringsAreSorted(keys) {
+		t.Errorf("Expected the moved TODOs to follow the child, got keys %v", keys)
+	}
+}
+
+func TestBulkService_BulkUpdateTODOs_InvalidRequests(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	bulkService := NewBulkService(todoService, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	todo, _ := todoService.CreateTODO(ctx, "user-123", "Todo", nil, nil, nil, nil, nil, nil, nil, nil)
+	priority := commonv1.Priority_PRIORITY_HIGH
+	tooMany := make([]string, maxBulkUpdateSize+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+
+	tests := []struct {
+		name    string
+		ids     []string
+		changes *domain.BulkChanges
+	}{
+		{"no ids", nil, &domain.BulkChanges{Priority: &priority}},
+		{"too many ids", tooMany, &domain.BulkChanges{Priority: &priority}},
+		{"no changes", []string{todo.ID}, &domain.BulkChanges{}},
+		{"conflicting due date", []string{todo.ID}, &domain.BulkChanges{DueDate: timePtr(todo.CreatedAt), ClearDueDate: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := bulkService.BulkUpdateTODOs(ctx, "user-123", tt.ids, tt.changes, false, false); grpcstatus.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBulkService_BulkUpdateStatus(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	bulkService := NewBulkService(todoService, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	first, _ := todoService.CreateTODO(ctx, "user-123", "First", nil, nil, nil, nil, nil, nil, nil, nil)
+	second, _ := todoService.CreateTODO(ctx, "user-123", "Second", nil, nil, nil, nil, nil, nil, nil, nil)
+	other, _ := todoService.CreateTODO(ctx, "user-456", "Other", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	results, err := bulkService.BulkUpdateStatus(ctx, "user-123", []string{first.ID, other.ID, second.ID}, commonv1.Status_STATUS_COMPLETED, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantCodes := []codes.Code{codes.OK, codes.PermissionDenied, codes.OK}
+	for i, want := range wantCodes {
+		if grpcstatus.Code(results[i].Err) != want {
+			t.Errorf("Expected result %d to be %v, got %v", i, want, results[i].Err)
+		}
+	}
+
+	for _, id := range []string{first.ID, second.ID} {
+		if got, _ := todoService.GetTODO(ctx, id); !got.IsCompleted() || got.CompletedAt == nil {
+			t.Errorf("Expected %s to be completed", id)
+		}
+	}
+	if got, _ := todoService.GetTODO(ctx, other.ID); got.IsCompleted() {
+		t.Error("Expected the TODO of another user to be unchanged")
+	}
+
+	if _, err := bulkService.BulkUpdateStatus(ctx, "user-123", []string{first.ID}, commonv1.Status_STATUS_UNSPECIFIED, false); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument without a status, got %v", err)
+	}
+}
+
+func TestBulkService_BulkDelete(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	bulkService := NewBulkService(todoService, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	first, _ := todoService.CreateTODO(ctx, "user-123", "First", nil, nil, nil, nil, nil, nil, nil, nil)
+	second, _ := todoService.CreateTODO(ctx, "user-123", "Second", nil, nil, nil, nil, nil, nil, nil, nil)
+	other, _ := todoService.CreateTODO(ctx, "user-456", "Other", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	results, err := bulkService.BulkDelete(ctx, "user-123", []string{first.ID, other.ID, "missing", second.ID, first.ID})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []struct {
+		id   string
+		code codes.Code
+	}{
+		{first.ID, codes.OK},
+		{other.ID, codes.PermissionDenied},
+		{"missing", codes.NotFound},
+		{second.ID, codes.OK},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("Expected %d results, got %d", len(want), len(results))
+	}
+	for i, w := range want {
+		if results[i].ID != w.id || grpcstatus.Code(results[i].Err) != w.code {
+			t.Errorf("Expected result %d to be %s with %v, got %s with %v", i, w.id, w.code, results[i].ID, results[i].Err)
+		}
+	}
+
+	for _, id := range []string{first.ID, second.ID} {
+		if _, err := todoService.GetTODO(ctx, id); err == nil {
+			t.Errorf("Expected %s to be deleted", id)
+		}
+	}
+	if _, err := todoService.GetTODO(ctx, other.ID); err != nil {
+		t.Error("Expected the TODO of another user not to be deleted")
+	}
+
+	if _, err := bulkService.BulkDelete(ctx, "user-123", nil); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument without ids, got %v", err)
+	}
+}
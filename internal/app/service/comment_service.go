@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxCommentLength is the maximum length of a comment in characters
+const maxCommentLength = 10000
+
+// EventBroadcaster delivers real-time events to connected clients.
+// WebSocketService implements it.
+type EventBroadcaster interface {
+	BroadcastEvent(ctx context.Context, eventType string, payload map[string]interface{}, userID string)
+}
+
+// CommentService handles threaded comments on TODOs. Users who can view a
+// TODO can read its comments; users who can edit it can comment.
+type CommentService struct {
+	repo        domain.CommentRepository
+	todoRepo    domain.TODORepository
+	permissions *PermissionService
+	events      EventBroadcaster
+}
+
+// NewCommentService creates a new comment service
+func NewCommentService(repo domain.CommentRepository, todoRepo domain.TODORepository, permissions *PermissionService, events EventBroadcaster) *CommentService {
+	return &CommentService{
+		repo:        repo,
+		todoRepo:    todoRepo,
+		permissions: permissions,
+		events:      events,
+	}
+}
+
+// CreateComment adds a comment to a TODO, or a reply to one of its comments
+// if parentID is set, and broadcasts it to the users following the TODO
+func (s *CommentService) CreateComment(ctx context.Context, userID, todoID, content string, parentID *string) (*domain.Comment, error) {
+	content, err := normalizeCommentContent(content)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.permissions.CanEditTODO(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	if parentID != nil && *parentID != "" {
+		parent, err := s.repo.GetByID(ctx, *parentID)
+		if err != nil || parent.TODOID != todoID {
+			return nil, grpcstatus.Error(codes.NotFound, "parent comment not found")
+		}
+		if parent.IsDeleted() {
+			return nil, grpcstatus.Error(codes.FailedPrecondition, "cannot reply to a deleted comment")
+		}
+	} else {
+		parentID = nil
+	}
+
+	comment := domain.NewComment(todoID, userID, content, parentID)
+	if err := s.repo.Create(ctx, comment); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create comment: %v", err))
+	}
+
+	s.broadcastCommentAdded(ctx, comment)
+
+	return comment, nil
+}
+
+// ListComments retrieves the top-level comments of a TODO, or the replies to
+// one of its comments if parentID is set
+func (s *CommentService) ListComments(ctx context.Context, userID, todoID string, parentID *string, page, pageSize int32) ([]*domain.Comment, *domain.PaginationResult, error) {
+	if err := s.permissions.CanViewTODO(ctx, userID, todoID); err != nil {
+		return nil, nil, err
+	}
+
+	options := domain.CommentListOptions{
+		TODOID:   todoID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if parentID != nil && *parentID != "" {
+		options.ParentID = parentID
+	}
+
+	comments, pagination, err := s.repo.List(ctx, options)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list comments: %v", err))
+	}
+
+	return comments, pagination, nil
+}
+
+// UpdateComment edits a comment. Only its author can edit it.
+func (s *CommentService) UpdateComment(ctx context.Context, userID, id, content string) (*domain.Comment, error) {
+	content, err := normalizeCommentContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.getComment(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted() {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "comment has been deleted")
+	}
+	if comment.UserID != userID {
+		return nil, grpcstatus.Error(codes.PermissionDenied, "only the author can edit a comment")
+	}
+	if err := s.permissions.CanEditTODO(ctx, userID, comment.TODOID); err != nil {
+		return nil, err
+	}
+
+	comment.Edit(content)
+	if err := s.repo.Update(ctx, comment); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to update comment: %v", err))
+	}
+
+	return comment, nil
+}
+
+// DeleteComment deletes a comment. Its author and the owner of the TODO can
+// delete it. A comment with replies is kept without its content so that the
+// replies stay in their thread.
+func (s *CommentService) DeleteComment(ctx context.Context, userID, id string) error {
+	comment, err := s.getComment(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if comment.IsDeleted() {
+		return nil
+	}
+	if err := s.permissions.CanEditTODO(ctx, userID, comment.TODOID); err != nil {
+		return err
+	}
+	if comment.UserID != userID {
+		todo, err := s.todoRepo.GetByID(ctx, comment.TODOID)
+		if err != nil {
+			return grpcstatus.Error(codes.NotFound, "todo not found")
+		}
+		if todo.UserID != userID {
+			return grpcstatus.Error(codes.PermissionDenied, "only the author or the owner of the todo can delete a comment")
+		}
+	}
+
+	if comment.ReplyCount > 0 {
+		comment.MarkDeleted()
+		err = s.repo.Update(ctx, comment)
+	} else {
+		err = s.repo.Delete(ctx, comment.ID)
+	}
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to delete comment: %v", err))
+	}
+
+	return nil
+}
+
+// getComment retrieves a comment on a TODO the user can view. Comments on
+// other TODOs are reported as not found.
+func (s *CommentService) getComment(ctx context.Context, userID, id string) (*domain.Comment, error) {
+	if id == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
+	}
+
+	comment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "comment not found")
+	}
+	if err := s.permissions.CanViewTODO(ctx, userID, comment.TODOID); err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "comment not found")
+	}
+
+	return comment, nil
+}
+
+// broadcastCommentAdded sends a comment added event to the owner of the TODO
+// and the members of the teams it belongs to or is shared with
+func (s *CommentService) broadcastCommentAdded(ctx context.Context, comment *domain.Comment) {
+	if s.events == nil {
+		return
+	}
+
+	todo, err := s.todoRepo.GetByID(ctx, comment.TODOID)
+	if err != nil {
+		return
+	}
+
+	event := &todov1.RealtimeEvent{
+		Type:      commonv1.EventType_EVENT_TYPE_COMMENT_ADDED,
+		Id:        uuid.New().String(),
+		Timestamp: timestamppb.New(comment.CreatedAt),
+		UserId:    comment.UserID,
+		TodoId:    comment.TODOID,
+		Payload:   &todov1.RealtimeEvent_Message{Message: comment.Content},
+	}
+	payload := map[string]interface{}{
+		"event":      event,
+		"todo_id":    comment.TODOID,
+		"comment_id": comment.ID,
+	}
+	if comment.ParentID != nil {
+		payload["parent_id"] = *comment.ParentID
+	}
+
+	var teamIDs []string
+	if todo.TeamID != nil && *todo.TeamID != "" {
+		event.TeamId = *todo.TeamID
+		teamIDs = append(teamIDs, *todo.TeamID)
+	}
+	if shared, err := s.todoRepo.GetSharedTeams(ctx, todo.ID); err == nil {
+		for _, teamID := range shared {
+			if !containsString(teamIDs, teamID) {
+				teamIDs = append(teamIDs, teamID)
+			}
+		}
+	}
+	if len(teamIDs) > 0 {
+		payload["team_ids"] = teamIDs
+	}
+
+	s.events.BroadcastEvent(ctx, "realtime_event", payload, todo.UserID)
+}
+
+// normalizeCommentContent trims a comment's content and checks its length
+func normalizeCommentContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", grpcstatus.Error(codes.InvalidArgument, "content is required")
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return "", grpcstatus.Error(codes.InvalidArgument, fmt.Sprintf("content cannot exceed %d characters", maxCommentLength))
+	}
+	return content, nil
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockCommentRepository is a mock implementation of CommentRepository for
+// testing
+type MockCommentRepository struct {
+	comments map[string]*domain.Comment
+}
+
+func NewMockCommentRepository() *MockCommentRepository {
+	return &MockCommentRepository{
+		comments: make(map[string]*domain.Comment),
+	}
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	stored := *comment
+	m.comments[comment.ID] = &stored
+	return nil
+}
+
+func (m *MockCommentRepository) GetByID(ctx context.Context, id string) (*domain.Comment, error) {
+	comment, ok := m.comments[id]
+	if !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	return m.read(comment), nil
+}
+
+func (m *MockCommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	if _, ok := m.comments[comment.ID]; !ok {
+		return &NotFoundError{ID: comment.ID}
+	}
+	stored := *comment
+	m.comments[comment.ID] = &stored
+	return nil
+}
+
+func (m *MockCommentRepository) Delete(ctx context.Context, id string) error {
+	if _, ok := m.comments[id]; !ok {
+		return &NotFoundError{ID: id}
+	}
+	delete(m.comments, id)
+	return nil
+}
+
+func (m *MockCommentRepository) List(ctx context.Context, options domain.CommentListOptions) ([]*domain.Comment, *domain.PaginationResult, error) {
+	var comments []*domain.Comment
+	for _, comment := range m.comments {
+		if comment.TODOID != options.TODOID {
+			continue
+		}
+		if (options.ParentID == nil) != (comment.ParentID == nil) ||
+			(options.ParentID != nil && *options.ParentID != *comment.ParentID) {
+			continue
+		}
+		comments = append(comments, m.read(comment))
+	}
+	sort.Slice(comments, func(i, j int) bool { return comments[i].CreatedAt.Before(comments[j].CreatedAt) })
+
+	total := int32(len(comments))
+	return comments, &domain.PaginationResult{
+		TotalItems:  total,
+		TotalPages:  1,
+		CurrentPage: 1,
+		PageSize:    total,
+	}, nil
+}
+
+// read returns a copy of a stored comment with its replies counted
+func (m *MockCommentRepository) read(stored *domain.Comment) *domain.Comment {
+	comment := *stored
+	comment.ReplyCount = 0
+	for _, reply := range m.comments {
+		if reply.ParentID != nil && *reply.ParentID == comment.ID {
+			comment.ReplyCount++
+		}
+	}
+	return &comment
+}
+
+// broadcastEvent is an event recorded by MockEventBroadcaster
+type broadcastEvent struct {
+	EventType string
+	Payload   map[string]interface{}
+	UserID    string
+}
+
+// MockEventBroadcaster is a mock implementation of EventBroadcaster for
+// testing
+type MockEventBroadcaster struct {
+	events []broadcastEvent
+}
+
+func (m *MockEventBroadcaster) BroadcastEvent(ctx context.Context, eventType string, payload map[string]interface{}, userID string) {
+	m.events = append(m.events, broadcastEvent{EventType: eventType, Payload: payload, UserID: userID})
+}
+
+// commentTestFixture is a TODO owned by "owner" and shared with a team in
+// which "member" is a member; "outsider" has no access to it
+type commentTestFixture struct {
+	service *CommentService
+	repo    *MockCommentRepository
+	events  *MockEventBroadcaster
+	todoID  string
+	otherID string
+	teamID  string
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func newCommentTestFixture(t *testing.T) *commentTestFixture {
+	t.Helper()
+	todoRepo := NewMockTODORepository()
+	teamRepo := NewMockTeamRepository()
+	repo := NewMockCommentRepository()
+	events := &MockEventBroadcaster{}
+
+	todoRepo.todos["todo-1"] = &domain.TODO{ID: "todo-1", UserID: "owner", Title: "Plan release"}
+	todoRepo.todos["todo-2"] = &domain.TODO{ID: "todo-2", UserID: "outsider", Title: "Private"}
+	todoRepo.sharedTODOs["todo-1"] = []string{"team-1"}
+	teamRepo.members["team-1"] = map[string]*domain.TeamMember{
+		"member": {TeamID: "team-1", UserID: "member", Role: commonv1.Role_ROLE_MEMBER},
+	}
+
+	return &commentTestFixture{
+		service: NewCommentService(repo, todoRepo, NewPermissionService(todoRepo, teamRepo), events),
+		repo:    repo,
+		events:  events,
+		todoID:  "todo-1",
+		otherID: "todo-2",
+		teamID:  "team-1",
+	}
+}
+
+func TestCommentService_CreateComment(t *testing.T) {
+	ctx := context.Background()
+	f := newCommentTestFixture(t)
+
+	parent, err := f.service.CreateComment(ctx, "owner", f.todoID, "  Who takes this?  ", nil)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	if parent.Content != "Who takes this?" || parent.ParentID != nil {
+		t.Errorf("CreateComment() = %+v, want trimmed top-level comment", parent)
+	}
+
+	otherComment, err := f.service.CreateComment(ctx, "outsider", f.otherID, "Note to self", nil)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userID   string
+		todoID   string
+		content  string
+		parentID *string
+		wantCode codes.Code
+	}{
+		{name: "team member", userID: "member", todoID: f.todoID, content: "I can", wantCode: codes.OK},
+		{name: "reply", userID: "member", todoID: f.todoID, content: "Me", parentID: &parent.ID, wantCode: codes.OK},
+		{name: "empty content", userID: "owner", todoID: f.todoID, content: "   ", wantCode: codes.InvalidArgument},
+		{name: "content too long", userID: "owner", todoID: f.todoID, content: strings.Repeat("a", maxCommentLength+1), wantCode: codes.InvalidArgument},
+		{name: "no access", userID: "outsider", todoID: f.todoID, content: "Hi", wantCode: codes.PermissionDenied},
+		{name: "unknown todo", userID: "owner", todoID: "missing", content: "Hi", wantCode: codes.NotFound},
+		{name: "unknown parent", userID: "owner", todoID: f.todoID, content: "Hi", parentID: stringPtr("missing"), wantCode: codes.NotFound},
+		{name: "parent on another todo", userID: "outsider", todoID: f.otherID, content: "Hi", parentID: &parent.ID, wantCode: codes.NotFound},
+		{name: "reply to another todo", userID: "owner", todoID: f.todoID, content: "Hi", parentID: &otherComment.ID, wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, err := f.service.CreateComment(ctx, tt.userID, tt.todoID, tt.content, tt.parentID)
+			if code := grpcstatus.Code(err); code != tt.wantCode {
+				t.Fatalf("CreateComment() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if comment.UserID != tt.userID || comment.TODOID != tt.todoID {
+				t.Errorf("CreateComment() = %+v, want comment of %s on %s", comment, tt.userID, tt.todoID)
+			}
+			if (tt.parentID == nil) != (comment.ParentID == nil) {
+				t.Errorf("CreateComment() ParentID = %v, want %v", comment.ParentID, tt.parentID)
+			}
+		})
+	}
+}
+
+func TestCommentService_CreateComment_Broadcast(t *testing.T) {
+	ctx := context.Background()
+	f := newCommentTestFixture(t)
+
+	comment, err := f.service.CreateComment(ctx, "member", f.todoID, "On it", nil)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+
+	if len(f.events.events) != 1 {
+		t.Fatalf("broadcast %d events, want 1", len(f.events.events))
+	}
+	sent := f.events.events[0]
+	if sent.EventType != "realtime_event" || sent.UserID != "owner" {
+		t.Errorf("broadcast %s to %s, want realtime_event to the owner", sent.EventType, sent.UserID)
+	}
+	if teamIDs, _ := sent.Payload["team_ids"].([]string); len(teamIDs) != 1 || teamIDs[0] != f.teamID {
+		t.Errorf("broadcast to teams %v, want [%s]", sent.Payload["team_ids"], f.teamID)
+	}
+
+	event, ok := sent.Payload["event"].(*todov1.RealtimeEvent)
+	if !ok {
+		t.Fatalf("payload event = %T, want *todov1.RealtimeEvent", sent.Payload["event"])
+	}
+	if event.Type != commonv1.EventType_EVENT_TYPE_COMMENT_ADDED || event.GetMessage() != comment.Content ||
+		event.TodoId != f.todoID || event.UserId != "member" {
+		t.Errorf("event = %+v, want comment added event for %s", event, comment.ID)
+	}
+}
+
+func TestCommentService_ListComments(t *testing.T) {
+	ctx := context.Background()
+	f := newCommentTestFixture(t)
+
+	first, err := f.service.CreateComment(ctx, "owner", f.todoID, "First", nil)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	if _, err := f.service.CreateComment(ctx, "member", f.todoID, "Reply", &first.ID); err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	if _, err := f.service.CreateComment(ctx, "member", f.todoID, "Second", nil); err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+
+	comments, _, err := f.service.ListComments(ctx, "member", f.todoID, nil, 1, 20)
+	if err != nil {
+		t.Fatalf("ListComments() error = %v", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("ListComments() returned %d comments, want 2 top-level comments", len(comments))
+	}
+	for _, comment := range comments {
+		if comment.ID == first.ID && comment.ReplyCount != 1 {
+			t.Errorf("first comment has %d replies, want 1", comment.ReplyCount)
+		}
+	}
+
+	replies, _, err := f.service.ListComments(ctx, "owner", f.todoID, &first.ID, 1, 20)
+	if err != nil {
+		t.Fatalf("ListComments() error = %v", err)
+	}
+	if len(replies) != 1 || replies[0].Content != "Reply" {
+		t.Errorf("ListComments() replies = %v, want the reply", replies)
+	}
+
+	if _, _, err := f.service.ListComments(ctx, "outsider", f.todoID, nil, 1, 20); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListComments() without access code = %v, want PermissionDenied", grpcstatus.Code(err))
+	}
+}
+
+func TestCommentService_UpdateComment(t *testing.T) {
+	ctx := context.Background()
+	f := newCommentTestFixture(t)
+
+	comment, err := f.service.CreateComment(ctx, "member", f.todoID, "Draft", nil)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userID   string
+		content  string
+		wantCode codes.Code
+	}{
+		{name: "author", userID: "member", content: "Final", wantCode: codes.OK},
+		{name: "owner of the todo", userID: "owner", content: "Mine now", wantCode: codes.PermissionDenied},
+		{name: "no access", userID: "outsider", content: "Hi", wantCode: codes.NotFound},
+		{name: "empty content", userID: "member", content: "", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, err := f.service.UpdateComment(ctx, tt.userID, comment.ID, tt.content)
+			if code := grpcstatus.Code(err); code != tt.wantCode {
+				t.Fatalf("UpdateComment() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+			if err == nil && (updated.Content != tt.content || updated.EditedAt == nil) {
+				t.Errorf("UpdateComment() = %+v, want edited content %q", updated, tt.content)
+			}
+		})
+	}
+}
+
+func TestCommentService_DeleteComment(t *testing.T) {
+	ctx := context.Background()
+	f := newCommentTestFixture(t)
+
+	parent, err := f.service.CreateComment(ctx, "member", f.todoID, "Question", nil)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	reply, err := f.service.CreateComment(ctx, "owner", f.todoID, "Answer", &parent.ID)
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+
+	if err := f.service.DeleteComment(ctx, "outsider", parent.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("DeleteComment() without access code = %v, want NotFound", grpcstatus.Code(err))
+	}
+	if err := f.service.DeleteComment(ctx, "member", reply.ID); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteComment() of another member's comment code = %v, want PermissionDenied", grpcstatus.Code(err))
+	}
+
+	// A comment with replies is kept without its content
+	if err := f.service.DeleteComment(ctx, "member", parent.ID); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	deleted, err := f.repo.GetByID(ctx, parent.ID)
+	if err != nil {
+		t.Fatalf("deleted comment with replies was removed: %v", err)
+	}
+	if !deleted.IsDeleted() || deleted.Content != "" {
+		t.Errorf("deleted comment = %+v, want it marked deleted without content", deleted)
+	}
+	if _, err := f.service.CreateComment(ctx, "owner", f.todoID, "Late answer", &parent.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateComment() replying to a deleted comment code = %v, want FailedPrecondition", grpcstatus.Code(err))
+	}
+
+	// The owner of the TODO can delete any comment; without replies it is removed
+	if err := f.service.DeleteComment(ctx, "owner", reply.ID); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	if _, err := f.repo.GetByID(ctx, reply.ID); err == nil {
+		t.Error("deleted comment without replies was kept")
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// LoginGuard throttles password logins. Failures are counted per account
+// and per client IP: an account is slowed down with growing delays and then
+// locked out, while an IP is only locked out, at a higher threshold, so that
+// users behind a shared address are not slowed down by each other.
+type LoginGuard struct {
+	attempts      auth.LoginAttemptTracker
+	activityRepo  domain.ActivityRepository
+	accountPolicy auth.LockoutPolicy
+	ipPolicy      auth.LockoutPolicy
+}
+
+// NewLoginGuard creates a new login guard
+func NewLoginGuard(
+	attempts auth.LoginAttemptTracker,
+	activityRepo domain.ActivityRepository,
+	accountPolicy auth.LockoutPolicy,
+	ipPolicy auth.LockoutPolicy,
+) *LoginGuard {
+	return &LoginGuard{
+		attempts:      attempts,
+		activityRepo:  activityRepo,
+		accountPolicy: accountPolicy,
+		ipPolicy:      ipPolicy,
+	}
+}
+
+// Check rejects the attempt if the account or the client IP is blocked
+func (g *LoginGuard) Check(ctx context.Context, email string, client ClientInfo) error {
+	var wait time.Duration
+	for _, key := range g.keys(email, client) {
+		blocked, err := g.attempts.Blocked(ctx, key)
+		if err != nil {
+			return grpcstatus.Error(codes.Unavailable, "failed to check login attempts")
+		}
+		if blocked > wait {
+			wait = blocked
+		}
+	}
+
+	if wait > 0 {
+		return grpcstatus.Error(codes.ResourceExhausted,
+			fmt.Sprintf("too many failed login attempts, try again in %s", wait.Round(time.Second)))
+	}
+	return nil
+}
+
+// RecordFailure counts a failed attempt against the account and the client
+// IP. user is nil if no account has the email address. Lockouts of existing
+// accounts are recorded in the activity log.
+func (g *LoginGuard) RecordFailure(ctx context.Context, email string, user *domain.User, client ClientInfo) {
+	failures, locked, err := g.attempts.RecordFailure(ctx, accountKey(email), g.accountPolicy)
+	if err != nil {
+		log.Printf("Failed to record login failure: %v", err)
+	} else if locked {
+		g.auditLockout(ctx, user, client, failures)
+	}
+
+	if client.IPAddress == "" {
+		return
+	}
+	failures, locked, err = g.attempts.RecordFailure(ctx, ipKey(client.IPAddress), g.ipPolicy)
+	if err != nil {
+		log.Printf("Failed to record login failure: %v", err)
+	} else if locked {
+		log.Printf("Login lockout for IP %s after %d failed attempts", client.IPAddress, failures)
+	}
+}
+
+// RecordSuccess clears the failures of an account after a correct password.
+// Failures of the client IP are kept, so an attacker cannot reset them by
+// signing in to an account of their own.
+func (g *LoginGuard) RecordSuccess(ctx context.Context, email string) {
+	if err := g.attempts.Reset(ctx, accountKey(email)); err != nil {
+		log.Printf("Failed to reset login failures: %v", err)
+	}
+}
+
+// Unlock lifts a lockout of an account and records who lifted it
+func (g *LoginGuard) Unlock(ctx context.Context, user *domain.User, unlockedBy string) error {
+	if err := g.attempts.Reset(ctx, accountKey(user.Email)); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to unlock account: %v", err))
+	}
+
+	entry := domain.NewActivityLog(user.ID, domain.ActivityActionAccountUnlocked, domain.ActivityResourceUser, nil, &user.ID,
+		map[string]interface{}{"unlocked_by": unlockedBy})
+	if err := g.activityRepo.Create(ctx, entry); err != nil {
+		log.Printf("Failed to record unlock of user %s: %v", user.ID, err)
+	}
+
+	return nil
+}
+
+// auditLockout records the lockout of an account
+func (g *LoginGuard) auditLockout(ctx context.Context, user *domain.User, client ClientInfo, failures int) {
+	if user == nil {
+		return
+	}
+
+	entry := domain.NewActivityLog(user.ID, domain.ActivityActionAccountLocked, domain.ActivityResourceUser, nil, &user.ID,
+		map[string]interface{}{
+			"failures":     failures,
+			"ip_address":   client.IPAddress,
+			"locked_until": time.Now().Add(g.accountPolicy.LockoutDuration),
+		})
+	if err := g.activityRepo.Create(ctx, entry); err != nil {
+		log.Printf("Failed to record lockout of user %s: %v", user.ID, err)
+	}
+}
+
+// keys returns the tracker keys an attempt counts against
+func (g *LoginGuard) keys(email string, client ClientInfo) []string {
+	keys := []string{accountKey(email)}
+	if client.IPAddress != "" {
+		keys = append(keys, ipKey(client.IPAddress))
+	}
+	return keys
+}
+
+// accountKey returns the tracker key of the account with an email address
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// ipKey returns the tracker key of a client IP
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockLoginAttemptTracker is an in-memory implementation of LoginAttemptTracker for testing
+type MockLoginAttemptTracker struct {
+	failures     map[string]int
+	blockedUntil map[string]time.Time
+	locked       map[string]bool
+}
+
+func NewMockLoginAttemptTracker() *MockLoginAttemptTracker {
+	return &MockLoginAttemptTracker{
+		failures:     make(map[string]int),
+		blockedUntil: make(map[string]time.Time),
+		locked:       make(map[string]bool),
+	}
+}
+
+func (m *MockLoginAttemptTracker) Blocked(ctx context.Context, key string) (time.Duration, error) {
+	if wait := time.Until(m.blockedUntil[key]); wait > 0 {
+		return wait, nil
+	}
+	return 0, nil
+}
+
+func (m *MockLoginAttemptTracker) RecordFailure(ctx context.Context, key string, policy auth.LockoutPolicy) (int, bool, error) {
+	m.failures[key]++
+	failures := m.failures[key]
+	if policy.MaxFailures > 0 && failures >= policy.MaxFailures {
+		locked := !m.locked[key]
+		m.locked[key] = true
+		m.blockedUntil[key] = time.Now().Add(policy.LockoutDuration)
+		m.failures[key] = 0
+		return failures, locked, nil
+	}
+	if delay := policy.Delay(failures); delay > 0 {
+		m.blockedUntil[key] = time.Now().Add(delay)
+	}
+	return failures, false, nil
+}
+
+func (m *MockLoginAttemptTracker) Reset(ctx context.Context, key string) error {
+	delete(m.failures, key)
+	delete(m.blockedUntil, key)
+	delete(m.locked, key)
+	return nil
+}
+
+// MockActivityRepository records activity log entries for testing
+type MockActivityRepository struct {
+	logs []*domain.ActivityLog
+}
+
+func (m *MockActivityRepository) Create(ctx context.Context, log *domain.ActivityLog) error {
+	m.logs = append(m.logs, log)
+	return nil
+}
+
+func (m *MockActivityRepository) ListByTeam(ctx context.Context, teamID string, limit int) ([]*domain.ActivityLog, error) {
+	return nil, nil
+}
+
+func (m *MockActivityRepository) ListByUser(ctx context.Context, userID string, limit int) ([]*domain.ActivityLog, error) {
+	var logs []*domain.ActivityLog
+	for _, log := range m.logs {
+		if log.UserID == userID {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func TestAuthService_LoginLockout(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewMockLoginAttemptTracker()
+	activityRepo := &MockActivityRepository{}
+	guard := NewLoginGuard(tracker, activityRepo,
+		auth.LockoutPolicy{MaxFailures: 3, Window: time.Hour, LockoutDuration: time.Hour},
+		auth.LockoutPolicy{MaxFailures: 10, Window: time.Hour, LockoutDuration: time.Hour})
+	authService := NewAuthService(NewMockUserRepository(), NewMockSessionRepository(), auth.NewJWTManager("test-secret", time.Hour),
+		NewMockRevocationStore(), nil, nil, 7*24*time.Hour, guard)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	client := ClientInfo{IPAddress: "203.0.113.7"}
+
+	for i := 0; i < 3; i++ {
+		if _, err := authService.Login(ctx, "test@example.com", "wrong", client); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Fatalf("attempt %d: expected Unauthenticated, got %v", i+1, err)
+		}
+	}
+
+	if _, err := authService.Login(ctx, "Test@Example.com", "password123", client); grpcstatus.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected locked account to be rejected, got %v", err)
+	}
+	if len(activityRepo.logs) != 1 || activityRepo.logs[0].Action != domain.ActivityActionAccountLocked {
+		t.Fatalf("expected one lockout audit entry, got %+v", activityRepo.logs)
+	}
+
+	// Other accounts from the same IP are not affected
+	if _, err := authService.Register(ctx, "other@example.com", "otheruser", "password123", "Other User"); err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	if _, err := authService.Login(ctx, "other@example.com", "password123", client); err != nil {
+		t.Errorf("expected other account to log in, got %v", err)
+	}
+
+	if err := guard.Unlock(ctx, user, "admin-1"); err != nil {
+		t.Fatalf("failed to unlock: %v", err)
+	}
+	if _, err := authService.Login(ctx, "test@example.com", "password123", client); err != nil {
+		t.Errorf("expected unlocked account to log in, got %v", err)
+	}
+	if got := activityRepo.logs[len(activityRepo.logs)-1].Action; got != domain.ActivityActionAccountUnlocked {
+		t.Errorf("last audit action = %v, want %v", got, domain.ActivityActionAccountUnlocked)
+	}
+}
+
+func TestLoginGuard_ProgressiveDelay(t *testing.T) {
+	ctx := context.Background()
+	guard := NewLoginGuard(NewMockLoginAttemptTracker(), &MockActivityRepository{},
+		auth.LockoutPolicy{Window: time.Hour, BaseDelay: time.Minute, MaxDelay: time.Hour},
+		auth.LockoutPolicy{})
+
+	if err := guard.Check(ctx, "test@example.com", ClientInfo{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	guard.RecordFailure(ctx, "test@example.com", nil, ClientInfo{})
+	if err := guard.Check(ctx, "test@example.com", ClientInfo{}); grpcstatus.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected attempt during delay to be rejected, got %v", err)
+	}
+
+	guard.RecordSuccess(ctx, "test@example.com")
+	if err := guard.Check(ctx, "test@example.com", ClientInfo{}); err != nil {
+		t.Errorf("expected success to clear the delay, got %v", err)
+	}
+}
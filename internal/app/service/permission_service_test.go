@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	"github.com/venslupro/todo-api/internal/domain"
@@ -44,6 +45,16 @@ func (m *MockTODORepository) Update(ctx context.Context, todo *domain.TODO) erro
 	return nil
 }
 
+func (m *MockTODORepository) CompleteOccurrence(ctx context.Context, completed *domain.TODO, created []*domain.TODO) error {
+	if err := m.Update(ctx, completed); err != nil {
+		return err
+	}
+	for _, todo := range created {
+		m.todos[todo.ID] = todo
+	}
+	return nil
+}
+
 func (m *MockTODORepository) Delete(ctx context.Context, id string) error {
 	if _, ok := m.todos[id]; !ok {
 		return &NotFoundError{ID: id}
@@ -76,15 +87,6 @@ func (m *MockTODORepository) List(ctx context.Context, options domain.TODOListOp
 	}, nil
 }
 
-func (m *MockTODORepository) BulkUpdateStatus(ctx context.Context, ids []string, status commonv1.Status) error {
-	for _, id := range ids {
-		if todo, ok := m.todos[id]; ok {
-			todo.Status = status
-		}
-	}
-	return nil
-}
-
 func (m *MockTODORepository) BulkDelete(ctx context.Context, ids []string) error {
 	for _, id := range ids {
 		delete(m.todos, id)
@@ -136,6 +138,50 @@ func (m *MockTODORepository) GetSharedTeams(ctx context.Context, todoID string)
 	return teams, nil
 }
 
+func (m *MockTODORepository) ListTrash(ctx context.Context, userID string, page, pageSize int32) ([]*domain.TODO, *domain.PaginationResult, error) {
+	return []*domain.TODO{}, &domain.PaginationResult{}, nil
+}
+
+func (m *MockTODORepository) GetDeletedByID(ctx context.Context, id string) (*domain.TODO, error) {
+	return nil, &NotFoundError{ID: id}
+}
+
+func (m *MockTODORepository) Restore(ctx context.Context, id string) error {
+	return &NotFoundError{ID: id}
+}
+
+func (m *MockTODORepository) Purge(ctx context.Context, id string) ([]string, error) {
+	return nil, &NotFoundError{ID: id}
+}
+
+func (m *MockTODORepository) ListPurgeable(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockTODORepository) GetTree(ctx context.Context, id string, depth int32) (*domain.TODOTreeNode, error) {
+	return nil, &NotFoundError{ID: id}
+}
+
+func (m *MockTODORepository) IsDescendant(ctx context.Context, id, ancestorID string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockTODORepository) ListSiblings(ctx context.Context, userID string, parentID *string) ([]*domain.TODO, error) {
+	return nil, nil
+}
+
+func (m *MockTODORepository) LastOrderKey(ctx context.Context, userID string, parentID *string) (string, error) {
+	return "", nil
+}
+
+func (m *MockTODORepository) SetOrderKeys(ctx context.Context, keys map[string]string) error {
+	return nil
+}
+
+func (m *MockTODORepository) UpdateMany(ctx context.Context, todos []*domain.TODO, orderKeys map[string]string) error {
+	return nil
+}
+
 func (m *MockTODORepository) ShareTODOWithTeam(ctx context.Context, todoID, teamID string) error {
 	if _, ok := m.todos[todoID]; !ok {
 		return &NotFoundError{ID: todoID}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// maxTokenNameLength bounds the name of a personal access token
+const maxTokenNameLength = 100
+
+// lastUsedResolution limits how often token usage is written back
+const lastUsedResolution = time.Minute
+
+// PersonalAccessTokenService manages scoped personal access tokens
+type PersonalAccessTokenService struct {
+	tokenRepo domain.PersonalAccessTokenRepository
+	userRepo  domain.UserRepository
+}
+
+// NewPersonalAccessTokenService creates a new personal access token service
+func NewPersonalAccessTokenService(tokenRepo domain.PersonalAccessTokenRepository, userRepo domain.UserRepository) *PersonalAccessTokenService {
+	return &PersonalAccessTokenService{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Create creates a token for the user and returns it with its plain value,
+// which is not stored and cannot be retrieved later
+func (s *PersonalAccessTokenService) Create(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (*domain.PersonalAccessToken, string, error) {
+	if name == "" {
+		return nil, "", grpcstatus.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > maxTokenNameLength {
+		return nil, "", grpcstatus.Error(codes.InvalidArgument, fmt.Sprintf("name must be at most %d characters", maxTokenNameLength))
+	}
+	if err := auth.ValidateScopes(scopes); err != nil {
+		return nil, "", grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", grpcstatus.Error(codes.InvalidArgument, "expiry must be in the future")
+	}
+
+	secret, err := auth.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, "", grpcstatus.Error(codes.Internal, err.Error())
+	}
+
+	token := domain.NewPersonalAccessToken(userID, name, auth.HashOpaqueToken(secret), scopes, expiresAt)
+	if err := s.tokenRepo.Create(ctx, token); err != nil {
+		return nil, "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create token: %v", err))
+	}
+
+	return token, secret, nil
+}
+
+// List lists the user's unrevoked tokens
+func (s *PersonalAccessTokenService) List(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	tokens, err := s.tokenRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list tokens: %v", err))
+	}
+	return tokens, nil
+}
+
+// Revoke revokes one of the user's tokens
+func (s *PersonalAccessTokenService) Revoke(ctx context.Context, userID, tokenID string) error {
+	if tokenID == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "token id is required")
+	}
+
+	if err := s.tokenRepo.Revoke(ctx, userID, tokenID); err != nil {
+		return grpcstatus.Error(codes.NotFound, "token not found")
+	}
+	return nil
+}
+
+// RevokeAll revokes all of the user's tokens
+func (s *PersonalAccessTokenService) RevokeAll(ctx context.Context, userID string) error {
+	if err := s.tokenRepo.RevokeAllByUser(ctx, userID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to revoke tokens: %v", err))
+	}
+	return nil
+}
+
+// VerifyPersonalAccessToken implements auth.PersonalAccessTokenVerifier
+func (s *PersonalAccessTokenService) VerifyPersonalAccessToken(ctx context.Context, secret string) (*auth.Claims, []string, error) {
+	token, err := s.tokenRepo.GetByHash(ctx, auth.HashOpaqueToken(secret))
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "invalid personal access token")
+	}
+	if !token.IsActive() {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "personal access token has expired or been revoked")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "user not found")
+	}
+	if !user.IsActive {
+		return nil, nil, grpcstatus.Error(codes.PermissionDenied, "user account is inactive")
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > lastUsedResolution {
+		// Usage tracking is informational; don't fail the request over it
+		_ = s.tokenRepo.UpdateLastUsed(ctx, token.ID, now)
+	}
+
+	claims := &auth.Claims{
+		UserID:   user.ID,
+		Username: user.Username,
+		Email:    user.Email,
+	}
+	claims.ID = token.ID
+
+	return claims, token.Scopes, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockPersonalAccessTokenRepository is a mock implementation of PersonalAccessTokenRepository for testing
+type MockPersonalAccessTokenRepository struct {
+	tokens map[string]*domain.PersonalAccessToken
+}
+
+func NewMockPersonalAccessTokenRepository() *MockPersonalAccessTokenRepository {
+	return &MockPersonalAccessTokenRepository{
+		tokens: make(map[string]*domain.PersonalAccessToken),
+	}
+}
+
+func (m *MockPersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+	m.tokens[token.ID] = token
+	return nil
+}
+
+func (m *MockPersonalAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	for _, token := range m.tokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return nil, fmt.Errorf("personal access token not found")
+}
+
+func (m *MockPersonalAccessTokenRepository) ListByUser(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	var tokens []*domain.PersonalAccessToken
+	for _, token := range m.tokens {
+		if token.UserID == userID && token.RevokedAt == nil {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+func (m *MockPersonalAccessTokenRepository) Revoke(ctx context.Context, userID, id string) error {
+	token, ok := m.tokens[id]
+	if !ok || token.UserID != userID || token.RevokedAt != nil {
+		return fmt.Errorf("personal access token not found")
+	}
+	now := time.Now()
+	token.RevokedAt = &now
+	return nil
+}
+
+func (m *MockPersonalAccessTokenRepository) RevokeAllByUser(ctx context.Context, userID string) error {
+	now := time.Now()
+	for _, token := range m.tokens {
+		if token.UserID == userID && token.RevokedAt == nil {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (m *MockPersonalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	if token, ok := m.tokens[id]; ok {
+		token.LastUsedAt = &usedAt
+	}
+	return nil
+}
+
+func TestPersonalAccessTokenService_Create(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name      string
+		tokenName string
+		scopes    []string
+		expiresAt *time.Time
+		wantCode  codes.Code
+	}{
+		{name: "valid token", tokenName: "ci", scopes: []string{auth.ScopeTODOsRead}, wantCode: codes.OK},
+		{name: "missing name", tokenName: "", scopes: []string{auth.ScopeTODOsRead}, wantCode: codes.InvalidArgument},
+		{name: "missing scopes", tokenName: "ci", scopes: nil, wantCode: codes.InvalidArgument},
+		{name: "unknown scope", tokenName: "ci", scopes: []string{"admin"}, wantCode: codes.InvalidArgument},
+		{name: "expiry in the past", tokenName: "ci", scopes: []string{auth.ScopeTODOsRead}, expiresAt: &past, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenRepo := NewMockPersonalAccessTokenRepository()
+			s := NewPersonalAccessTokenService(tokenRepo, NewMockUserRepository())
+
+			token, secret, err := s.Create(context.Background(), "user-123", tt.tokenName, tt.scopes, tt.expiresAt)
+			if grpcstatus.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if !strings.HasPrefix(secret, auth.PersonalAccessTokenPrefix) {
+				t.Errorf("secret %q lacks the token prefix", secret)
+			}
+			if token.TokenHash == secret || token.TokenHash != auth.HashOpaqueToken(secret) {
+				t.Error("expected only the hash of the secret to be stored")
+			}
+		})
+	}
+}
+
+func TestPersonalAccessTokenService_Verify(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	user := domain.NewUser("test@example.com", "testuser", "hash")
+	_ = userRepo.Create(ctx, user)
+	s := NewPersonalAccessTokenService(NewMockPersonalAccessTokenRepository(), userRepo)
+
+	token, secret, err := s.Create(ctx, user.ID, "ci", []string{auth.ScopeTODOsWrite}, nil)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	claims, scopes, err := s.VerifyPersonalAccessToken(ctx, secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claims.UserID != user.ID || claims.ID != token.ID {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+	if len(scopes) != 1 || scopes[0] != auth.ScopeTODOsWrite {
+		t.Errorf("scopes = %v, want [%s]", scopes, auth.ScopeTODOsWrite)
+	}
+	if token.LastUsedAt == nil {
+		t.Error("expected last use to be recorded")
+	}
+
+	if _, _, err := s.VerifyPersonalAccessToken(ctx, auth.PersonalAccessTokenPrefix+"unknown"); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated for unknown token, got %v", err)
+	}
+
+	if err := s.Revoke(ctx, "other-user", token.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound when revoking another user's token, got %v", err)
+	}
+	if err := s.Revoke(ctx, user.ID, token.ID); err != nil {
+		t.Fatalf("failed to revoke token: %v", err)
+	}
+	if _, _, err := s.VerifyPersonalAccessToken(ctx, secret); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated for revoked token, got %v", err)
+	}
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// reminderBatchSize is the maximum number of reminders fired per run
+const reminderBatchSize = 100
+
+// ReminderNotificationType is the type of the notification sent when a
+// reminder fires
+const ReminderNotificationType = "reminder"
+
+// UserNotifier delivers notifications to a user's connected clients.
+// WebSocketService implements it.
+type UserNotifier interface {
+	BroadcastUserNotification(ctx context.Context, userID, messageType, content string)
+}
+
+// ReminderNotification is the content of a reminder notification
+type ReminderNotification struct {
+	ReminderID string     `json:"reminder_id"`
+	TODOID     string     `json:"todo_id"`
+	Title      string     `json:"title,omitempty"`
+	DueDate    *time.Time `json:"due_date,omitempty"`
+}
+
+// ReminderService handles reminders users attach to TODOs and fires them
+// when they are due. Reminders are private to the user who created them.
+type ReminderService struct {
+	repo        domain.ReminderRepository
+	todoRepo    domain.TODORepository
+	permissions *PermissionService
+	notifier    UserNotifier
+}
+
+// NewReminderService creates a new reminder service
+func NewReminderService(repo domain.ReminderRepository, todoRepo domain.TODORepository, permissions *PermissionService, notifier UserNotifier) *ReminderService {
+	return &ReminderService{
+		repo:        repo,
+		todoRepo:    todoRepo,
+		permissions: permissions,
+		notifier:    notifier,
+	}
+}
+
+// CreateReminder attaches a reminder to a TODO the user can view. Exactly
+// one of remindAt and beforeDue must be given; a reminder relative to the due
+// date of a TODO without one fires once a due date is set.
+func (s *ReminderService) CreateReminder(ctx context.Context, userID, todoID string, remindAt *time.Time, beforeDue *time.Duration) (*domain.Reminder, error) {
+	if todoID == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "todo_id is required")
+	}
+	if (remindAt == nil) == (beforeDue == nil) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "exactly one of remind_at and before_due is required")
+	}
+
+	if err := s.permissions.CanViewTODO(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	todo, err := s.todoRepo.GetByID(ctx, todoID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "todo not found")
+	}
+
+	var reminder *domain.Reminder
+	if remindAt != nil {
+		if !remindAt.After(time.Now()) {
+			return nil, grpcstatus.Error(codes.InvalidArgument, "remind_at must be in the future")
+		}
+		reminder = domain.NewReminder(todo.ID, userID, *remindAt)
+	} else {
+		if *beforeDue < 0 {
+			return nil, grpcstatus.Error(codes.InvalidArgument, "before_due cannot be negative")
+		}
+		reminder = domain.NewRelativeReminder(todo.ID, userID, beforeDue.Truncate(time.Second), todo.DueDate)
+	}
+
+	if err := s.repo.Create(ctx, reminder); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create reminder: %v", err))
+	}
+
+	return reminder, nil
+}
+
+// ListReminders retrieves the user's reminders on a TODO
+func (s *ReminderService) ListReminders(ctx context.Context, userID, todoID string) ([]*domain.Reminder, error) {
+	if todoID == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "todo_id is required")
+	}
+
+	reminders, err := s.repo.ListByTODO(ctx, todoID, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list reminders: %v", err))
+	}
+
+	return reminders, nil
+}
+
+// SnoozeReminder makes a reminder fire again after the given duration,
+// whether or not it has already fired
+func (s *ReminderService) SnoozeReminder(ctx context.Context, userID, id string, duration time.Duration) (*domain.Reminder, error) {
+	if duration <= 0 {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "duration must be positive")
+	}
+
+	reminder, err := s.getReminder(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if reminder.IsDismissed() {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "reminder has been dismissed")
+	}
+
+	reminder.Snooze(time.Now().Add(duration))
+	if err := s.repo.Update(ctx, reminder); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to snooze reminder: %v", err))
+	}
+
+	return reminder, nil
+}
+
+// DismissReminder stops a reminder from firing. Dismissing a dismissed
+// reminder has no effect.
+func (s *ReminderService) DismissReminder(ctx context.Context, userID, id string) (*domain.Reminder, error) {
+	reminder, err := s.getReminder(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if reminder.IsDismissed() {
+		return reminder, nil
+	}
+
+	reminder.Dismiss()
+	if err := s.repo.Update(ctx, reminder); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to dismiss reminder: %v", err))
+	}
+
+	return reminder, nil
+}
+
+// DeleteReminder deletes a reminder
+func (s *ReminderService) DeleteReminder(ctx context.Context, userID, id string) error {
+	reminder, err := s.getReminder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, reminder.ID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to delete reminder: %v", err))
+	}
+
+	return nil
+}
+
+// FireDue fires the reminders that are due and returns how many were fired.
+// Each reminder is claimed before it is delivered, so it fires once even when
+// several servers run the scheduler; a reminder whose notification cannot be
+// delivered is not retried.
+func (s *ReminderService) FireDue(ctx context.Context) (int, error) {
+	fired := 0
+	for {
+		reminders, err := s.repo.ClaimDue(ctx, time.Now(), reminderBatchSize)
+		if err != nil {
+			return fired, fmt.Errorf("failed to claim due reminders: %w", err)
+		}
+
+		for _, reminder := range reminders {
+			s.notify(ctx, reminder)
+		}
+		fired += len(reminders)
+
+		if len(reminders) < reminderBatchSize {
+			return fired, nil
+		}
+	}
+}
+
+// StartScheduler runs FireDue every interval until ctx is done
+func (s *ReminderService) StartScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.FireDue(ctx); err != nil {
+					log.Printf("Failed to fire reminders: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// notify delivers a fired reminder to its user. The title and due date of
+// the TODO are left out if the user can no longer view it, for example
+// because it was unshared from their team after the reminder was created.
+func (s *ReminderService) notify(ctx context.Context, reminder *domain.Reminder) {
+	notification := ReminderNotification{
+		ReminderID: reminder.ID,
+		TODOID:     reminder.TODOID,
+	}
+	if err := s.permissions.CanViewTODO(ctx, reminder.UserID, reminder.TODOID); err != nil {
+		log.Printf("Not including todo %s in reminder %s: %v", reminder.TODOID, reminder.ID, err)
+	} else if todo, err := s.todoRepo.GetByID(ctx, reminder.TODOID); err == nil {
+		notification.Title = todo.Title
+		notification.DueDate = todo.DueDate
+	} else {
+		log.Printf("Failed to load todo %s of reminder %s: %v", reminder.TODOID, reminder.ID, err)
+	}
+
+	content, err := json.Marshal(notification)
+	if err != nil {
+		log.Printf("Failed to encode reminder %s: %v", reminder.ID, err)
+		return
+	}
+	s.notifier.BroadcastUserNotification(ctx, reminder.UserID, ReminderNotificationType, string(content))
+}
+
+// getReminder retrieves one of the user's reminders. Other users' reminders
+// are reported as not found.
+func (s *ReminderService) getReminder(ctx context.Context, userID, id string) (*domain.Reminder, error) {
+	if id == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
+	}
+
+	reminder, err := s.repo.GetByID(ctx, id)
+	if err != nil || reminder.UserID != userID {
+		return nil, grpcstatus.Error(codes.NotFound, "reminder not found")
+	}
+
+	return reminder, nil
+}
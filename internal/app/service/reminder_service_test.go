@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockReminderRepository is a mock implementation of ReminderRepository for
+// testing. Like the PostgreSQL repository, it derives FireAt from the due
+// date of the TODO when reminders are read.
+type MockReminderRepository struct {
+	reminders map[string]*domain.Reminder
+	todos     *MockRepository
+}
+
+func NewMockReminderRepository(todos *MockRepository) *MockReminderRepository {
+	return &MockReminderRepository{
+		reminders: make(map[string]*domain.Reminder),
+		todos:     todos,
+	}
+}
+
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *domain.Reminder) error {
+	stored := *reminder
+	m.reminders[reminder.ID] = &stored
+	return nil
+}
+
+func (m *MockReminderRepository) GetByID(ctx context.Context, id string) (*domain.Reminder, error) {
+	reminder, ok := m.reminders[id]
+	if !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	return m.read(reminder), nil
+}
+
+func (m *MockReminderRepository) ListByTODO(ctx context.Context, todoID, userID string) ([]*domain.Reminder, error) {
+	var result []*domain.Reminder
+	for _, reminder := range m.reminders {
+		if reminder.TODOID == todoID && reminder.UserID == userID {
+			result = append(result, m.read(reminder))
+		}
+	}
+	return result, nil
+}
+
+func (m *MockReminderRepository) Update(ctx context.Context, reminder *domain.Reminder) error {
+	if _, ok := m.reminders[reminder.ID]; !ok {
+		return &NotFoundError{ID: reminder.ID}
+	}
+	stored := *reminder
+	m.reminders[reminder.ID] = &stored
+	return nil
+}
+
+func (m *MockReminderRepository) Delete(ctx context.Context, id string) error {
+	if _, ok := m.reminders[id]; !ok {
+		return &NotFoundError{ID: id}
+	}
+	delete(m.reminders, id)
+	return nil
+}
+
+func (m *MockReminderRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domain.Reminder, error) {
+	var claimed []*domain.Reminder
+	for _, stored := range m.reminders {
+		reminder := m.read(stored)
+		if len(claimed) == limit || reminder.FiredAt != nil || reminder.IsDismissed() ||
+			reminder.FireAt == nil || reminder.FireAt.After(now) {
+			continue
+		}
+		if todo, ok := m.todos.todos[reminder.TODOID]; !ok || todo.Status == commonv1.Status_STATUS_COMPLETED {
+			continue
+		}
+
+		firedAt := now
+		stored.FiredAt = &firedAt
+		reminder.FiredAt = &firedAt
+		claimed = append(claimed, reminder)
+	}
+	return claimed, nil
+}
+
+// read returns a copy of a stored reminder with FireAt derived
+func (m *MockReminderRepository) read(stored *domain.Reminder) *domain.Reminder {
+	reminder := *stored
+	reminder.FireAt = reminder.RemindAt
+	if reminder.RemindAt == nil {
+		reminder.FireAt = nil
+		if todo, ok := m.todos.todos[reminder.TODOID]; ok && todo.DueDate != nil {
+			fireAt := todo.DueDate.Add(-*reminder.BeforeDue)
+			reminder.FireAt = &fireAt
+		}
+	}
+	return &reminder
+}
+
+// sentNotification is a notification recorded by MockUserNotifier
+type sentNotification struct {
+	UserID      string
+	MessageType string
+	Content     ReminderNotification
+}
+
+// MockUserNotifier is a mock implementation of UserNotifier for testing
+type MockUserNotifier struct {
+	sent []sentNotification
+}
+
+func (m *MockUserNotifier) BroadcastUserNotification(ctx context.Context, userID, messageType, content string) {
+	notification := sentNotification{UserID: userID, MessageType: messageType}
+	_ = json.Unmarshal([]byte(content), &notification.Content)
+	m.sent = append(m.sent, notification)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+// newTestReminderService creates a reminder service for TODOs created by
+// createReminderTestTODO, which are owned by "user-1" and shared with a team
+// in which "user-2" is a member
+func newTestReminderService(t *testing.T) (*ReminderService, *MockRepository, *MockReminderRepository, *MockUserNotifier) {
+	t.Helper()
+	todoRepo := NewMockRepository()
+	teamRepo := NewMockTeamRepository()
+	teamRepo.members["team-1"] = map[string]*domain.TeamMember{
+		"user-2": {TeamID: "team-1", UserID: "user-2", Role: commonv1.Role_ROLE_MEMBER},
+	}
+	repo := NewMockReminderRepository(todoRepo)
+	notifier := &MockUserNotifier{}
+	permissions := NewPermissionService(todoRepo, teamRepo)
+	return NewReminderService(repo, todoRepo, permissions, notifier), todoRepo, repo, notifier
+}
+
+func createReminderTestTODO(t *testing.T, todoRepo *MockRepository, dueDate *time.Time) *domain.TODO {
+	t.Helper()
+	todo := domain.NewTODO("user-1", "Submit report")
+	todo.DueDate = dueDate
+	if err := todoRepo.Create(context.Background(), todo); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	todoRepo.shared[todo.ID] = []string{"team-1"}
+	return todo
+}
+
+func TestReminderService_CreateReminder(t *testing.T) {
+	ctx := context.Background()
+	service, todoRepo, _, _ := newTestReminderService(t)
+
+	dueDate := time.Now().Add(48 * time.Hour)
+	todo := createReminderTestTODO(t, todoRepo, &dueDate)
+	undated := createReminderTestTODO(t, todoRepo, nil)
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	hour := time.Hour
+	negative := -time.Minute
+
+	tests := []struct {
+		name       string
+		userID     string // defaults to the owner, user-1
+		todoID     string
+		remindAt   *time.Time
+		beforeDue  *time.Duration
+		wantCode   codes.Code
+		wantFireAt *time.Time
+	}{
+		{name: "absolute", todoID: todo.ID, remindAt: &future, wantCode: codes.OK, wantFireAt: &future},
+		{name: "relative", todoID: todo.ID, beforeDue: &hour, wantCode: codes.OK, wantFireAt: timePtr(dueDate.Add(-time.Hour))},
+		{name: "relative without due date", todoID: undated.ID, beforeDue: &hour, wantCode: codes.OK},
+		{name: "missing todo id", remindAt: &future, wantCode: codes.InvalidArgument},
+		{name: "missing trigger", todoID: todo.ID, wantCode: codes.InvalidArgument},
+		{name: "both triggers", todoID: todo.ID, remindAt: &future, beforeDue: &hour, wantCode: codes.InvalidArgument},
+		{name: "in the past", todoID: todo.ID, remindAt: &past, wantCode: codes.InvalidArgument},
+		{name: "negative offset", todoID: todo.ID, beforeDue: &negative, wantCode: codes.InvalidArgument},
+		{name: "unknown todo", todoID: "missing", remindAt: &future, wantCode: codes.NotFound},
+		{name: "team member", userID: "user-2", todoID: todo.ID, remindAt: &future, wantCode: codes.OK, wantFireAt: &future},
+		{name: "no access", userID: "user-3", todoID: todo.ID, remindAt: &future, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := tt.userID
+			if userID == "" {
+				userID = "user-1"
+			}
+			reminder, err := service.CreateReminder(ctx, userID, tt.todoID, tt.remindAt, tt.beforeDue)
+			if code := grpcstatus.Code(err); code != tt.wantCode {
+				t.Fatalf("CreateReminder() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if reminder.UserID != userID || reminder.TODOID != tt.todoID {
+				t.Errorf("CreateReminder() = %+v, want reminder of %s on %s", reminder, userID, tt.todoID)
+			}
+			if (reminder.FireAt == nil) != (tt.wantFireAt == nil) ||
+				(reminder.FireAt != nil && !reminder.FireAt.Equal(*tt.wantFireAt)) {
+				t.Errorf("CreateReminder() FireAt = %v, want %v", reminder.FireAt, tt.wantFireAt)
+			}
+		})
+	}
+}
+
+func TestReminderService_FireDue(t *testing.T) {
+	ctx := context.Background()
+	service, todoRepo, repo, notifier := newTestReminderService(t)
+
+	soon := time.Now().Add(30 * time.Minute)
+	todo := createReminderTestTODO(t, todoRepo, &soon)
+	completed := createReminderTestTODO(t, todoRepo, &soon)
+	completed.Complete()
+
+	hour := time.Hour
+	due, err := service.CreateReminder(ctx, "user-1", todo.ID, nil, &hour)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+	later, err := service.CreateReminder(ctx, "user-2", todo.ID, timePtr(time.Now().Add(time.Hour)), nil)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+	onCompleted, err := service.CreateReminder(ctx, "user-1", completed.ID, nil, &hour)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+	dismissed, err := service.CreateReminder(ctx, "user-1", todo.ID, nil, &hour)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+	if _, err := service.DismissReminder(ctx, "user-1", dismissed.ID); err != nil {
+		t.Fatalf("DismissReminder() error = %v", err)
+	}
+
+	fired, err := service.FireDue(ctx)
+	if err != nil {
+		t.Fatalf("FireDue() error = %v", err)
+	}
+	if fired != 1 || len(notifier.sent) != 1 {
+		t.Fatalf("FireDue() fired %d reminders and sent %d notifications, want 1", fired, len(notifier.sent))
+	}
+
+	sent := notifier.sent[0]
+	if sent.UserID != "user-1" || sent.MessageType != ReminderNotificationType {
+		t.Errorf("notification sent to %s with type %s, want user-1 with type %s", sent.UserID, sent.MessageType, ReminderNotificationType)
+	}
+	if sent.Content.ReminderID != due.ID || sent.Content.TODOID != todo.ID || sent.Content.Title != todo.Title {
+		t.Errorf("notification content = %+v, want reminder %s on %s", sent.Content, due.ID, todo.ID)
+	}
+
+	// A reminder fires only once
+	if fired, err := service.FireDue(ctx); err != nil || fired != 0 {
+		t.Errorf("second FireDue() = %d, %v, want 0, nil", fired, err)
+	}
+
+	for _, id := range []string{later.ID, onCompleted.ID, dismissed.ID} {
+		if reminder, _ := repo.GetByID(ctx, id); reminder.FiredAt != nil {
+			t.Errorf("reminder %s fired, want it pending", id)
+		}
+	}
+}
+
+func TestReminderService_FireDueAfterAccessLost(t *testing.T) {
+	ctx := context.Background()
+	service, todoRepo, _, notifier := newTestReminderService(t)
+
+	soon := time.Now().Add(30 * time.Minute)
+	todo := createReminderTestTODO(t, todoRepo, &soon)
+	hour := time.Hour
+	reminder, err := service.CreateReminder(ctx, "user-2", todo.ID, nil, &hour)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+
+	// The TODO is unshared from the team of user-2 before the reminder fires
+	delete(todoRepo.shared, todo.ID)
+
+	if fired, err := service.FireDue(ctx); err != nil || fired != 1 || len(notifier.sent) != 1 {
+		t.Fatalf("FireDue() = %d, %v and sent %d notifications, want 1", fired, err, len(notifier.sent))
+	}
+	sent := notifier.sent[0].Content
+	if sent.ReminderID != reminder.ID || sent.Title != "" || sent.DueDate != nil {
+		t.Errorf("notification content = %+v, want reminder %s without the title and due date of the todo", sent, reminder.ID)
+	}
+}
+
+func TestReminderService_SnoozeReminder(t *testing.T) {
+	ctx := context.Background()
+	service, todoRepo, repo, notifier := newTestReminderService(t)
+
+	soon := time.Now().Add(30 * time.Minute)
+	todo := createReminderTestTODO(t, todoRepo, &soon)
+	hour := time.Hour
+	reminder, err := service.CreateReminder(ctx, "user-1", todo.ID, nil, &hour)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+	if _, err := service.FireDue(ctx); err != nil {
+		t.Fatalf("FireDue() error = %v", err)
+	}
+
+	if _, err := service.SnoozeReminder(ctx, "user-1", reminder.ID, 0); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("SnoozeReminder() with zero duration code = %v, want InvalidArgument", grpcstatus.Code(err))
+	}
+	if _, err := service.SnoozeReminder(ctx, "user-2", reminder.ID, time.Minute); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("SnoozeReminder() by another user code = %v, want NotFound", grpcstatus.Code(err))
+	}
+
+	snoozed, err := service.SnoozeReminder(ctx, "user-1", reminder.ID, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("SnoozeReminder() error = %v", err)
+	}
+	if snoozed.FiredAt != nil || snoozed.FireAt == nil || !snoozed.FireAt.After(time.Now()) {
+		t.Fatalf("SnoozeReminder() = %+v, want a pending reminder firing later", snoozed)
+	}
+
+	// The snoozed reminder fires again once its new time has come
+	if fired, _ := service.FireDue(ctx); fired != 0 {
+		t.Errorf("FireDue() before the snooze ended fired %d reminders, want 0", fired)
+	}
+	past := time.Now().Add(-time.Second)
+	repo.reminders[reminder.ID].RemindAt = &past
+	if fired, _ := service.FireDue(ctx); fired != 1 || len(notifier.sent) != 2 {
+		t.Errorf("FireDue() after the snooze ended fired %d reminders, want 1", fired)
+	}
+
+	if _, err := service.DismissReminder(ctx, "user-1", reminder.ID); err != nil {
+		t.Fatalf("DismissReminder() error = %v", err)
+	}
+	if _, err := service.SnoozeReminder(ctx, "user-1", reminder.ID, time.Minute); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SnoozeReminder() of a dismissed reminder code = %v, want FailedPrecondition", grpcstatus.Code(err))
+	}
+}
+
+func TestReminderService_ListAndDelete(t *testing.T) {
+	ctx := context.Background()
+	service, todoRepo, _, _ := newTestReminderService(t)
+
+	dueDate := time.Now().Add(48 * time.Hour)
+	todo := createReminderTestTODO(t, todoRepo, &dueDate)
+	for _, before := range []time.Duration{time.Hour, 24 * time.Hour} {
+		before := before
+		if _, err := service.CreateReminder(ctx, "user-1", todo.ID, nil, &before); err != nil {
+			t.Fatalf("CreateReminder() error = %v", err)
+		}
+	}
+	hour := time.Hour
+	other, err := service.CreateReminder(ctx, "user-2", todo.ID, nil, &hour)
+	if err != nil {
+		t.Fatalf("CreateReminder() error = %v", err)
+	}
+
+	reminders, err := service.ListReminders(ctx, "user-1", todo.ID)
+	if err != nil {
+		t.Fatalf("ListReminders() error = %v", err)
+	}
+	if len(reminders) != 2 {
+		t.Fatalf("ListReminders() returned %d reminders, want 2", len(reminders))
+	}
+	sort.Slice(reminders, func(i, j int) bool { return reminders[i].FireAt.Before(*reminders[j].FireAt) })
+	if want := dueDate.Add(-24 * time.Hour); !reminders[0].FireAt.Equal(want) {
+		t.Errorf("first reminder fires at %v, want %v", reminders[0].FireAt, want)
+	}
+
+	if err := service.DeleteReminder(ctx, "user-1", other.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("DeleteReminder() of another user's reminder code = %v, want NotFound", grpcstatus.Code(err))
+	}
+	if err := service.DeleteReminder(ctx, "user-2", other.ID); err != nil {
+		t.Errorf("DeleteReminder() error = %v", err)
+	}
+	if reminders, _ := service.ListReminders(ctx, "user-2", todo.ID); len(reminders) != 0 {
+		t.Errorf("ListReminders() after delete returned %d reminders, want 0", len(reminders))
+	}
+}
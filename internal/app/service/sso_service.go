@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/sso"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// maxUsernameLength bounds usernames generated for provisioned users
+const maxUsernameLength = 50
+
+// SSOService handles login through external OpenID Connect providers
+type SSOService struct {
+	userRepo     domain.UserRepository
+	identityRepo domain.UserIdentityRepository
+	authService  *AuthService
+	providers    map[string]*sso.Provider
+	states       sso.StateStore
+	stateTTL     time.Duration
+}
+
+// NewSSOService creates a new single sign-on service. stateTTL bounds how long
+// a user may take to sign in at the provider.
+func NewSSOService(
+	userRepo domain.UserRepository,
+	identityRepo domain.UserIdentityRepository,
+	authService *AuthService,
+	states sso.StateStore,
+	stateTTL time.Duration,
+	providers ...*sso.Provider,
+) *SSOService {
+	byName := make(map[string]*sso.Provider, len(providers))
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+
+	return &SSOService{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		authService:  authService,
+		providers:    byName,
+		states:       states,
+		stateTTL:     stateTTL,
+	}
+}
+
+// Providers returns the names of the configured providers
+func (s *SSOService) Providers() []string {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StartLogin begins a login with a provider and returns the URL to send the
+// user to, along with the state the provider will echo back
+func (s *SSOService) StartLogin(ctx context.Context, providerName string) (string, string, error) {
+	provider, err := s.provider(providerName)
+	if err != nil {
+		return "", "", err
+	}
+
+	login, err := sso.NewLoginState(provider.Name())
+	if err != nil {
+		return "", "", grpcstatus.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.states.Save(ctx, login, s.stateTTL); err != nil {
+		return "", "", grpcstatus.Error(codes.Unavailable, fmt.Sprintf("failed to start login: %v", err))
+	}
+
+	return provider.AuthCodeURL(login), login.State, nil
+}
+
+// CompleteLogin redeems the provider's authorization code and opens a session
+// for the linked user, provisioning an account on first login. Like a
+// password login, users with two-factor authentication enabled get a
+// challenge instead of tokens.
+func (s *SSOService) CompleteLogin(ctx context.Context, providerName, code, state string, client ClientInfo) (*LoginResult, error) {
+	if code == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "code is required")
+	}
+	if state == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "state is required")
+	}
+
+	provider, err := s.provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	login, err := s.states.Take(ctx, state)
+	if errors.Is(err, sso.ErrLoginStateNotFound) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "login state is invalid or has expired")
+	}
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unavailable, fmt.Sprintf("failed to load login state: %v", err))
+	}
+	if login.Provider != provider.Name() {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "login state was issued for another provider")
+	}
+
+	identity, err := provider.Exchange(ctx, code, login)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, fmt.Sprintf("single sign-on failed: %v", err))
+	}
+
+	user, err := s.resolveUser(ctx, provider.Name(), identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authService.completeFirstFactor(ctx, user, client)
+}
+
+// resolveUser returns the user linked to an external identity. Unknown
+// identities are linked to the account with the same email address if the
+// provider has verified it, or to a newly provisioned account otherwise.
+func (s *SSOService) resolveUser(ctx context.Context, providerName string, identity *sso.Identity) (*domain.User, error) {
+	if linked, err := s.identityRepo.GetByProviderSubject(ctx, providerName, identity.Subject); err == nil {
+		user, err := s.userRepo.GetByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, grpcstatus.Error(codes.NotFound, "user not found")
+		}
+		return user, nil
+	}
+
+	if identity.Email == "" {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "identity provider did not return an email address")
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, identity.Email)
+	if err == nil {
+		// Linking on an unverified address would let anyone who can register
+		// it at the provider take over the account
+		if !identity.EmailVerified {
+			return nil, grpcstatus.Error(codes.FailedPrecondition,
+				"an account with this email already exists; the provider must verify the address to link it")
+		}
+	} else {
+		user, err = s.provisionUser(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	link := domain.NewUserIdentity(user.ID, providerName, identity.Subject, identity.Email)
+	if err := s.identityRepo.Create(ctx, link); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to link identity: %v", err))
+	}
+
+	return user, nil
+}
+
+// provisionUser creates an account for a first-time single sign-on user. The
+// account has no password until the user sets one through a password reset.
+func (s *SSOService) provisionUser(ctx context.Context, identity *sso.Identity) (*domain.User, error) {
+	username, err := s.availableUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	user := domain.NewUser(identity.Email, username, "")
+	user.FullName = identity.Name
+	if identity.EmailVerified {
+		user.MarkEmailVerified()
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create user: %v", err))
+	}
+
+	return user, nil
+}
+
+// availableUsername derives an unused username from the identity
+func (s *SSOService) availableUsername(ctx context.Context, identity *sso.Identity) (string, error) {
+	base := sanitizeUsername(identity.PreferredUsername)
+	if base == "" {
+		base = sanitizeUsername(strings.SplitN(identity.Email, "@", 2)[0])
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	for i := 0; i < 100; i++ {
+		candidate := base
+		if i > 0 {
+			candidate = fmt.Sprintf("%s%d", base, i+1)
+		}
+
+		exists, err := s.userRepo.ExistsByUsername(ctx, candidate)
+		if err != nil {
+			return "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to check username: %v", err))
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+
+	return "", grpcstatus.Error(codes.AlreadyExists, "could not find an available username")
+}
+
+// provider looks up a configured provider by name
+func (s *SSOService) provider(name string) (*sso.Provider, error) {
+	provider, ok := s.providers[name]
+	if !ok {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("unknown single sign-on provider: %s", name))
+	}
+	return provider, nil
+}
+
+// sanitizeUsername keeps the characters of s that are safe in usernames
+func sanitizeUsername(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.' && r != '_' && r != '-' {
+			continue
+		}
+		if b.Len()+utf8.RuneLen(r) > maxUsernameLength {
+			break
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"github.com/venslupro/todo-api/internal/pkg/sso"
+	"github.com/venslupro/todo-api/internal/pkg/sso/ssotest"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockUserIdentityRepository is a mock implementation of UserIdentityRepository for testing
+type MockUserIdentityRepository struct {
+	identities map[string]*domain.UserIdentity
+}
+
+func NewMockUserIdentityRepository() *MockUserIdentityRepository {
+	return &MockUserIdentityRepository{
+		identities: make(map[string]*domain.UserIdentity),
+	}
+}
+
+func (m *MockUserIdentityRepository) Create(ctx context.Context, identity *domain.UserIdentity) error {
+	m.identities[identity.Provider+"|"+identity.Subject] = identity
+	return nil
+}
+
+func (m *MockUserIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	identity, ok := m.identities[provider+"|"+subject]
+	if !ok {
+		return nil, grpcstatus.Error(codes.NotFound, "identity not found")
+	}
+	return identity, nil
+}
+
+// MockSSOStateStore is an in-memory implementation of sso.StateStore for testing
+type MockSSOStateStore struct {
+	states map[string]*sso.LoginState
+}
+
+func (m *MockSSOStateStore) Save(ctx context.Context, login *sso.LoginState, ttl time.Duration) error {
+	m.states[login.State] = login
+	return nil
+}
+
+func (m *MockSSOStateStore) Take(ctx context.Context, state string) (*sso.LoginState, error) {
+	login, ok := m.states[state]
+	if !ok {
+		return nil, sso.ErrLoginStateNotFound
+	}
+	delete(m.states, state)
+	return login, nil
+}
+
+func newTestSSOService(t *testing.T) (*SSOService, *AuthService, *ssotest.Issuer) {
+	t.Helper()
+	issuer := ssotest.NewIssuer(t)
+	provider, err := sso.NewProvider(context.Background(), sso.ProviderConfig{
+		Name:         "corp",
+		IssuerURL:    issuer.URL,
+		ClientID:     issuer.ClientID,
+		ClientSecret: issuer.ClientSecret,
+		RedirectURL:  "https://app.example.com/sso/callback",
+	})
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+
+	authService := newTestAuthService(t, &auth.PasswordPolicy{MinLength: 8})
+	ssoService := NewSSOService(authService.userRepo, NewMockUserIdentityRepository(), authService,
+		&MockSSOStateStore{states: make(map[string]*sso.LoginState)}, 10*time.Minute, provider)
+	return ssoService, authService, issuer
+}
+
+// ssoLogin runs a complete login as the given provider user
+func ssoLogin(t *testing.T, s *SSOService, issuer *ssotest.Issuer, claims ssotest.Claims) (*LoginResult, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	authURL, state, err := s.StartLogin(ctx, "corp")
+	if err != nil {
+		t.Fatalf("StartLogin() error = %v", err)
+	}
+	code, returnedState, err := issuer.Authorize(authURL, claims)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if returnedState != state {
+		t.Fatalf("state = %v, want %v", returnedState, state)
+	}
+
+	return s.CompleteLogin(ctx, "corp", code, state, ClientInfo{})
+}
+
+func TestSSOService_ProvisionsAndReusesUser(t *testing.T) {
+	ssoService, authService, issuer := newTestSSOService(t)
+	claims := ssotest.Claims{
+		Subject:           "corp-123",
+		Email:             "jane@example.com",
+		EmailVerified:     true,
+		Name:              "Jane Doe",
+		PreferredUsername: "Jane.Doe",
+	}
+
+	result, err := ssoLogin(t, ssoService, issuer, claims)
+	if err != nil {
+		t.Fatalf("CompleteLogin() error = %v", err)
+	}
+	user, tokens := result.User, result.Tokens
+	if user.Username != "jane.doe" || user.FullName != "Jane Doe" || !user.EmailVerified {
+		t.Errorf("unexpected provisioned user: %+v", user)
+	}
+	if _, err := authService.ValidateToken(context.Background(), tokens.AccessToken); err != nil {
+		t.Errorf("expected a valid access token, got %v", err)
+	}
+
+	// The same identity signs in to the same account, even if its email changes
+	claims.Email = "jane.doe@example.com"
+	again, err := ssoLogin(t, ssoService, issuer, claims)
+	if err != nil {
+		t.Fatalf("CompleteLogin() error = %v", err)
+	}
+	if again.User.ID != user.ID {
+		t.Errorf("expected the linked user %s, got %s", user.ID, again.User.ID)
+	}
+}
+
+func TestSSOService_LinksExistingAccount(t *testing.T) {
+	tests := []struct {
+		name          string
+		emailVerified bool
+		wantCode      codes.Code
+	}{
+		{name: "verified email links account", emailVerified: true, wantCode: codes.OK},
+		{name: "unverified email is rejected", emailVerified: false, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssoService, authService, issuer := newTestSSOService(t)
+			existing, err := authService.Register(context.Background(), "jane@example.com", "jane", "password123", "")
+			if err != nil {
+				t.Fatalf("failed to register user: %v", err)
+			}
+
+			result, err := ssoLogin(t, ssoService, issuer, ssotest.Claims{
+				Subject:       "corp-123",
+				Email:         "jane@example.com",
+				EmailVerified: tt.emailVerified,
+			})
+			if grpcstatus.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err == nil && result.User.ID != existing.ID {
+				t.Errorf("expected existing user %s, got %s", existing.ID, result.User.ID)
+			}
+		})
+	}
+}
+
+func TestSSOService_ChallengesTwoFactorUser(t *testing.T) {
+	ssoService, authService, issuer := newTestSSOService(t)
+	existing, err := authService.Register(context.Background(), "jane@example.com", "jane", "password123", "")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	existing.TwoFactorEnabled = true
+
+	result, err := ssoLogin(t, ssoService, issuer, ssotest.Claims{
+		Subject:       "corp-123",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatalf("CompleteLogin() error = %v", err)
+	}
+	if result.Tokens != nil || result.Challenge == nil {
+		t.Fatal("expected a challenge instead of tokens")
+	}
+}
+
+func TestSSOService_RejectsInvalidState(t *testing.T) {
+	ctx := context.Background()
+	ssoService, _, issuer := newTestSSOService(t)
+
+	authURL, state, err := ssoService.StartLogin(ctx, "corp")
+	if err != nil {
+		t.Fatalf("StartLogin() error = %v", err)
+	}
+	code, _, err := issuer.Authorize(authURL, ssotest.Claims{Subject: "corp-123", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+
+	if _, err := ssoService.CompleteLogin(ctx, "corp", code, "forged", ClientInfo{}); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for unknown state, got %v", err)
+	}
+	if _, err := ssoService.CompleteLogin(ctx, "corp", code, state, ClientInfo{}); err != nil {
+		t.Fatalf("CompleteLogin() error = %v", err)
+	}
+	if _, err := ssoService.CompleteLogin(ctx, "corp", code, state, ClientInfo{}); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected state to be single-use, got %v", err)
+	}
+	if _, _, err := ssoService.StartLogin(ctx, "unknown"); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for unknown provider, got %v", err)
+	}
+}
@@ -2,33 +2,51 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"slices"
 	"time"
 
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
 	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/orderkey"
+	"github.com/venslupro/todo-api/internal/pkg/recurrence"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Depths of the subtask trees returned by GetTODOTree
+const (
+	defaultTreeDepth = 3
+	maxTreeDepth     = 10
+)
+
 // TODOService provides business logic for TODO operations
 type TODOService struct {
 	repo             domain.TODORepository
+	revisions        domain.TODORevisionRepository
+	dependencies     domain.TODODependencyRepository
 	websocketService *WebSocketService
+	permissions      *PermissionService
 }
 
 // NewTODOService creates a new TODO service
-func NewTODOService(repo domain.TODORepository, websocketService *WebSocketService) *TODOService {
+func NewTODOService(repo domain.TODORepository, revisions domain.TODORevisionRepository, dependencies domain.TODODependencyRepository, websocketService *WebSocketService, permissions *PermissionService) *TODOService {
 	return &TODOService{
 		repo:             repo,
+		revisions:        revisions,
+		dependencies:     dependencies,
 		websocketService: websocketService,
+		permissions:      permissions,
 	}
 }
 
-// CreateTODO creates a new TODO
-func (s *TODOService) CreateTODO(ctx context.Context, userID, title string, description *string, status *commonv1.Status, priority *commonv1.Priority, dueDate *time.Time, tags []string, assignedTo, parentID *string) (*domain.TODO, error) {
+// CreateTODO creates a new TODO. A TODO with a recurrence rule must have a
+// due date, which is its first occurrence.
+func (s *TODOService) CreateTODO(ctx context.Context, userID, title string, description *string, status *commonv1.Status, priority *commonv1.Priority, dueDate *time.Time, tags []string, assignedTo, parentID, recurrenceRule *string) (*domain.TODO, error) {
 	// Validate title
 	if title == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "title is required")
@@ -70,10 +88,24 @@ func (s *TODOService) CreateTODO(ctx context.Context, userID, title string, desc
 		}
 		todo.ParentID = parentID
 	}
+	if recurrenceRule != nil {
+		rule, err := normalizeRecurrenceRule(*recurrenceRule, todo.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		todo.RecurrenceRule = rule
+	}
+	orderKey, err := s.appendOrderKey(ctx, userID, todo.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	todo.OrderKey = orderKey
+
 	// Save TODO
 	if err := s.repo.Create(ctx, todo); err != nil {
 		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create TODO: %v", err))
 	}
+	s.recordRevision(ctx, userID, domain.RevisionActionCreated, nil, todo)
 
 	// Broadcast WebSocket notification
 	if s.websocketService != nil {
@@ -97,8 +129,12 @@ func (s *TODOService) GetTODO(ctx context.Context, id string) (*domain.TODO, err
 	return todo, nil
 }
 
-// UpdateTODO updates an existing TODO
-func (s *TODOService) UpdateTODO(ctx context.Context, id string, title, description *string, status *commonv1.Status, priority *commonv1.Priority, dueDate *time.Time, tags []string, assignedTo, parentID *string, position *int32) (*domain.TODO, error) {
+// UpdateTODO updates an existing TODO on behalf of userID. Setting an empty
+// recurrence rule ends the series; a TODO that keeps recurring must be
+// completed with CompleteTODO. A TODO with unfinished blockers cannot be
+// started or completed unless force is set. A non-zero expectedVersion must
+// be the TODO's current version.
+func (s *TODOService) UpdateTODO(ctx context.Context, userID, id string, title, description *string, status *commonv1.Status, priority *commonv1.Priority, dueDate *time.Time, tags []string, assignedTo, parentID *string, position *int32, recurrenceRule *string, force bool, expectedVersion int64) (*domain.TODO, error) {
 	if id == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
 	}
@@ -108,27 +144,52 @@ func (s *TODOService) UpdateTODO(ctx context.Context, id string, title, descript
 	if err != nil {
 		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
 	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
 
 	// Validate parent if provided
 	if parentID != nil && *parentID != "" {
-		if *parentID == id {
-			return nil, grpcstatus.Error(codes.InvalidArgument, "todo cannot be its own parent")
+		if err := s.validateParent(ctx, id, *parentID); err != nil {
+			return nil, err
 		}
-		parentExists, err := s.repo.Exists(ctx, *parentID)
-		if err != nil {
-			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to validate parent: %v", err))
+	}
+
+	if status != nil {
+		recurs := todo.IsRecurring()
+		if recurrenceRule != nil {
+			recurs = *recurrenceRule != ""
 		}
-		if !parentExists {
-			return nil, grpcstatus.Error(codes.NotFound, "parent todo not found")
+		if err := checkCompletion(todo, *status, recurs); err != nil {
+			return nil, err
+		}
+		if !force {
+			if err := s.checkBlockers(ctx, todo, *status); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	// Update TODO
+	previous := todo.Snapshot()
+	if parentID != nil && !sameParent(todo.ParentID, parentID) {
+		if todo.OrderKey, err = s.appendOrderKey(ctx, todo.UserID, parentID); err != nil {
+			return nil, err
+		}
+	}
 	todo.Update(title, description, status, priority, dueDate, tags, assignedTo, parentID, position)
+	if recurrenceRule != nil {
+		rule, err := normalizeRecurrenceRule(*recurrenceRule, todo.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		todo.RecurrenceRule = rule
+	}
 
 	if err := s.repo.Update(ctx, todo); err != nil {
-		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to update todo: %v", err))
+		return nil, saveError(err, "failed to update todo")
 	}
+	s.recordRevision(ctx, userID, domain.RevisionActionUpdated, &previous, todo)
 
 	// Broadcast WebSocket notification
 	if s.websocketService != nil {
@@ -138,7 +199,92 @@ func (s *TODOService) UpdateTODO(ctx context.Context, id string, title, descript
 	return todo, nil
 }
 
-// DeleteTODO deletes a TODO by ID
+// PatchTODO sets the fields of a TODO named in paths to their values in
+// values; see domain.PatchableFields. Unlike UpdateTODO, a field unset in
+// values is cleared rather than left unchanged. As with UpdateTODO, a TODO
+// that keeps recurring cannot be completed. A TODO with unfinished blockers
+// cannot be started or completed unless force is set. A non-zero
+// expectedVersion must be the TODO's current version.
+func (s *TODOService) PatchTODO(ctx context.Context, userID, id string, values *domain.TODO, paths []string, force bool, expectedVersion int64) (*domain.TODO, error) {
+	if id == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
+	}
+	if len(paths) == 0 {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "update_mask must name at least one field")
+	}
+	if err := domain.ValidatePatchPaths(paths); err != nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	masked := func(field string) bool { return slices.Contains(paths, field) }
+
+	if masked("title") && values.Title == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "title cannot be cleared")
+	}
+	if masked("status") && values.Status == commonv1.Status_STATUS_UNSPECIFIED {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "status cannot be cleared")
+	}
+	if masked("priority") && values.Priority == commonv1.Priority_PRIORITY_UNSPECIFIED {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "priority cannot be cleared")
+	}
+
+	todo, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
+	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if masked("parent_id") && values.ParentID != nil && *values.ParentID != "" {
+		if err := s.validateParent(ctx, id, *values.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if masked("status") {
+		recurs := todo.IsRecurring()
+		if masked("recurrence_rule") {
+			recurs = values.RecurrenceRule != ""
+		}
+		if err := checkCompletion(todo, values.Status, recurs); err != nil {
+			return nil, err
+		}
+		if !force {
+			if err := s.checkBlockers(ctx, todo, values.Status); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	previous := todo.Snapshot()
+	if masked("parent_id") && !sameParent(todo.ParentID, values.ParentID) {
+		if todo.OrderKey, err = s.appendOrderKey(ctx, todo.UserID, values.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	todo.Patch(values, paths)
+	if masked("recurrence_rule") || masked("due_date") {
+		rule, err := normalizeRecurrenceRule(todo.RecurrenceRule, todo.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		todo.RecurrenceRule = rule
+	}
+
+	if err := s.repo.Update(ctx, todo); err != nil {
+		return nil, saveError(err, "failed to update todo")
+	}
+	s.recordRevision(ctx, userID, domain.RevisionActionUpdated, &previous, todo)
+
+	// Broadcast WebSocket notification
+	if s.websocketService != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, todo, "updated")
+	}
+
+	return todo, nil
+}
+
+// DeleteTODO moves a TODO and its subtasks to the trash
 func (s *TODOService) DeleteTODO(ctx context.Context, id string) error {
 	if id == "" {
 		return grpcstatus.Error(codes.InvalidArgument, "id is required")
@@ -179,34 +325,204 @@ func (s *TODOService) ListTODOs(ctx context.Context, filter domain.TODOFilter, s
 	return todos, pagination, nil
 }
 
-// BulkUpdateStatus updates status for multiple TODOs
-func (s *TODOService) BulkUpdateStatus(ctx context.Context, ids []string, status commonv1.Status) error {
-	if len(ids) == 0 {
-		return grpcstatus.Error(codes.InvalidArgument, "ids are required")
+// CompleteTODO marks a TODO as completed. Completing an occurrence of a
+// recurring TODO creates the next occurrence, with a copy of its subtasks,
+// and returns it as well; the recurrence rule moves to the next occurrence.
+// next is nil if the TODO does not recur or the series has ended. A TODO
+// with unfinished blockers cannot be completed unless force is set. A
+// non-zero expectedVersion must be the TODO's current version.
+func (s *TODOService) CompleteTODO(ctx context.Context, userID, id string, force bool, expectedVersion int64) (completed, next *domain.TODO, err error) {
+	if id == "" {
+		return nil, nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
 	}
 
-	if err := s.repo.BulkUpdateStatus(ctx, ids, status); err != nil {
-		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to bulk update status: %v", err))
+	todo, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
+	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	if !force {
+		if err := s.checkBlockers(ctx, todo, commonv1.Status_STATUS_COMPLETED); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	previous := todo.Snapshot()
+	var created []*domain.TODO
+	if todo.IsRecurring() && !todo.IsCompleted() {
+		if dueDate, ok := s.nextDueDate(todo); ok {
+			next = todo.NextOccurrence(dueDate)
+			subtasks, err := s.copySubtasks(ctx, todo.ID, next.ID, dueDate.Sub(*todo.DueDate))
+			if err != nil {
+				return nil, nil, err
+			}
+			created = append([]*domain.TODO{next}, subtasks...)
+		}
+		todo.RecurrenceRule = ""
+	}
+
+	todo.Complete()
+
+	// The next occurrence is only created if the TODO is still at the
+	// version it was completed from, so that a series is never continued
+	// twice
+	if created != nil {
+		err = s.repo.CompleteOccurrence(ctx, todo, created)
+	} else {
+		err = s.repo.Update(ctx, todo)
+	}
+	if err != nil {
+		return nil, nil, saveError(err, "failed to complete todo")
+	}
+	for _, dup := range created {
+		s.recordRevision(ctx, userID, domain.RevisionActionCreated, nil, dup)
+	}
+	s.recordRevision(ctx, userID, domain.RevisionActionCompleted, &previous, todo)
+
+	if s.websocketService != nil && next != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, next, "created")
+	}
+
+	return todo, next, nil
 }
 
-// BulkDelete deletes multiple TODOs
-func (s *TODOService) BulkDelete(ctx context.Context, ids []string) error {
-	if len(ids) == 0 {
-		return grpcstatus.Error(codes.InvalidArgument, "ids are required")
+// SkipOccurrence moves a recurring TODO on to its next occurrence without
+// completing it. The last occurrence of a series cannot be skipped.
+func (s *TODOService) SkipOccurrence(ctx context.Context, userID, id string) (*domain.TODO, error) {
+	todo, err := s.getRecurringTODO(ctx, id)
+	if err != nil {
+		return nil, err
 	}
+	previous := todo.Snapshot()
 
-	if err := s.repo.BulkDelete(ctx, ids); err != nil {
-		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to bulk delete: %v", err))
+	dueDate, ok := s.nextDueDate(todo)
+	if !ok {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "this is the last occurrence of the series")
 	}
+	todo.DueDate = &dueDate
+	todo.Occurrence++
+	todo.UpdatedAt = time.Now()
 
-	return nil
+	if err := s.repo.Update(ctx, todo); err != nil {
+		return nil, saveError(err, "failed to skip occurrence")
+	}
+	s.recordRevision(ctx, userID, domain.RevisionActionOccurrenceSkipped, &previous, todo)
+
+	if s.websocketService != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, todo, "updated")
+	}
+
+	return todo, nil
+}
+
+// EndRecurrence ends the series of a recurring TODO. The TODO itself is
+// kept as its last occurrence.
+func (s *TODOService) EndRecurrence(ctx context.Context, userID, id string) (*domain.TODO, error) {
+	todo, err := s.getRecurringTODO(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	previous := todo.Snapshot()
+
+	todo.RecurrenceRule = ""
+	todo.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, todo); err != nil {
+		return nil, saveError(err, "failed to end recurrence")
+	}
+	s.recordRevision(ctx, userID, domain.RevisionActionRecurrenceEnded, &previous, todo)
+
+	if s.websocketService != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, todo, "updated")
+	}
+
+	return todo, nil
+}
+
+// getRecurringTODO retrieves the open occurrence of a recurring TODO
+func (s *TODOService) getRecurringTODO(ctx context.Context, id string) (*domain.TODO, error) {
+	todo, err := s.GetTODO(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !todo.IsRecurring() {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "todo does not recur")
+	}
+	return todo, nil
+}
+
+// nextDueDate returns the due date of the occurrence that follows a
+// recurring TODO, or false if the series ends with it
+func (s *TODOService) nextDueDate(todo *domain.TODO) (time.Time, bool) {
+	if todo.DueDate == nil {
+		return time.Time{}, false
+	}
+	rule, err := recurrence.Parse(todo.RecurrenceRule)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return rule.Next(*todo.DueDate, int(todo.Occurrence))
+}
+
+// copySubtasks returns copies of the subtasks of a TODO, recursively, under
+// another TODO, parents before their subtasks. The copies have not been
+// started yet, do not recur and are due shift later than the originals.
+func (s *TODOService) copySubtasks(ctx context.Context, fromID, toID string, shift time.Duration) ([]*domain.TODO, error) {
+	var copies []*domain.TODO
+	filter := domain.TODOFilter{ParentID: &fromID}
+	for page := int32(1); ; page++ {
+		subtasks, pagination, err := s.repo.List(ctx, domain.TODOListOptions{Filter: filter, Page: page, PageSize: 100})
+		if err != nil {
+			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list subtasks: %v", err))
+		}
+
+		for _, subtask := range subtasks {
+			dup := subtask.Duplicate()
+			dup.ParentID = &toID
+			dup.RecurrenceRule = ""
+			dup.Occurrence = 1
+			if subtask.DueDate != nil {
+				dueDate := subtask.DueDate.Add(shift)
+				dup.DueDate = &dueDate
+			}
+			nested, err := s.copySubtasks(ctx, subtask.ID, dup.ID, shift)
+			if err != nil {
+				return nil, err
+			}
+			copies = append(copies, dup)
+			copies = append(copies, nested...)
+		}
+
+		if !pagination.HasNext {
+			return copies, nil
+		}
+	}
 }
 
-// CompleteTODO marks a TODO as completed
-func (s *TODOService) CompleteTODO(ctx context.Context, id string) (*domain.TODO, error) {
+// normalizeRecurrenceRule validates a recurrence rule and returns it in its
+// canonical form; an empty rule means the TODO does not recur
+func normalizeRecurrenceRule(rule string, dueDate *time.Time) (string, error) {
+	if rule == "" {
+		return "", nil
+	}
+
+	parsed, err := recurrence.Parse(rule)
+	if err != nil {
+		return "", grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	if dueDate == nil {
+		return "", grpcstatus.Error(codes.InvalidArgument, "recurring todos require a due date")
+	}
+
+	return parsed.String(), nil
+}
+
+// ReopenTODO reopens a completed TODO. A non-zero expectedVersion must be
+// the TODO's current version.
+func (s *TODOService) ReopenTODO(ctx context.Context, userID, id string, expectedVersion int64) (*domain.TODO, error) {
 	if id == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
 	}
@@ -215,18 +531,28 @@ func (s *TODOService) CompleteTODO(ctx context.Context, id string) (*domain.TODO
 	if err != nil {
 		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
 	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
 
-	todo.Complete()
+	if !todo.IsCompleted() {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "todo is not completed")
+	}
+
+	previous := todo.Snapshot()
+	todo.Reopen()
 
 	if err := s.repo.Update(ctx, todo); err != nil {
-		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to complete todo: %v", err))
+		return nil, saveError(err, "failed to reopen todo")
 	}
+	s.recordRevision(ctx, userID, domain.RevisionActionReopened, &previous, todo)
 
 	return todo, nil
 }
 
-// ReopenTODO reopens a completed TODO
-func (s *TODOService) ReopenTODO(ctx context.Context, id string) (*domain.TODO, error) {
+// MoveTODO moves a TODO to a new position or parent. A non-zero
+// expectedVersion must be the TODO's current version.
+func (s *TODOService) MoveTODO(ctx context.Context, userID, id string, parentID *string, position *int32, expectedVersion int64) (*domain.TODO, error) {
 	if id == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
 	}
@@ -235,64 +561,532 @@ func (s *TODOService) ReopenTODO(ctx context.Context, id string) (*domain.TODO,
 	if err != nil {
 		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
 	}
+	if err := checkVersion(todo, expectedVersion); err != nil {
+		return nil, err
+	}
 
-	if !todo.IsCompleted() {
-		return nil, grpcstatus.Error(codes.FailedPrecondition, "todo is not completed")
+	if parentID != nil && *parentID != "" {
+		if err := s.validateParent(ctx, id, *parentID); err != nil {
+			return nil, err
+		}
 	}
 
-	todo.Reopen()
+	previous := todo.Snapshot()
+	if parentID != nil && !sameParent(todo.ParentID, parentID) {
+		if todo.OrderKey, err = s.appendOrderKey(ctx, todo.UserID, parentID); err != nil {
+			return nil, err
+		}
+	}
+	todo.Update(nil, nil, nil, nil, nil, nil, nil, parentID, position)
 
 	if err := s.repo.Update(ctx, todo); err != nil {
-		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to reopen todo: %v", err))
+		return nil, saveError(err, "failed to move todo")
 	}
+	s.recordRevision(ctx, userID, domain.RevisionActionMoved, &previous, todo)
 
 	return todo, nil
 }
 
-// MoveTODO moves a TODO to a new position or parent
-func (s *TODOService) MoveTODO(ctx context.Context, id string, parentID *string, position *int32) (*domain.TODO, error) {
+// ReorderTODO places a TODO on behalf of userID just before or just after an
+// anchor TODO, moving it into the anchor's list if needed. Exactly one of
+// beforeID and afterID must be set. The TODO gets an order key between the
+// anchor and its neighbour, so no other TODO is renumbered unless the keys
+// have grown too long and the list is rebalanced.
+func (s *TODOService) ReorderTODO(ctx context.Context, userID, id, beforeID, afterID string) (*domain.TODO, error) {
 	if id == "" {
 		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
 	}
+	if (beforeID == "") == (afterID == "") {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "exactly one of before_id and after_id is required")
+	}
+	anchorID, before := afterID, false
+	if beforeID != "" {
+		anchorID, before = beforeID, true
+	}
+	if anchorID == id {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "todo cannot be placed next to itself")
+	}
 
 	todo, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
 	}
+	anchor, err := s.repo.GetByID(ctx, anchorID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("anchor todo not found: %v", err))
+	}
 
-	if parentID != nil && *parentID != "" {
-		if *parentID == id {
-			return nil, grpcstatus.Error(codes.InvalidArgument, "todo cannot be its own parent")
+	if anchor.ParentID == nil || *anchor.ParentID == "" {
+		if anchor.UserID != todo.UserID {
+			return nil, grpcstatus.Error(codes.InvalidArgument, "todo cannot be placed among another user's todos")
+		}
+	} else if !sameParent(todo.ParentID, anchor.ParentID) {
+		if err := s.validateParent(ctx, id, *anchor.ParentID); err != nil {
+			return nil, err
 		}
+	}
+
+	siblings, err := s.repo.ListSiblings(ctx, todo.UserID, anchor.ParentID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list siblings: %v", err))
+	}
+	siblings = withoutTODO(siblings, id)
+
+	key, err := orderKeyNextTo(siblings, anchorID, before)
+	if err != nil || len(key) > orderkey.MaxLength {
+		// Keys are missing, duplicated by concurrent reorders or too long
+		if err := s.rebalanceOrderKeys(ctx, siblings); err != nil {
+			return nil, err
+		}
+		if key, err = orderKeyNextTo(siblings, anchorID, before); err != nil {
+			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to place todo: %v", err))
+		}
+	}
+
+	previous := todo.Snapshot()
+	todo.ParentID = anchor.ParentID
+	todo.OrderKey = key
+	todo.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, todo); err != nil {
+		return nil, saveError(err, "failed to reorder todo")
+	}
+	s.recordRevision(ctx, userID, domain.RevisionActionMoved, &previous, todo)
+
+	if s.websocketService != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, todo, "updated")
+	}
+
+	return todo, nil
+}
+
+// appendOrderKey returns an order key that places a TODO at the end of the
+// list of parentID's subtasks, or of userID's top-level TODOs
+func (s *TODOService) appendOrderKey(ctx context.Context, userID string, parentID *string) (string, error) {
+	last, err := s.repo.LastOrderKey(ctx, userID, parentID)
+	if err != nil {
+		return "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to get order key: %v", err))
+	}
+
+	key, err := orderkey.Between(last, "")
+	if err == nil && len(key) <= orderkey.MaxLength {
+		return key, nil
+	}
+
+	siblings, err := s.repo.ListSiblings(ctx, userID, parentID)
+	if err != nil {
+		return "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list siblings: %v", err))
+	}
+	if err := s.rebalanceOrderKeys(ctx, siblings); err != nil {
+		return "", err
+	}
+	return orderKeyAfter(siblings), nil
+}
+
+// rebalanceOrderKeys gives the TODOs in a list evenly spaced order keys,
+// keeping their order
+func (s *TODOService) rebalanceOrderKeys(ctx context.Context, siblings []*domain.TODO) error {
+	keys := make(map[string]string, len(siblings))
+	for i, key := range orderkey.Spread(len(siblings)) {
+		siblings[i].OrderKey = key
+		keys[siblings[i].ID] = key
+	}
+
+	if err := s.repo.SetOrderKeys(ctx, keys); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to rebalance order keys: %v", err))
+	}
+	return nil
+}
+
+// orderKeyNextTo returns an order key between the anchor and its neighbour
+// in siblings, on the side given by before. Either lacking a key is an
+// error, since an empty bound would mean the start or end of the list.
+func orderKeyNextTo(siblings []*domain.TODO, anchorID string, before bool) (string, error) {
+	for i, sibling := range siblings {
+		if sibling.ID != anchorID {
+			continue
+		}
+
+		lower, upper := sibling.OrderKey, ""
+		neighbour := i + 1
+		if before {
+			lower, upper = "", sibling.OrderKey
+			neighbour = i - 1
+		}
+		if neighbour >= 0 && neighbour < len(siblings) {
+			if before {
+				lower = siblings[neighbour].OrderKey
+			} else {
+				upper = siblings[neighbour].OrderKey
+			}
+			if siblings[neighbour].OrderKey == "" {
+				return "", orderkey.ErrInvalidKey
+			}
+		}
+		if sibling.OrderKey == "" {
+			return "", orderkey.ErrInvalidKey
+		}
+		return orderkey.Between(lower, upper)
+	}
+	return "", fmt.Errorf("anchor %s is not in the list", anchorID)
+}
+
+// orderKeyAfter returns an order key after every TODO in a rebalanced list
+func orderKeyAfter(siblings []*domain.TODO) string {
+	last := ""
+	if len(siblings) > 0 {
+		last = siblings[len(siblings)-1].OrderKey
+	}
+	key, _ := orderkey.Between(last, "")
+	return key
+}
+
+// withoutTODO returns todos without the TODO with the given ID
+func withoutTODO(todos []*domain.TODO, id string) []*domain.TODO {
+	result := make([]*domain.TODO, 0, len(todos))
+	for _, todo := range todos {
+		if todo.ID != id {
+			result = append(result, todo)
+		}
+	}
+	return result
+}
+
+// sameParent reports whether two parent IDs refer to the same list, treating
+// nil and empty as the top level
+func sameParent(a, b *string) bool {
+	return optionalID(a) == optionalID(b)
+}
+
+// optionalID returns the value of id, or an empty string if id is nil
+func optionalID(id *string) string {
+	if id == nil {
+		return ""
+	}
+	return *id
+}
+
+// checkVersion returns an ABORTED error if expectedVersion is set and is not
+// the TODO's current version
+func checkVersion(todo *domain.TODO, expectedVersion int64) error {
+	if expectedVersion != 0 && expectedVersion != todo.Version {
+		return versionConflictError(fmt.Sprintf("todo is at version %d, not %d", todo.Version, expectedVersion))
+	}
+	return nil
+}
+
+// saveError converts an error from saving a TODO to a gRPC error. A TODO
+// changed since it was read is reported as a version conflict.
+func saveError(err error, message string) error {
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return versionConflictError("todo was modified concurrently; reload it and retry")
+	}
+	return grpcstatus.Error(codes.Internal, fmt.Sprintf("%s: %v", message, err))
+}
+
+// versionConflictError returns an ABORTED error carrying the
+// ERROR_CODE_RESOURCE_CONFLICT error code, which the gateway maps to HTTP 412
+func versionConflictError(message string) error {
+	st := grpcstatus.New(codes.Aborted, message)
+	detailed, err := st.WithDetails(&commonv1.Error{
+		Code:    commonv1.ErrorCode_ERROR_CODE_RESOURCE_CONFLICT,
+		Message: message,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// validateParent checks that parentID exists and is neither the TODO id
+// itself nor one of its subtasks, which would make the TODO its own ancestor
+func (s *TODOService) validateParent(ctx context.Context, id, parentID string) error {
+	if parentID == id {
+		return grpcstatus.Error(codes.InvalidArgument, "todo cannot be its own parent")
+	}
+
+	parentExists, err := s.repo.Exists(ctx, parentID)
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to validate parent: %v", err))
+	}
+	if !parentExists {
+		return grpcstatus.Error(codes.NotFound, "parent todo not found")
+	}
+
+	descendant, err := s.repo.IsDescendant(ctx, parentID, id)
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to validate parent: %v", err))
+	}
+	if descendant {
+		return grpcstatus.Error(codes.InvalidArgument, "todo cannot be moved under one of its own subtasks")
+	}
+
+	return nil
+}
+
+// GetTODOTree retrieves a TODO with its subtasks down to depth levels below
+// it, together with the progress of each TODO's subtasks
+func (s *TODOService) GetTODOTree(ctx context.Context, id string, depth int32) (*domain.TODOTreeNode, error) {
+	if id == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
+	}
+	if depth < 1 {
+		depth = defaultTreeDepth
+	}
+	if depth > maxTreeDepth {
+		depth = maxTreeDepth
+	}
+
+	tree, err := s.repo.GetTree(ctx, id, depth)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found: %v", err))
+	}
+
+	return tree, nil
+}
+
+// AddDependency records on behalf of userID that a TODO is blocked by
+// another TODO. The user must be able to edit the blocked TODO and view the
+// blocking one. Dependencies that would make a TODO block itself, directly or
+// through other TODOs, are rejected.
+func (s *TODOService) AddDependency(ctx context.Context, userID, id, blockedByID string) error {
+	if id == "" || blockedByID == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "id and blocked_by_id are required")
+	}
+	if id == blockedByID {
+		return grpcstatus.Error(codes.InvalidArgument, "todo cannot be blocked by itself")
+	}
+
+	if err := s.permissions.CanEditTODO(ctx, userID, id); err != nil {
+		return err
+	}
+	if err := s.permissions.CanViewTODO(ctx, userID, blockedByID); err != nil {
+		return err
+	}
+
+	err := s.dependencies.Add(ctx, domain.NewTODODependency(id, blockedByID, userID))
+	if errors.Is(err, domain.ErrDependencyCycle) {
+		return grpcstatus.Error(codes.FailedPrecondition, "dependency would create a cycle")
+	}
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to add dependency: %v", err))
+	}
+
+	return nil
+}
+
+// RemoveDependency removes on behalf of userID the dependency of a TODO on
+// another TODO. The user must be able to edit the blocked TODO.
+func (s *TODOService) RemoveDependency(ctx context.Context, userID, id, blockedByID string) error {
+	if id == "" || blockedByID == "" {
+		return grpcstatus.Error(codes.InvalidArgument, "id and blocked_by_id are required")
+	}
+	if err := s.permissions.CanEditTODO(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.dependencies.Remove(ctx, id, blockedByID); err != nil {
+		return grpcstatus.Error(codes.NotFound, fmt.Sprintf("dependency not found: %v", err))
+	}
+
+	return nil
+}
+
+// ListDependencies retrieves the TODOs that a TODO is blocked by and the
+// TODOs that it blocks, leaving out those userID cannot view
+func (s *TODOService) ListDependencies(ctx context.Context, userID, id string) (blockedBy, blocking []*domain.TODO, err error) {
+	if err := s.permissions.CanViewTODO(ctx, userID, id); err != nil {
+		return nil, nil, err
+	}
+
+	blockedBy, err = s.dependencies.ListBlockers(ctx, id)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list blockers: %v", err))
+	}
+	blocking, err = s.dependencies.ListBlocked(ctx, id)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list blocked todos: %v", err))
+	}
+
+	if blockedBy, err = s.visibleTODOs(ctx, userID, blockedBy); err != nil {
+		return nil, nil, err
+	}
+	if blocking, err = s.visibleTODOs(ctx, userID, blocking); err != nil {
+		return nil, nil, err
+	}
+	return blockedBy, blocking, nil
+}
+
+// visibleTODOs returns the TODOs that userID can view
+func (s *TODOService) visibleTODOs(ctx context.Context, userID string, todos []*domain.TODO) ([]*domain.TODO, error) {
+	visible := make([]*domain.TODO, 0, len(todos))
+	for _, todo := range todos {
+		err := s.permissions.CanViewTODO(ctx, userID, todo.ID)
+		switch grpcstatus.Code(err) {
+		case codes.OK:
+			visible = append(visible, todo)
+		case codes.PermissionDenied, codes.NotFound:
+		default:
+			return nil, err
+		}
+	}
+	return visible, nil
+}
+
+// checkCompletion returns a FAILED_PRECONDITION error if setting a TODO's
+// status would complete it while it recurs. Only CompleteTODO may complete
+// an occurrence of a series, since it creates the next occurrence.
+func checkCompletion(todo *domain.TODO, status commonv1.Status, recurs bool) error {
+	if status == commonv1.Status_STATUS_COMPLETED && !todo.IsCompleted() && recurs {
+		return grpcstatus.Error(codes.FailedPrecondition, "recurring todos must be completed with CompleteTODO, which creates the next occurrence")
+	}
+	return nil
+}
+
+// checkBlockers returns an error if moving a TODO to status would start or
+// complete it while it has unfinished blockers
+func (s *TODOService) checkBlockers(ctx context.Context, todo *domain.TODO, status commonv1.Status) error {
+	if s.dependencies == nil || status == todo.Status || !domain.StartsWork(status) {
+		return nil
+	}
+
+	count, err := s.dependencies.CountUnfinishedBlockers(ctx, todo.ID)
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to check blockers: %v", err))
+	}
+	if count > 0 {
+		return grpcstatus.Error(codes.FailedPrecondition,
+			fmt.Sprintf("todo is blocked by %d unfinished todos; set force to override", count))
+	}
+
+	return nil
+}
+
+// ListRevisions retrieves the revision history of a TODO, newest first
+func (s *TODOService) ListRevisions(ctx context.Context, id string, page, pageSize int32) ([]*domain.TODORevision, *domain.PaginationResult, error) {
+	if _, err := s.GetTODO(ctx, id); err != nil {
+		return nil, nil, err
+	}
+
+	revisions, pagination, err := s.revisions.List(ctx, id, page, pageSize)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list revisions: %v", err))
+	}
+
+	return revisions, pagination, nil
+}
+
+// DiffRevisions returns the fields of a TODO that changed between two of its
+// revisions
+func (s *TODOService) DiffRevisions(ctx context.Context, id string, fromRevision, toRevision int32) ([]domain.FieldChange, error) {
+	from, err := s.getRevision(ctx, id, fromRevision)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getRevision(ctx, id, toRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffSnapshots(from.Snapshot, to.Snapshot), nil
+}
+
+// RestoreRevision sets a TODO back to the state of an earlier revision on
+// behalf of userID. The restore is recorded as a new revision, so it can be
+// undone in turn. The recurrence of the TODO is not restored.
+func (s *TODOService) RestoreRevision(ctx context.Context, userID, id string, revision int32) (*domain.TODO, error) {
+	todo, err := s.GetTODO(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.getRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID := rev.Snapshot.ParentID; parentID != nil && *parentID != "" {
 		parentExists, err := s.repo.Exists(ctx, *parentID)
 		if err != nil {
 			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to validate parent: %v", err))
 		}
 		if !parentExists {
-			return nil, grpcstatus.Error(codes.NotFound, "parent todo not found")
+			return nil, grpcstatus.Error(codes.FailedPrecondition, "the parent of this revision no longer exists")
+		}
+		descendant, err := s.repo.IsDescendant(ctx, *parentID, id)
+		if err != nil {
+			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to validate parent: %v", err))
+		}
+		if descendant {
+			return nil, grpcstatus.Error(codes.FailedPrecondition, "the parent of this revision is now one of the todo's subtasks")
 		}
 	}
 
-	todo.Update(nil, nil, nil, nil, nil, nil, nil, parentID, position)
+	previous := todo.Snapshot()
+	todo.Restore(rev.Snapshot)
 
 	if err := s.repo.Update(ctx, todo); err != nil {
-		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to move todo: %v", err))
+		return nil, saveError(err, "failed to restore todo")
+	}
+	s.recordRevision(ctx, userID, domain.RevisionActionRestored, &previous, todo)
+
+	if s.websocketService != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, todo, "updated")
 	}
 
 	return todo, nil
 }
 
+// getRevision retrieves a revision of a TODO
+func (s *TODOService) getRevision(ctx context.Context, id string, revision int32) (*domain.TODORevision, error) {
+	if id == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
+	}
+	if revision < 1 {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "revision must be positive")
+	}
+
+	rev, err := s.revisions.Get(ctx, id, revision)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("revision %d not found", revision))
+	}
+
+	return rev, nil
+}
+
+// recordRevision records a change that userID made to a TODO. previous is
+// the state before the change, or nil if the TODO was created. Changes that
+// leave the TODO as it was are not recorded. The change has already been
+// saved, so a revision that cannot be stored is only logged.
+func (s *TODOService) recordRevision(ctx context.Context, userID, action string, previous *domain.TODOSnapshot, todo *domain.TODO) {
+	if s.revisions == nil {
+		return
+	}
+
+	revision := domain.NewTODORevision(todo, userID, action, previous)
+	if previous != nil && len(revision.ChangedFields) == 0 {
+		return
+	}
+
+	if err := s.revisions.Append(ctx, revision); err != nil {
+		log.Printf("Failed to record revision of todo %s: %v", todo.ID, err)
+	}
+}
+
 // ConvertToProto converts a domain TODO to proto TODO
 func ConvertToProto(todo *domain.TODO) *todov1.TODO {
 	pb := &todov1.TODO{
-		Id:          todo.ID,
-		UserId:      todo.UserID,
-		Title:       todo.Title,
-		Description: todo.Description,
-		Status:      todo.Status,
-		Priority:    todo.Priority,
-		Tags:        todo.Tags,
-		Position:    todo.Position,
+		Id:             todo.ID,
+		UserId:         todo.UserID,
+		Title:          todo.Title,
+		Description:    todo.Description,
+		Status:         todo.Status,
+		Priority:       todo.Priority,
+		Tags:           todo.Tags,
+		Position:       todo.Position,
+		OrderKey:       todo.OrderKey,
+		RecurrenceRule: todo.RecurrenceRule,
+		Occurrence:     todo.Occurrence,
+		Version:        todo.Version,
 	}
 
 	if todo.DueDate != nil {
@@ -307,6 +1101,9 @@ func ConvertToProto(todo *domain.TODO) *todov1.TODO {
 	if todo.CompletedAt != nil {
 		pb.CompletedAt = timestamppb.New(*todo.CompletedAt)
 	}
+	if todo.DeletedAt != nil {
+		pb.DeletedAt = timestamppb.New(*todo.DeletedAt)
+	}
 	if todo.AssignedTo != nil {
 		pb.AssignedTo = *todo.AssignedTo
 	}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"testing"
 	"time"
 
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 // WebSocketServiceInterface defines the interface for WebSocket service
@@ -23,7 +26,11 @@ type WebSocketServiceInterface interface {
 
 // MockRepository is a mock implementation of TODORepository for testing
 type MockRepository struct {
-	todos map[string]*domain.TODO
+	todos     map[string]*domain.TODO
+	trash     map[string]*domain.TODO
+	mediaURLs map[string][]string // todoID -> file URLs
+	versions  map[string]int64    // todoID -> version last saved
+	shared    map[string][]string // todoID -> teamIDs
 }
 
 // MockWebSocketService is a mock implementation of WebSocketService for testing
@@ -64,14 +71,134 @@ func NewTODOServiceForTest(repo domain.TODORepository, websocketService WebSocke
 	}
 }
 
+// MockTODORevisionRepository is a mock implementation of TODORevisionRepository for testing
+type MockTODORevisionRepository struct {
+	revisions map[string][]*domain.TODORevision
+}
+
+func NewMockTODORevisionRepository() *MockTODORevisionRepository {
+	return &MockTODORevisionRepository{
+		revisions: make(map[string][]*domain.TODORevision),
+	}
+}
+
+func (m *MockTODORevisionRepository) Append(ctx context.Context, revision *domain.TODORevision) error {
+	revision.Revision = int32(len(m.revisions[revision.TODOID]) + 1)
+	m.revisions[revision.TODOID] = append(m.revisions[revision.TODOID], revision)
+	return nil
+}
+
+func (m *MockTODORevisionRepository) Get(ctx context.Context, todoID string, revision int32) (*domain.TODORevision, error) {
+	revisions := m.revisions[todoID]
+	if revision < 1 || int(revision) > len(revisions) {
+		return nil, fmt.Errorf("revision not found")
+	}
+	return revisions[revision-1], nil
+}
+
+func (m *MockTODORevisionRepository) List(ctx context.Context, todoID string, page, pageSize int32) ([]*domain.TODORevision, *domain.PaginationResult, error) {
+	var revisions []*domain.TODORevision
+	for i := len(m.revisions[todoID]) - 1; i >= 0; i-- {
+		revisions = append(revisions, m.revisions[todoID][i])
+	}
+	return revisions, &domain.PaginationResult{
+		TotalItems:  int32(len(revisions)),
+		TotalPages:  1,
+		CurrentPage: 1,
+		PageSize:    int32(len(revisions)),
+	}, nil
+}
+
+// MockTODODependencyRepository is a mock implementation of TODODependencyRepository for testing
+type MockTODODependencyRepository struct {
+	todos     *MockRepository
+	blockedBy map[string][]string // todoID -> blocker IDs
+}
+
+func NewMockTODODependencyRepository(todos *MockRepository) *MockTODODependencyRepository {
+	return &MockTODODependencyRepository{
+		todos:     todos,
+		blockedBy: make(map[string][]string),
+	}
+}
+
+func (m *MockTODODependencyRepository) Add(ctx context.Context, dependency *domain.TODODependency) error {
+	// Walk the blockers of the new blocker looking for the TODO
+	seen := map[string]bool{}
+	queue := []string{dependency.BlockedByID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == dependency.TODOID {
+			return domain.ErrDependencyCycle
+		}
+		if !seen[id] {
+			seen[id] = true
+			queue = append(queue, m.blockedBy[id]...)
+		}
+	}
+
+	if !containsString(m.blockedBy[dependency.TODOID], dependency.BlockedByID) {
+		m.blockedBy[dependency.TODOID] = append(m.blockedBy[dependency.TODOID], dependency.BlockedByID)
+	}
+	return nil
+}
+
+func (m *MockTODODependencyRepository) Remove(ctx context.Context, todoID, blockedByID string) error {
+	blockers := m.blockedBy[todoID]
+	for i, id := range blockers {
+		if id == blockedByID {
+			m.blockedBy[todoID] = append(blockers[:i], blockers[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("dependency not found")
+}
+
+func (m *MockTODODependencyRepository) ListBlockers(ctx context.Context, todoID string) ([]*domain.TODO, error) {
+	var todos []*domain.TODO
+	for _, id := range m.blockedBy[todoID] {
+		if todo, ok := m.todos.todos[id]; ok {
+			todos = append(todos, todo)
+		}
+	}
+	return todos, nil
+}
+
+func (m *MockTODODependencyRepository) ListBlocked(ctx context.Context, todoID string) ([]*domain.TODO, error) {
+	var todos []*domain.TODO
+	for id, blockers := range m.blockedBy {
+		if todo, ok := m.todos.todos[id]; ok && containsString(blockers, todoID) {
+			todos = append(todos, todo)
+		}
+	}
+	return todos, nil
+}
+
+func (m *MockTODODependencyRepository) CountUnfinishedBlockers(ctx context.Context, todoID string) (int, error) {
+	blockers, _ := m.ListBlockers(ctx, todoID)
+	count := 0
+	for _, blocker := range blockers {
+		if !blocker.IsFinished() {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func NewMockRepository() *MockRepository {
 	return &MockRepository{
-		todos: make(map[string]*domain.TODO),
+		todos:     make(map[string]*domain.TODO),
+		trash:     make(map[string]*domain.TODO),
+		mediaURLs: make(map[string][]string),
+		versions:  make(map[string]int64),
+		shared:    make(map[string][]string),
 	}
 }
 
 func (m *MockRepository) Create(ctx context.Context, todo *domain.TODO) error {
 	m.todos[todo.ID] = todo
+	m.versions[todo.ID] = todo.Version
 	return nil
 }
 
@@ -80,25 +207,73 @@ func (m *MockRepository) GetByID(ctx context.Context, id string) (*domain.TODO,
 	if !ok {
 		return nil, &NotFoundError{ID: id}
 	}
-	return todo, nil
+	copied := *todo
+	return &copied, nil
 }
 
 func (m *MockRepository) Update(ctx context.Context, todo *domain.TODO) error {
 	if _, ok := m.todos[todo.ID]; !ok {
 		return &NotFoundError{ID: todo.ID}
 	}
+	if version, ok := m.versions[todo.ID]; ok && version != todo.Version {
+		return domain.ErrVersionConflict
+	}
+	todo.Version++
+	m.versions[todo.ID] = todo.Version
 	m.todos[todo.ID] = todo
 	return nil
 }
 
+func (m *MockRepository) UpdateMany(ctx context.Context, todos []*domain.TODO, orderKeys map[string]string) error {
+	for _, todo := range todos {
+		if _, ok := m.todos[todo.ID]; !ok {
+			return &domain.BatchSaveError{ID: todo.ID, Err: &NotFoundError{ID: todo.ID}}
+		}
+		if version, ok := m.versions[todo.ID]; ok && version != todo.Version {
+			return &domain.BatchSaveError{ID: todo.ID, Err: domain.ErrVersionConflict}
+		}
+	}
+	m.SetOrderKeys(ctx, orderKeys)
+	for _, todo := range todos {
+		m.Update(ctx, todo)
+	}
+	return nil
+}
+
+func (m *MockRepository) CompleteOccurrence(ctx context.Context, completed *domain.TODO, created []*domain.TODO) error {
+	if err := m.Update(ctx, completed); err != nil {
+		return err
+	}
+	for _, todo := range created {
+		m.Create(ctx, todo)
+	}
+	return nil
+}
+
 func (m *MockRepository) Delete(ctx context.Context, id string) error {
 	if _, ok := m.todos[id]; !ok {
 		return &NotFoundError{ID: id}
 	}
-	delete(m.todos, id)
+	m.trashSubtree(id, time.Now())
 	return nil
 }
 
+// trashSubtree moves a TODO and its subtasks to the trash
+func (m *MockRepository) trashSubtree(id string, deletedAt time.Time) {
+	todo, ok := m.todos[id]
+	if !ok {
+		return
+	}
+	todo.DeletedAt = &deletedAt
+	m.trash[id] = todo
+	delete(m.todos, id)
+	for _, child := range m.todos {
+		if child.ParentID != nil && *child.ParentID == id {
+			m.trashSubtree(child.ID, deletedAt)
+		}
+	}
+}
+
 func (m *MockRepository) List(ctx context.Context, options domain.TODOListOptions) ([]*domain.TODO, *domain.PaginationResult, error) {
 	var todos []*domain.TODO
 
@@ -427,18 +602,10 @@ func toLower(b byte) byte {
 	return b
 }
 
-func (m *MockRepository) BulkUpdateStatus(ctx context.Context, ids []string, status commonv1.Status) error {
-	for _, id := range ids {
-		if todo, ok := m.todos[id]; ok {
-			todo.Status = status
-		}
-	}
-	return nil
-}
-
 func (m *MockRepository) BulkDelete(ctx context.Context, ids []string) error {
+	deletedAt := time.Now()
 	for _, id := range ids {
-		delete(m.todos, id)
+		m.trashSubtree(id, deletedAt)
 	}
 	return nil
 }
@@ -454,8 +621,161 @@ func (m *MockRepository) GetSharedTODOs(ctx context.Context, teamID string, opti
 }
 
 func (m *MockRepository) GetSharedTeams(ctx context.Context, todoID string) ([]string, error) {
-	// For testing purposes, return empty results
-	return []string{}, nil
+	return append([]string{}, m.shared[todoID]...), nil
+}
+
+// isTrashRoot reports whether a TODO in the trash was not deleted together
+// with its parent
+func (m *MockRepository) isTrashRoot(todo *domain.TODO) bool {
+	if todo.ParentID == nil {
+		return true
+	}
+	parent, ok := m.trash[*todo.ParentID]
+	return !ok || !parent.DeletedAt.Equal(*todo.DeletedAt)
+}
+
+func (m *MockRepository) ListTrash(ctx context.Context, userID string, page, pageSize int32) ([]*domain.TODO, *domain.PaginationResult, error) {
+	var todos []*domain.TODO
+	for _, todo := range m.trash {
+		if todo.UserID == userID && m.isTrashRoot(todo) {
+			todos = append(todos, todo)
+		}
+	}
+	return todos, &domain.PaginationResult{
+		TotalItems:  int32(len(todos)),
+		TotalPages:  1,
+		CurrentPage: 1,
+		PageSize:    int32(len(todos)),
+	}, nil
+}
+
+func (m *MockRepository) GetDeletedByID(ctx context.Context, id string) (*domain.TODO, error) {
+	todo, ok := m.trash[id]
+	if !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	return todo, nil
+}
+
+func (m *MockRepository) Restore(ctx context.Context, id string) error {
+	todo, ok := m.trash[id]
+	if !ok {
+		return &NotFoundError{ID: id}
+	}
+	deletedAt := *todo.DeletedAt
+	todo.DeletedAt = nil
+	m.todos[id] = todo
+	delete(m.trash, id)
+	for _, child := range m.trash {
+		if child.ParentID != nil && *child.ParentID == id && child.DeletedAt.Equal(deletedAt) {
+			m.Restore(ctx, child.ID)
+		}
+	}
+	return nil
+}
+
+func (m *MockRepository) Purge(ctx context.Context, id string) ([]string, error) {
+	if _, ok := m.trash[id]; !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	fileURLs := m.mediaURLs[id]
+	delete(m.trash, id)
+	delete(m.mediaURLs, id)
+	for _, child := range m.trash {
+		if child.ParentID != nil && *child.ParentID == id {
+			childURLs, _ := m.Purge(ctx, child.ID)
+			fileURLs = append(fileURLs, childURLs...)
+		}
+	}
+	return fileURLs, nil
+}
+
+func (m *MockRepository) ListPurgeable(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	var ids []string
+	for _, todo := range m.trash {
+		if m.isTrashRoot(todo) && !todo.DeletedAt.After(before) && len(ids) < limit {
+			ids = append(ids, todo.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (m *MockRepository) GetTree(ctx context.Context, id string, depth int32) (*domain.TODOTreeNode, error) {
+	root, ok := m.todos[id]
+	if !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	return m.treeNode(root, depth), nil
+}
+
+// treeNode builds the tree node of a TODO, counting all of its subtasks but
+// only including depth levels of children
+func (m *MockRepository) treeNode(todo *domain.TODO, depth int32) *domain.TODOTreeNode {
+	node := &domain.TODOTreeNode{TODO: todo}
+	for _, child := range m.todos {
+		if child.ParentID == nil || *child.ParentID != todo.ID {
+			continue
+		}
+		childNode := m.treeNode(child, depth-1)
+		node.TotalSubtasks += 1 + childNode.TotalSubtasks
+		node.CompletedSubtasks += childNode.CompletedSubtasks
+		if child.IsCompleted() {
+			node.CompletedSubtasks++
+		}
+		if depth > 0 {
+			node.Children = append(node.Children, childNode)
+		}
+	}
+	return node
+}
+
+func (m *MockRepository) IsDescendant(ctx context.Context, id, ancestorID string) (bool, error) {
+	for todo, ok := m.todos[id]; ok && todo.ParentID != nil; todo, ok = m.todos[*todo.ParentID] {
+		if *todo.ParentID == ancestorID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockRepository) ListSiblings(ctx context.Context, userID string, parentID *string) ([]*domain.TODO, error) {
+	var siblings []*domain.TODO
+	for _, todo := range m.todos {
+		// Return copies, like the database, so that changes are only kept
+		// once saved
+		sibling := *todo
+		if parentID != nil && *parentID != "" {
+			if todo.ParentID != nil && *todo.ParentID == *parentID {
+				siblings = append(siblings, &sibling)
+			}
+		} else if todo.UserID == userID && (todo.ParentID == nil || *todo.ParentID == "") {
+			siblings = append(siblings, &sibling)
+		}
+	}
+	sort.Slice(siblings, func(i, j int) bool {
+		if siblings[i].OrderKey != siblings[j].OrderKey {
+			return siblings[i].OrderKey < siblings[j].OrderKey
+		}
+		return siblings[i].ID < siblings[j].ID
+	})
+	return siblings, nil
+}
+
+func (m *MockRepository) LastOrderKey(ctx context.Context, userID string, parentID *string) (string, error) {
+	siblings, _ := m.ListSiblings(ctx, userID, parentID)
+	if len(siblings) == 0 {
+		return "", nil
+	}
+	return siblings[len(siblings)-1].OrderKey, nil
+}
+
+func (m *MockRepository) SetOrderKeys(ctx context.Context, keys map[string]string) error {
+	for id, key := range keys {
+		if todo, ok := m.todos[id]; ok {
+			todo.OrderKey = key
+		}
+	}
+	return nil
 }
 
 type NotFoundError struct {
@@ -468,13 +788,13 @@ func (e *NotFoundError) Error() string {
 
 func TestTODOService_CreateTODO(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	userID := "user-123"
 	title := "Test TODO"
 
-	todo, err := service.CreateTODO(ctx, userID, title, nil, nil, nil, nil, nil, nil, nil)
+	todo, err := service.CreateTODO(ctx, userID, title, nil, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -492,10 +812,10 @@ func TestTODOService_CreateTODO(t *testing.T) {
 
 func TestTODOService_CreateTODO_EmptyTitle(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
-	_, err := service.CreateTODO(ctx, "user-123", "", nil, nil, nil, nil, nil, nil, nil)
+	_, err := service.CreateTODO(ctx, "user-123", "", nil, nil, nil, nil, nil, nil, nil, nil)
 	if err == nil {
 		t.Error("Expected error for empty title")
 	}
@@ -503,11 +823,11 @@ func TestTODOService_CreateTODO_EmptyTitle(t *testing.T) {
 
 func TestTODOService_GetTODO(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create a TODO first
-	todo, _ := service.CreateTODO(ctx, "user-123", "Test TODO", nil, nil, nil, nil, nil, nil, nil)
+	todo, _ := service.CreateTODO(ctx, "user-123", "Test TODO", nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// Get the TODO
 	retrieved, err := service.GetTODO(ctx, todo.ID)
@@ -522,7 +842,7 @@ func TestTODOService_GetTODO(t *testing.T) {
 
 func TestTODOService_GetTODO_NotFound(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	_, err := service.GetTODO(ctx, "non-existent-id")
@@ -533,15 +853,15 @@ func TestTODOService_GetTODO_NotFound(t *testing.T) {
 
 func TestTODOService_UpdateTODO(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create a TODO
-	todo, _ := service.CreateTODO(ctx, "user-123", "Original Title", nil, nil, nil, nil, nil, nil, nil)
+	todo, _ := service.CreateTODO(ctx, "user-123", "Original Title", nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// Update the TODO
 	newTitle := "Updated Title"
-	updated, err := service.UpdateTODO(ctx, todo.ID, &newTitle, nil, nil, nil, nil, nil, nil, nil, nil)
+	updated, err := service.UpdateTODO(ctx, "user-123", todo.ID, &newTitle, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 0)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -553,14 +873,14 @@ func TestTODOService_UpdateTODO(t *testing.T) {
 
 func TestTODOService_DeleteTODO(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create a TODO
-	todo, _ := service.CreateTODO(ctx, "user-123", "Test TODO", nil, nil, nil, nil, nil, nil, nil)
+	todo, _ := service.CreateTODO(ctx, "user-123", "Test TODO", nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// Complete the TODO
-	completed, err := service.CompleteTODO(ctx, todo.ID)
+	completed, _, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 0)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -575,15 +895,15 @@ func TestTODOService_DeleteTODO(t *testing.T) {
 
 func TestTODOService_CompleteTODO(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create and complete a TODO
-	todo, _ := service.CreateTODO(ctx, "user-123", "Test TODO", nil, nil, nil, nil, nil, nil, nil)
-	service.CompleteTODO(ctx, todo.ID)
+	todo, _ := service.CreateTODO(ctx, "user-123", "Test TODO", nil, nil, nil, nil, nil, nil, nil, nil)
+	service.CompleteTODO(ctx, "user-123", todo.ID, false, 0)
 
 	// Reopen the TODO
-	reopened, err := service.ReopenTODO(ctx, todo.ID)
+	reopened, err := service.ReopenTODO(ctx, "user-123", todo.ID, 0)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -595,13 +915,13 @@ func TestTODOService_CompleteTODO(t *testing.T) {
 
 func TestTODOService_ReopenTODO(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create multiple TODOs
-	service.CreateTODO(ctx, "user-123", "TODO 1", nil, nil, nil, nil, nil, nil, nil)
-	service.CreateTODO(ctx, "user-123", "TODO 2", nil, nil, nil, nil, nil, nil, nil)
-	service.CreateTODO(ctx, "user-123", "TODO 3", nil, nil, nil, nil, nil, nil, nil)
+	service.CreateTODO(ctx, "user-123", "TODO 1", nil, nil, nil, nil, nil, nil, nil, nil)
+	service.CreateTODO(ctx, "user-123", "TODO 2", nil, nil, nil, nil, nil, nil, nil, nil)
+	service.CreateTODO(ctx, "user-123", "TODO 3", nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// List TODOs
 	filter := domain.TODOFilter{}
@@ -618,65 +938,9 @@ func TestTODOService_ReopenTODO(t *testing.T) {
 	}
 }
 
-func TestTODOService_BulkDelete(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
-	ctx := context.Background()
-
-	// Create multiple TODOs
-	todo1, _ := service.CreateTODO(ctx, "user-123", "TODO 1", nil, nil, nil, nil, nil, nil, nil)
-	todo2, _ := service.CreateTODO(ctx, "user-123", "TODO 2", nil, nil, nil, nil, nil, nil, nil)
-
-	// Bulk update status
-	ids := []string{todo1.ID, todo2.ID}
-	err := service.BulkUpdateStatus(ctx, ids, commonv1.Status_STATUS_COMPLETED)
-	if err != nil {
-		t.Fatalf("Unexpected error: %v", err)
-	}
-
-	// Verify status was updated
-	retrieved1, _ := service.GetTODO(ctx, todo1.ID)
-	retrieved2, _ := service.GetTODO(ctx, todo2.ID)
-
-	if retrieved1.Status != commonv1.Status_STATUS_COMPLETED {
-		t.Error("Expected todo1 status to be COMPLETED")
-	}
-	if retrieved2.Status != commonv1.Status_STATUS_COMPLETED {
-		t.Error("Expected todo2 status to be COMPLETED")
-	}
-}
-
-func TestTODOService_ListTODOs(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
-	ctx := context.Background()
-
-	// Create multiple TODOs
-	todo1, _ := service.CreateTODO(ctx, "user-123", "TODO 1", nil, nil, nil, nil, nil, nil, nil)
-	todo2, _ := service.CreateTODO(ctx, "user-123", "TODO 2", nil, nil, nil, nil, nil, nil, nil)
-
-	// Bulk delete
-	ids := []string{todo1.ID, todo2.ID}
-	err := service.BulkDelete(ctx, ids)
-	if err != nil {
-		t.Fatalf("Unexpected error: %v", err)
-	}
-
-	// Verify TODOs were deleted
-	_, err1 := service.GetTODO(ctx, todo1.ID)
-	_, err2 := service.GetTODO(ctx, todo2.ID)
-
-	if err1 == nil {
-		t.Error("Expected todo1 to be deleted")
-	}
-	if err2 == nil {
-		t.Error("Expected todo2 to be deleted")
-	}
-}
-
 func TestTODOService_ListTODOs_AdvancedSearch(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create test TODOs with different attributes
@@ -691,11 +955,11 @@ func TestTODOService_ListTODOs_AdvancedSearch(t *testing.T) {
 	priorityLow := commonv1.Priority_PRIORITY_LOW
 
 	todo1, _ := service.CreateTODO(ctx, "user-123", "Buy groceries for dinner",
-		&desc1, nil, &priorityHigh, &dueDate1, []string{"shopping", "food"}, nil, nil)
+		&desc1, nil, &priorityHigh, &dueDate1, []string{"shopping", "food"}, nil, nil, nil)
 	todo2, _ := service.CreateTODO(ctx, "user-123", "Finish project report",
-		&desc2, nil, &priorityMedium, &dueDate2, []string{"work", "report"}, nil, nil)
+		&desc2, nil, &priorityMedium, &dueDate2, []string{"work", "report"}, nil, nil, nil)
 	_, _ = service.CreateTODO(ctx, "user-456", "Team meeting preparation",
-		&desc3, nil, &priorityLow, nil, []string{"meeting", "team"}, nil, nil)
+		&desc3, nil, &priorityLow, nil, []string{"meeting", "team"}, nil, nil, nil)
 
 	// Test 1: Search by title
 	searchQuery1 := "groceries"
@@ -763,7 +1027,7 @@ func TestTODOService_ListTODOs_AdvancedSearch(t *testing.T) {
 
 func TestTODOService_ListTODOs_PaginationAndSorting(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create multiple TODOs
@@ -771,7 +1035,7 @@ func TestTODOService_ListTODOs_PaginationAndSorting(t *testing.T) {
 		dueDate := time.Now().Add(time.Duration(i) * 24 * time.Hour)
 		priority := commonv1.Priority(i % 3) // Cycle through priorities
 		_, err := service.CreateTODO(ctx, "user-123", fmt.Sprintf("TODO %d", i),
-			nil, nil, &priority, &dueDate, nil, nil, nil)
+			nil, nil, &priority, &dueDate, nil, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Failed to create TODO %d: %v", i, err)
 		}
@@ -817,7 +1081,7 @@ func TestTODOService_ListTODOs_PaginationAndSorting(t *testing.T) {
 
 func TestTODOService_ListTODOs_DateFiltering(t *testing.T) {
 	repo := NewMockRepository()
-	service := NewTODOService(repo, nil)
+	service := NewTODOService(repo, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	// Create TODOs with specific dates
@@ -826,11 +1090,11 @@ func TestTODOService_ListTODOs_DateFiltering(t *testing.T) {
 	tomorrow := now.Add(24 * time.Hour)
 
 	todo1, _ := service.CreateTODO(ctx, "user-123", "Yesterday's TODO",
-		nil, nil, nil, &yesterday, nil, nil, nil)
+		nil, nil, nil, &yesterday, nil, nil, nil, nil)
 	todo2, _ := service.CreateTODO(ctx, "user-123", "Today's TODO",
-		nil, nil, nil, &now, nil, nil, nil)
+		nil, nil, nil, &now, nil, nil, nil, nil)
 	_, _ = service.CreateTODO(ctx, "user-123", "Tomorrow's TODO",
-		nil, nil, nil, &tomorrow, nil, nil, nil)
+		nil, nil, nil, &tomorrow, nil, nil, nil, nil)
 
 	// Test due date range filtering
 	todos, _, err := service.ListTODOs(ctx, domain.TODOFilter{
@@ -859,3 +1123,804 @@ func TestTODOService_ListTODOs_DateFiltering(t *testing.T) {
 		t.Error("Expected to find both todo1 and todo2 in date range")
 	}
 }
+
+func TestTODOService_CreateTODO_Recurrence(t *testing.T) {
+	service := NewTODOService(NewMockRepository(), nil, nil, nil, nil)
+	ctx := context.Background()
+	dueDate := time.Now()
+
+	tests := []struct {
+		name     string
+		dueDate  *time.Time
+		rule     string
+		wantRule string
+		wantCode codes.Code
+	}{
+		{name: "canonical form", dueDate: &dueDate, rule: "RRULE:freq=weekly;interval=1;byday=mo", wantRule: "FREQ=WEEKLY;BYDAY=MO"},
+		{name: "empty rule", dueDate: &dueDate, rule: "", wantRule: ""},
+		{name: "invalid rule", dueDate: &dueDate, rule: "FREQ=HOURLY", wantCode: codes.InvalidArgument},
+		{name: "missing due date", rule: "FREQ=DAILY", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo, err := service.CreateTODO(ctx, "user-123", "Chore", nil, nil, nil, tt.dueDate, nil, nil, nil, &tt.rule)
+			if tt.wantCode != codes.OK {
+				if grpcstatus.Code(err) != tt.wantCode {
+					t.Errorf("Expected %v, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if todo.RecurrenceRule != tt.wantRule {
+				t.Errorf("Expected rule to be %s, got %s", tt.wantRule, todo.RecurrenceRule)
+			}
+		})
+	}
+}
+
+func TestTODOService_CompleteTODO_Recurring(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	dueDate := time.Date(2026, time.October, 12, 18, 0, 0, 0, time.UTC) // Monday
+	subtaskDue := dueDate.Add(-2 * time.Hour)
+	assignee := "user-456"
+	rule := "FREQ=WEEKLY;BYDAY=MO;COUNT=2"
+	todo, err := service.CreateTODO(ctx, "user-123", "Take out the bins", nil, nil, nil, &dueDate, []string{"chores"}, &assignee, nil, &rule)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	subtask, _ := service.CreateTODO(ctx, "user-123", "Rinse the recycling", nil, nil, nil, &subtaskDue, nil, nil, &todo.ID, nil)
+	service.CompleteTODO(ctx, "user-123", subtask.ID, false, 0)
+
+	completed, next, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if completed.IsRecurring() {
+		t.Error("Expected the completed occurrence to hand its rule over")
+	}
+	if next == nil {
+		t.Fatal("Expected the next occurrence to be created")
+	}
+	if want := dueDate.AddDate(0, 0, 7); next.DueDate == nil || !next.DueDate.Equal(want) {
+		t.Errorf("Expected next due date to be %v, got %v", want, next.DueDate)
+	}
+	if next.RecurrenceRule != rule || next.Occurrence != 2 {
+		t.Errorf("Expected occurrence 2 of %s, got occurrence %d of %s", rule, next.Occurrence, next.RecurrenceRule)
+	}
+	if len(next.Tags) != 1 || next.Tags[0] != "chores" || next.AssignedTo == nil || *next.AssignedTo != assignee {
+		t.Errorf("Expected tags and assignee to be copied, got %v and %v", next.Tags, next.AssignedTo)
+	}
+
+	subtasks, _, _ := service.ListTODOs(ctx, domain.TODOFilter{ParentID: &next.ID}, nil, 1, 20)
+	if len(subtasks) != 1 {
+		t.Fatalf("Expected 1 copied subtask, got %d", len(subtasks))
+	}
+	if subtasks[0].IsCompleted() || subtasks[0].Title != subtask.Title {
+		t.Errorf("Expected an open copy of the subtask, got %+v", subtasks[0])
+	}
+	if want := subtaskDue.AddDate(0, 0, 7); !subtasks[0].DueDate.Equal(want) {
+		t.Errorf("Expected subtask due date to be %v, got %v", want, subtasks[0].DueDate)
+	}
+
+	if _, again, err := service.CompleteTODO(ctx, "user-123", completed.ID, false, 0); err != nil || again != nil {
+		t.Errorf("Expected completing again not to create another occurrence, got %v, %v", again, err)
+	}
+
+	// COUNT=2: the second occurrence is the last
+	_, last, err := service.CompleteTODO(ctx, "user-123", next.ID, false, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if last != nil {
+		t.Errorf("Expected the series to end, got %+v", last)
+	}
+}
+
+func TestTODOService_CompleteTODO_RecurringConflict(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	dueDate := time.Now()
+	rule := "FREQ=DAILY"
+	todo, _ := service.CreateTODO(ctx, "user-123", "Water the plants", nil, nil, nil, &dueDate, nil, nil, nil, &rule)
+	service.CreateTODO(ctx, "user-123", "Fill the can", nil, nil, nil, nil, nil, nil, &todo.ID, nil)
+
+	// The TODO is changed by someone else while it is being completed
+	repo.versions[todo.ID] = todo.Version + 1
+	count := len(repo.todos)
+
+	if _, _, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 0); grpcstatus.Code(err) != codes.Aborted {
+		t.Fatalf("Expected Aborted, got %v", err)
+	}
+	if len(repo.todos) != count {
+		t.Errorf("Expected no next occurrence or subtask copies to be created, got %d new TODOs", len(repo.todos)-count)
+	}
+}
+
+func TestTODOService_CompleteRecurringThroughUpdates(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	bulkService := NewBulkService(service, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	dueDate := time.Now()
+	rule := "FREQ=DAILY"
+	todo, _ := service.CreateTODO(ctx, "user-123", "Feed the cat", nil, nil, nil, &dueDate, nil, nil, nil, &rule)
+	completedStatus := commonv1.Status_STATUS_COMPLETED
+
+	// Only CompleteTODO creates the next occurrence
+	if _, err := service.UpdateTODO(ctx, "user-123", todo.ID, nil, nil, &completedStatus, nil, nil, nil, nil, nil, nil, nil, false, 0); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition from UpdateTODO, got %v", err)
+	}
+	if _, err := service.PatchTODO(ctx, "user-123", todo.ID, &domain.TODO{Status: completedStatus}, []string{"status"}, false, 0); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition from PatchTODO, got %v", err)
+	}
+	results, err := bulkService.BulkUpdateStatus(ctx, "user-123", []string{todo.ID}, completedStatus, false)
+	if err != nil || grpcstatus.Code(results[0].Err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition from BulkUpdateStatus, got %v, %v", results, err)
+	}
+	if got, _ := service.GetTODO(ctx, todo.ID); got.IsCompleted() {
+		t.Fatal("Expected the TODO not to be completed")
+	}
+
+	// Ending the series in the same change completes the last occurrence
+	completed, err := service.PatchTODO(ctx, "user-123", todo.ID, &domain.TODO{Status: completedStatus}, []string{"status", "recurrence_rule"}, false, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !completed.IsCompleted() || completed.IsRecurring() {
+		t.Errorf("Expected a completed TODO that no longer recurs, got %+v", completed)
+	}
+}
+
+func TestTODOService_SkipOccurrence(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	dueDate := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+	rule := "FREQ=MONTHLY;UNTIL=20260331"
+	todo, _ := service.CreateTODO(ctx, "user-123", "Pay rent", nil, nil, nil, &dueDate, nil, nil, nil, &rule)
+	oneOff, _ := service.CreateTODO(ctx, "user-123", "One-off", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	if _, err := service.SkipOccurrence(ctx, "user-123", oneOff.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition for a TODO that does not recur, got %v", err)
+	}
+
+	skipped, err := service.SkipOccurrence(ctx, "user-123", todo.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, time.March, 31, 9, 0, 0, 0, time.UTC); !skipped.DueDate.Equal(want) {
+		t.Errorf("Expected due date to be %v, got %v", want, skipped.DueDate)
+	}
+	if skipped.Occurrence != 2 || skipped.IsCompleted() {
+		t.Errorf("Expected open occurrence 2, got occurrence %d with status %v", skipped.Occurrence, skipped.Status)
+	}
+
+	if _, err := service.SkipOccurrence(ctx, "user-123", todo.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition for the last occurrence, got %v", err)
+	}
+}
+
+func TestTODOService_EndRecurrence(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	dueDate := time.Now()
+	rule := "FREQ=DAILY"
+	todo, _ := service.CreateTODO(ctx, "user-123", "Stretch", nil, nil, nil, &dueDate, nil, nil, nil, &rule)
+
+	ended, err := service.EndRecurrence(ctx, "user-123", todo.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ended.IsRecurring() {
+		t.Error("Expected the TODO to no longer recur")
+	}
+	if _, err := service.EndRecurrence(ctx, "user-123", todo.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition once the series has ended, got %v", err)
+	}
+
+	_, next, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if next != nil {
+		t.Error("Expected no next occurrence after the series has ended")
+	}
+}
+
+func TestTODOService_RecordsRevisions(t *testing.T) {
+	repo := NewMockRepository()
+	revisions := NewMockTODORevisionRepository()
+	service := NewTODOService(repo, revisions, nil, nil, nil)
+	ctx := context.Background()
+
+	todo, _ := service.CreateTODO(ctx, "user-123", "Draft", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	title := "Final"
+	if _, err := service.UpdateTODO(ctx, "user-456", todo.ID, &title, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// An update that changes nothing is not recorded
+	if _, err := service.UpdateTODO(ctx, "user-456", todo.ID, &title, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, _, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	history, _, err := service.ListRevisions(ctx, todo.ID, 1, 20)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("Expected 3 revisions, got %d", len(history))
+	}
+
+	tests := []struct {
+		revision *domain.TODORevision
+		action   string
+		actorID  string
+		changed  string
+	}{
+		{history[2], domain.RevisionActionCreated, "user-123", "title"},
+		{history[1], domain.RevisionActionUpdated, "user-456", "title"},
+		{history[0], domain.RevisionActionCompleted, "user-123", "status"},
+	}
+	for _, tt := range tests {
+		if tt.revision.Action != tt.action || tt.revision.ActorID != tt.actorID {
+			t.Errorf("Expected %s by %s, got %s by %s", tt.action, tt.actorID, tt.revision.Action, tt.revision.ActorID)
+		}
+		if !containsString(tt.revision.ChangedFields, tt.changed) {
+			t.Errorf("Expected %s revision to change %s, got %v", tt.action, tt.changed, tt.revision.ChangedFields)
+		}
+	}
+}
+
+func TestTODOService_DiffRevisions(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, NewMockTODORevisionRepository(), nil, nil, nil)
+	ctx := context.Background()
+
+	todo, _ := service.CreateTODO(ctx, "user-123", "Draft", nil, nil, nil, nil, nil, nil, nil, nil)
+	title := "Final"
+	priority := commonv1.Priority_PRIORITY_HIGH
+	service.UpdateTODO(ctx, "user-123", todo.ID, &title, nil, nil, &priority, nil, nil, nil, nil, nil, nil, false, 0)
+
+	changes, err := service.DiffRevisions(ctx, todo.ID, 1, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(changes) != 2 || changes[0].Field != "title" || changes[1].Field != "priority" {
+		t.Fatalf("Expected title and priority to change, got %v", changes)
+	}
+	if changes[0].OldValue != "Draft" || changes[0].NewValue != "Final" {
+		t.Errorf("Expected title to change from Draft to Final, got %v -> %v", changes[0].OldValue, changes[0].NewValue)
+	}
+
+	tests := []struct {
+		name         string
+		fromRevision int32
+		toRevision   int32
+		want         codes.Code
+	}{
+		{"zero revision", 0, 2, codes.InvalidArgument},
+		{"missing revision", 1, 5, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.DiffRevisions(ctx, todo.ID, tt.fromRevision, tt.toRevision); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestTODOService_RestoreRevision(t *testing.T) {
+	repo := NewMockRepository()
+	revisions := NewMockTODORevisionRepository()
+	service := NewTODOService(repo, revisions, nil, nil, nil)
+	ctx := context.Background()
+
+	todo, _ := service.CreateTODO(ctx, "user-123", "Draft", nil, nil, nil, nil, []string{"work"}, nil, nil, nil)
+	title := "Final"
+	service.UpdateTODO(ctx, "user-123", todo.ID, &title, nil, nil, nil, nil, []string{"home"}, nil, nil, nil, nil, false, 0)
+	service.CompleteTODO(ctx, "user-123", todo.ID, false, 0)
+
+	restored, err := service.RestoreRevision(ctx, "user-456", todo.ID, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if restored.Title != "Draft" || len(restored.Tags) != 1 || restored.Tags[0] != "work" {
+		t.Errorf("Expected the first revision to be restored, got %q %v", restored.Title, restored.Tags)
+	}
+	if restored.IsCompleted() {
+		t.Error("Expected the restored TODO not to be completed")
+	}
+
+	latest, err := revisions.Get(ctx, todo.ID, 4)
+	if err != nil {
+		t.Fatalf("Expected the restore to be recorded as revision 4: %v", err)
+	}
+	if latest.Action != domain.RevisionActionRestored || latest.ActorID != "user-456" {
+		t.Errorf("Expected a restored revision by user-456, got %s by %s", latest.Action, latest.ActorID)
+	}
+
+	if _, err := service.RestoreRevision(ctx, "user-456", todo.ID, 9); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for a missing revision, got %v", err)
+	}
+}
+
+func TestTODOService_RestoreRevision_ParentDeleted(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, NewMockTODORevisionRepository(), nil, nil, nil)
+	ctx := context.Background()
+
+	parent, _ := service.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	todo, _ := service.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &parent.ID, nil)
+	root := ""
+	service.MoveTODO(ctx, "user-123", todo.ID, &root, nil, 0)
+	service.DeleteTODO(ctx, parent.ID)
+
+	if _, err := service.RestoreRevision(ctx, "user-123", todo.ID, 1); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition when the parent no longer exists, got %v", err)
+	}
+}
+
+func TestTODOService_AddDependency(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, NewMockTODODependencyRepository(repo), nil, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	build, _ := service.CreateTODO(ctx, "user-123", "Build", nil, nil, nil, nil, nil, nil, nil, nil)
+	test, _ := service.CreateTODO(ctx, "user-123", "Test", nil, nil, nil, nil, nil, nil, nil, nil)
+	release, _ := service.CreateTODO(ctx, "user-123", "Release", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	if err := service.AddDependency(ctx, "user-123", test.ID, build.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := service.AddDependency(ctx, "user-123", release.ID, test.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		blockedByID string
+		want        codes.Code
+	}{
+		{"missing blocker", release.ID, "", codes.InvalidArgument},
+		{"blocked by itself", build.ID, build.ID, codes.InvalidArgument},
+		{"blocker not found", release.ID, "non-existent-id", codes.NotFound},
+		{"direct cycle", build.ID, test.ID, codes.FailedPrecondition},
+		{"transitive cycle", build.ID, release.ID, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := service.AddDependency(ctx, "user-123", tt.id, tt.blockedByID); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	blockedBy, blocking, err := service.ListDependencies(ctx, "user-123", test.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(blockedBy) != 1 || blockedBy[0].ID != build.ID {
+		t.Errorf("Expected test to be blocked by build, got %d blockers", len(blockedBy))
+	}
+	if len(blocking) != 1 || blocking[0].ID != release.ID {
+		t.Errorf("Expected test to block release, got %d blocked todos", len(blocking))
+	}
+
+	if err := service.RemoveDependency(ctx, "user-123", test.ID, build.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := service.RemoveDependency(ctx, "user-123", test.ID, build.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for a removed dependency, got %v", err)
+	}
+}
+
+func TestTODOService_DependencyPermissions(t *testing.T) {
+	repo := NewMockRepository()
+	dependencies := NewMockTODODependencyRepository(repo)
+	service := NewTODOService(repo, nil, dependencies, nil, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	own, _ := service.CreateTODO(ctx, "user-123", "Own", nil, nil, nil, nil, nil, nil, nil, nil)
+	other, _ := service.CreateTODO(ctx, "user-123", "Other", nil, nil, nil, nil, nil, nil, nil, nil)
+	secret, _ := service.CreateTODO(ctx, "user-456", "Secret", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	if err := service.AddDependency(ctx, "user-123", own.ID, secret.ID); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a blocker the user cannot view, got %v", err)
+	}
+	if err := service.AddDependency(ctx, "user-123", secret.ID, own.ID); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a TODO the user cannot edit, got %v", err)
+	}
+
+	// A dependency on a TODO the user cannot view, added by its owner
+	if err := dependencies.Add(ctx, domain.NewTODODependency(own.ID, secret.ID, "user-456")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := service.AddDependency(ctx, "user-123", own.ID, other.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	blockedBy, _, err := service.ListDependencies(ctx, "user-123", own.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(blockedBy) != 1 || blockedBy[0].ID != other.ID {
+		t.Errorf("Expected only the visible blocker, got %d blockers", len(blockedBy))
+	}
+	if _, _, err := service.ListDependencies(ctx, "user-456", own.ID); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied listing dependencies of another user's TODO, got %v", err)
+	}
+
+	if err := service.RemoveDependency(ctx, "user-456", own.ID, secret.ID); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied removing a dependency of another user's TODO, got %v", err)
+	}
+}
+
+func TestTODOService_BlockedTODO(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, NewMockTODODependencyRepository(repo), nil, NewPermissionService(repo, NewMockTeamRepository()))
+	ctx := context.Background()
+
+	blocker, _ := service.CreateTODO(ctx, "user-123", "Blocker", nil, nil, nil, nil, nil, nil, nil, nil)
+	blocked, _ := service.CreateTODO(ctx, "user-123", "Blocked", nil, nil, nil, nil, nil, nil, nil, nil)
+	service.AddDependency(ctx, "user-123", blocked.ID, blocker.ID)
+
+	inProgress := commonv1.Status_STATUS_IN_PROGRESS
+	if _, err := service.UpdateTODO(ctx, "user-123", blocked.ID, nil, nil, &inProgress, nil, nil, nil, nil, nil, nil, nil, false, 0); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition when starting a blocked TODO, got %v", err)
+	}
+	if _, _, err := service.CompleteTODO(ctx, "user-123", blocked.ID, false, 0); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition when completing a blocked TODO, got %v", err)
+	}
+
+	// Changes that do not start the TODO are allowed
+	priority := commonv1.Priority_PRIORITY_HIGH
+	if _, err := service.UpdateTODO(ctx, "user-123", blocked.ID, nil, nil, nil, &priority, nil, nil, nil, nil, nil, nil, false, 0); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if _, err := service.UpdateTODO(ctx, "user-123", blocked.ID, nil, nil, &inProgress, nil, nil, nil, nil, nil, nil, nil, true, 0); err != nil {
+		t.Errorf("Expected a forced start to succeed, got %v", err)
+	}
+
+	service.CompleteTODO(ctx, "user-123", blocker.ID, false, 0)
+	if _, _, err := service.CompleteTODO(ctx, "user-123", blocked.ID, false, 0); err != nil {
+		t.Errorf("Expected completion to succeed once the blocker is completed, got %v", err)
+	}
+}
+
+func TestTODOService_MoveTODO_RejectsCycles(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	parent, _ := service.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	child, _ := service.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &parent.ID, nil)
+	grandchild, _ := service.CreateTODO(ctx, "user-123", "Grandchild", nil, nil, nil, nil, nil, nil, &child.ID, nil)
+
+	tests := []struct {
+		name     string
+		parentID string
+		want     codes.Code
+	}{
+		{"itself", parent.ID, codes.InvalidArgument},
+		{"child", child.ID, codes.InvalidArgument},
+		{"grandchild", grandchild.ID, codes.InvalidArgument},
+		{"missing parent", "non-existent-id", codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.MoveTODO(ctx, "user-123", parent.ID, &tt.parentID, nil, 0); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+			if _, err := service.UpdateTODO(ctx, "user-123", parent.ID, nil, nil, nil, nil, nil, nil, nil, &tt.parentID, nil, nil, false, 0); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v from UpdateTODO, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Moving a subtask up the tree is allowed
+	if _, err := service.MoveTODO(ctx, "user-123", grandchild.ID, &parent.ID, nil, 0); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestTODOService_GetTODOTree(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	root, _ := service.CreateTODO(ctx, "user-123", "Root", nil, nil, nil, nil, nil, nil, nil, nil)
+	done, _ := service.CreateTODO(ctx, "user-123", "Done", nil, nil, nil, nil, nil, nil, &root.ID, nil)
+	open, _ := service.CreateTODO(ctx, "user-123", "Open", nil, nil, nil, nil, nil, nil, &root.ID, nil)
+	nested, _ := service.CreateTODO(ctx, "user-123", "Nested", nil, nil, nil, nil, nil, nil, &open.ID, nil)
+	service.CompleteTODO(ctx, "user-123", done.ID, false, 0)
+	service.CompleteTODO(ctx, "user-123", nested.ID, false, 0)
+
+	tree, err := service.GetTODOTree(ctx, root.ID, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tree.TotalSubtasks != 3 || tree.CompletedSubtasks != 2 {
+		t.Errorf("Expected 2 of 3 subtasks completed, got %d of %d", tree.CompletedSubtasks, tree.TotalSubtasks)
+	}
+	if progress := tree.Progress(); progress < 66.6 || progress > 66.7 {
+		t.Errorf("Expected progress of 66.7%%, got %v", progress)
+	}
+	if len(tree.Children) != 2 {
+		t.Fatalf("Expected 2 children, got %d", len(tree.Children))
+	}
+	for _, child := range tree.Children {
+		if len(child.Children) != 0 {
+			t.Errorf("Expected no children below the requested depth, got %d", len(child.Children))
+		}
+		if child.TODO.ID == open.ID && (child.TotalSubtasks != 1 || child.Progress() != 100) {
+			t.Errorf("Expected the nested subtask to be counted below the requested depth")
+		}
+	}
+
+	if _, err := service.GetTODOTree(ctx, "non-existent-id", 0); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
+
+// siblingTitles returns the titles of a list of TODOs in order
+func siblingTitles(t *testing.T, repo *MockRepository, userID string, parentID *string) []string {
+	t.Helper()
+	siblings, _ := repo.ListSiblings(context.Background(), userID, parentID)
+	titles := make([]string, 0, len(siblings))
+	for _, todo := range siblings {
+		titles = append(titles, todo.Title)
+	}
+	return titles
+}
+
+func TestTODOService_ReorderTODO(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	a, _ := service.CreateTODO(ctx, "user-123", "A", nil, nil, nil, nil, nil, nil, nil, nil)
+	b, _ := service.CreateTODO(ctx, "user-123", "B", nil, nil, nil, nil, nil, nil, nil, nil)
+	c, _ := service.CreateTODO(ctx, "user-123", "C", nil, nil, nil, nil, nil, nil, nil, nil)
+	if got := fmt.Sprint(siblingTitles(t, repo, "user-123", nil)); got != "[A B C]" {
+		t.Fatalf("Expected new TODOs to be appended, got %s", got)
+	}
+
+	if _, err := service.ReorderTODO(ctx, "user-123", c.ID, a.ID, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := service.ReorderTODO(ctx, "user-123", a.ID, "", b.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(siblingTitles(t, repo, "user-123", nil)); got != "[C B A]" {
+		t.Errorf("Expected order [C B A], got %s", got)
+	}
+
+	// Placing a TODO next to a subtask moves it into the subtask's list
+	child, _ := service.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &a.ID, nil)
+	moved, err := service.ReorderTODO(ctx, "user-123", b.ID, child.ID, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if moved.ParentID == nil || *moved.ParentID != a.ID {
+		t.Error("Expected the TODO to move under the anchor's parent")
+	}
+	if got := fmt.Sprint(siblingTitles(t, repo, "user-123", &a.ID)); got != "[B Child]" {
+		t.Errorf("Expected order [B Child], got %s", got)
+	}
+
+	other, _ := service.CreateTODO(ctx, "user-456", "Other", nil, nil, nil, nil, nil, nil, nil, nil)
+	tests := []struct {
+		name     string
+		id       string
+		beforeID string
+		afterID  string
+		want     codes.Code
+	}{
+		{"missing anchor", a.ID, "", "", codes.InvalidArgument},
+		{"both anchors", a.ID, b.ID, c.ID, codes.InvalidArgument},
+		{"next to itself", a.ID, a.ID, "", codes.InvalidArgument},
+		{"anchor not found", a.ID, "non-existent-id", "", codes.NotFound},
+		{"another user's list", a.ID, other.ID, "", codes.InvalidArgument},
+		{"under its own subtask", a.ID, b.ID, "", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.ReorderTODO(ctx, "user-123", tt.id, tt.beforeID, tt.afterID); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestTODOService_ReorderTODO_Rebalances(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	first, _ := service.CreateTODO(ctx, "user-123", "First", nil, nil, nil, nil, nil, nil, nil, nil)
+	last, _ := service.CreateTODO(ctx, "user-123", "Last", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Legacy TODOs without keys are given keys in their current order
+	legacy := domain.NewTODO("user-123", "Legacy")
+	repo.Create(ctx, legacy)
+	first.OrderKey = ""
+
+	// Repeatedly placing TODOs right after the first one makes keys grow
+	// until the list is rebalanced
+	for i := 0; i < 200; i++ {
+		todo, _ := service.CreateTODO(ctx, "user-123", fmt.Sprintf("Item %d", i), nil, nil, nil, nil, nil, nil, nil, nil)
+		if _, err := service.ReorderTODO(ctx, "user-123", todo.ID, "", first.ID); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	siblings, _ := repo.ListSiblings(ctx, "user-123", nil)
+	if len(siblings) != 203 {
+		t.Fatalf("Expected 203 TODOs, got %d", len(siblings))
+	}
+	if siblings[len(siblings)-1].ID != last.ID {
+		t.Errorf("Expected %q to stay last, got %q", last.Title, siblings[len(siblings)-1].Title)
+	}
+	for i, todo := range siblings {
+		if todo.OrderKey == "" || len(todo.OrderKey) > 24 {
+			t.Errorf("Expected a key of at most 24 digits, got %q", todo.OrderKey)
+		}
+		if i > 0 && siblings[i-1].OrderKey >= todo.OrderKey {
+			t.Errorf("Expected distinct ascending keys, got %q before %q", siblings[i-1].OrderKey, todo.OrderKey)
+		}
+	}
+}
+
+func TestTODOService_ExpectedVersion(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	todo, _ := service.CreateTODO(ctx, "user-123", "Versioned", nil, nil, nil, nil, nil, nil, nil, nil)
+	if todo.Version != 1 {
+		t.Fatalf("Expected a new TODO to be at version 1, got %d", todo.Version)
+	}
+
+	title := "Renamed"
+	updated, err := service.UpdateTODO(ctx, "user-123", todo.ID, &title, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("Expected the update to increment the version to 2, got %d", updated.Version)
+	}
+
+	position := int32(3)
+	stale := map[string]func() error{
+		"update": func() error {
+			_, err := service.UpdateTODO(ctx, "user-123", todo.ID, &title, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 1)
+			return err
+		},
+		"move": func() error {
+			_, err := service.MoveTODO(ctx, "user-123", todo.ID, nil, &position, 1)
+			return err
+		},
+		"complete": func() error {
+			_, _, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 1)
+			return err
+		},
+		"reopen": func() error {
+			_, err := service.ReopenTODO(ctx, "user-123", todo.ID, 1)
+			return err
+		},
+	}
+	for name, write := range stale {
+		t.Run(name, func(t *testing.T) {
+			err := write()
+			if grpcstatus.Code(err) != codes.Aborted {
+				t.Fatalf("Expected Aborted for a stale version, got %v", err)
+			}
+			details := grpcstatus.Convert(err).Details()
+			if len(details) != 1 || details[0].(*commonv1.Error).Code != commonv1.ErrorCode_ERROR_CODE_RESOURCE_CONFLICT {
+				t.Errorf("Expected a RESOURCE_CONFLICT error detail, got %v", details)
+			}
+		})
+	}
+
+	if _, _, err := service.CompleteTODO(ctx, "user-123", todo.ID, false, 2); err != nil {
+		t.Errorf("Expected completion at the current version to succeed, got %v", err)
+	}
+}
+
+func TestTODOService_ConcurrentUpdate(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	todo, _ := service.CreateTODO(ctx, "user-123", "Shared", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Another writer saves a change after this update has read the TODO
+	repo.versions[todo.ID] = 2
+
+	title := "Overwritten"
+	if _, err := service.UpdateTODO(ctx, "user-123", todo.ID, &title, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 0); grpcstatus.Code(err) != codes.Aborted {
+		t.Errorf("Expected Aborted when the TODO changed concurrently, got %v", err)
+	}
+}
+
+func TestTODOService_PatchTODO(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewTODOService(repo, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	description := "Keep me"
+	dueDate := time.Now().Add(24 * time.Hour)
+	todo, _ := service.CreateTODO(ctx, "user-123", "Patched", &description, nil, nil, &dueDate, []string{"work"}, stringPtr("user-456"), nil, nil)
+
+	// Masked fields that are unset in the values are cleared
+	patched, err := service.PatchTODO(ctx, "user-123", todo.ID, &domain.TODO{Title: "Renamed"}, []string{"title", "tags", "due_date", "assigned_to"}, false, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if patched.Title != "Renamed" {
+		t.Errorf("Expected title 'Renamed', got '%s'", patched.Title)
+	}
+	if len(patched.Tags) != 0 || patched.DueDate != nil || patched.AssignedTo != nil {
+		t.Errorf("Expected tags, due date and assignee to be cleared, got %v, %v, %v", patched.Tags, patched.DueDate, patched.AssignedTo)
+	}
+	if patched.Description != description || patched.Priority != commonv1.Priority_PRIORITY_MEDIUM {
+		t.Error("Expected fields outside the mask to be unchanged")
+	}
+	if patched.Version != 2 {
+		t.Errorf("Expected the patch to increment the version to 2, got %d", patched.Version)
+	}
+
+	tests := []struct {
+		name   string
+		values *domain.TODO
+		paths  []string
+		want   codes.Code
+	}{
+		{"empty mask", &domain.TODO{Title: "Renamed"}, nil, codes.InvalidArgument},
+		{"unknown field", &domain.TODO{}, []string{"user_id"}, codes.InvalidArgument},
+		{"read-only field", &domain.TODO{}, []string{"version"}, codes.InvalidArgument},
+		{"cleared title", &domain.TODO{}, []string{"title"}, codes.InvalidArgument},
+		{"cleared status", &domain.TODO{}, []string{"status"}, codes.InvalidArgument},
+		{"own parent", &domain.TODO{ParentID: &todo.ID}, []string{"parent_id"}, codes.InvalidArgument},
+		{"rule without due date", &domain.TODO{RecurrenceRule: "FREQ=DAILY"}, []string{"recurrence_rule"}, codes.InvalidArgument},
+		{"stale version", &domain.TODO{Title: "Stale"}, []string{"title"}, codes.Aborted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectedVersion := int64(0)
+			if tt.want == codes.Aborted {
+				expectedVersion = 1
+			}
+			if _, err := service.PatchTODO(ctx, "user-123", todo.ID, tt.values, tt.paths, false, expectedVersion); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	completed, err := service.PatchTODO(ctx, "user-123", todo.ID, &domain.TODO{Status: commonv1.Status_STATUS_COMPLETED}, []string{"status"}, false, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if completed.CompletedAt == nil {
+		t.Error("Expected completing the TODO through a patch to set CompletedAt")
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// trashPurgeBatchSize is the maximum number of TODOs purged per purge run
+const trashPurgeBatchSize = 100
+
+// TrashService handles TODOs in the trash. Deleted TODOs can be restored by
+// their owner until the retention period has passed, after which they are
+// purged together with their subtasks and media.
+type TrashService struct {
+	repo             domain.TODORepository
+	storage          StorageService
+	retention        time.Duration
+	websocketService *WebSocketService
+}
+
+// NewTrashService creates a new trash service
+func NewTrashService(repo domain.TODORepository, storage StorageService, retention time.Duration, websocketService *WebSocketService) *TrashService {
+	return &TrashService{
+		repo:             repo,
+		storage:          storage,
+		retention:        retention,
+		websocketService: websocketService,
+	}
+}
+
+// ListTrash retrieves the user's TODOs in the trash, most recently deleted
+// first
+func (s *TrashService) ListTrash(ctx context.Context, userID string, page, pageSize int32) ([]*domain.TODO, *domain.PaginationResult, error) {
+	todos, pagination, err := s.repo.ListTrash(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list trash: %v", err))
+	}
+
+	return todos, pagination, nil
+}
+
+// RestoreTODO takes one of the user's TODOs out of the trash, together with
+// the subtasks that were deleted with it. A subtask deleted on its own can
+// only be restored once its parent is no longer in the trash.
+func (s *TrashService) RestoreTODO(ctx context.Context, userID, id string) (*domain.TODO, error) {
+	todo, err := s.getTrashedTODO(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if todo.ParentID != nil && *todo.ParentID != "" {
+		parentExists, err := s.repo.Exists(ctx, *todo.ParentID)
+		if err != nil {
+			return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to validate parent: %v", err))
+		}
+		if !parentExists {
+			return nil, grpcstatus.Error(codes.FailedPrecondition, "the parent todo is in the trash; restore it first")
+		}
+	}
+
+	if err := s.repo.Restore(ctx, id); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to restore todo: %v", err))
+	}
+
+	restored, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to get restored todo: %v", err))
+	}
+
+	if s.websocketService != nil {
+		s.websocketService.BroadcastTODOUpdate(ctx, restored, "restored")
+	}
+
+	return restored, nil
+}
+
+// PurgeTODO permanently deletes one of the user's TODOs in the trash
+func (s *TrashService) PurgeTODO(ctx context.Context, userID, id string) error {
+	if _, err := s.getTrashedTODO(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.purge(ctx, id); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to purge todo: %v", err))
+	}
+
+	return nil
+}
+
+// PurgeExpired permanently deletes the TODOs that have been in the trash for
+// longer than the retention period and returns how many were purged. A TODO
+// that fails to be purged is logged and retried on the next run.
+func (s *TrashService) PurgeExpired(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListPurgeable(ctx, time.Now().Add(-s.retention), trashPurgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired todos: %w", err)
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := s.purge(ctx, id); err != nil {
+			log.Printf("Failed to purge todo %s: %v", id, err)
+			continue
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// StartPurging runs PurgeExpired every interval until ctx is done
+func (s *TrashService) StartPurging(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purged, err := s.PurgeExpired(ctx)
+				if err != nil {
+					log.Printf("Failed to purge trash: %v", err)
+				} else if purged > 0 {
+					log.Printf("Purged %d todos from the trash", purged)
+				}
+			}
+		}
+	}()
+}
+
+// getTrashedTODO retrieves a TODO in the trash owned by userID
+func (s *TrashService) getTrashedTODO(ctx context.Context, userID, id string) (*domain.TODO, error) {
+	if id == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "id is required")
+	}
+
+	todo, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, fmt.Sprintf("todo not found in trash: %v", err))
+	}
+	if todo.UserID != userID {
+		return nil, grpcstatus.Error(codes.PermissionDenied, "only the owner can restore or purge a deleted todo")
+	}
+
+	return todo, nil
+}
+
+// purge deletes a TODO in the trash and then the stored files of its media.
+// The TODO is gone once the database rows are, so files that cannot be
+// deleted are only logged.
+func (s *TrashService) purge(ctx context.Context, id string) error {
+	fileURLs, err := s.repo.Purge(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if s.storage == nil {
+		return nil
+	}
+	for _, fileURL := range fileURLs {
+		if err := s.storage.DeleteFile(ctx, fileURL); err != nil {
+			log.Printf("Failed to delete file %s of purged todo %s: %v", fileURL, id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func TestTrashService_DeleteAndRestoreSubtree(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	trashService := NewTrashService(repo, nil, time.Hour, nil)
+	ctx := context.Background()
+
+	parent, _ := todoService.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	child, _ := todoService.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &parent.ID, nil)
+
+	if err := todoService.DeleteTODO(ctx, parent.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := todoService.GetTODO(ctx, child.ID); err == nil {
+		t.Error("Expected the subtask to be deleted with its parent")
+	}
+
+	trash, _, err := trashService.ListTrash(ctx, "user-123", 1, 20)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(trash) != 1 || trash[0].ID != parent.ID {
+		t.Fatalf("Expected only the parent to be listed in the trash, got %d todos", len(trash))
+	}
+
+	restored, err := trashService.RestoreTODO(ctx, "user-123", parent.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if restored.DeletedAt != nil {
+		t.Error("Expected the restored TODO not to be deleted")
+	}
+	if _, err := todoService.GetTODO(ctx, child.ID); err != nil {
+		t.Errorf("Expected the subtask to be restored with its parent: %v", err)
+	}
+}
+
+func TestTrashService_RestoreTODO_Errors(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	trashService := NewTrashService(repo, nil, time.Hour, nil)
+	ctx := context.Background()
+
+	parent, _ := todoService.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	child, _ := todoService.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &parent.ID, nil)
+	live, _ := todoService.CreateTODO(ctx, "user-123", "Live", nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// The subtask is deleted on its own before its parent
+	todoService.DeleteTODO(ctx, child.ID)
+	repo.trash[child.ID].DeletedAt = timePtr(time.Now().Add(-time.Minute))
+	todoService.DeleteTODO(ctx, parent.ID)
+
+	tests := []struct {
+		name   string
+		userID string
+		id     string
+		want   codes.Code
+	}{
+		{"missing id", "user-123", "", codes.InvalidArgument},
+		{"not in trash", "user-123", live.ID, codes.NotFound},
+		{"other user", "user-456", parent.ID, codes.PermissionDenied},
+		{"parent in trash", "user-123", child.ID, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := trashService.RestoreTODO(ctx, tt.userID, tt.id); grpcstatus.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Restoring the parent leaves the separately deleted subtask in the trash
+	if _, err := trashService.RestoreTODO(ctx, "user-123", parent.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := repo.trash[child.ID]; !ok {
+		t.Error("Expected the separately deleted subtask to stay in the trash")
+	}
+}
+
+func TestTrashService_PurgeTODO(t *testing.T) {
+	repo := NewMockRepository()
+	storage := NewMockStorageService()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	trashService := NewTrashService(repo, storage, time.Hour, nil)
+	ctx := context.Background()
+
+	parent, _ := todoService.CreateTODO(ctx, "user-123", "Parent", nil, nil, nil, nil, nil, nil, nil, nil)
+	child, _ := todoService.CreateTODO(ctx, "user-123", "Child", nil, nil, nil, nil, nil, nil, &parent.ID, nil)
+	repo.mediaURLs[parent.ID] = []string{"https://s3.amazonaws.com/bucket/parent.png"}
+	repo.mediaURLs[child.ID] = []string{"https://s3.amazonaws.com/bucket/child.png"}
+	storage.files["https://s3.amazonaws.com/bucket/parent.png"] = "mock-file-content"
+	storage.files["https://s3.amazonaws.com/bucket/child.png"] = "mock-file-content"
+
+	if err := trashService.PurgeTODO(ctx, "user-123", parent.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for a TODO that is not in the trash, got %v", err)
+	}
+
+	todoService.DeleteTODO(ctx, parent.ID)
+	if err := trashService.PurgeTODO(ctx, "user-456", parent.ID); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for another user, got %v", err)
+	}
+	if err := trashService.PurgeTODO(ctx, "user-123", parent.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(repo.trash) != 0 {
+		t.Errorf("Expected the trash to be empty, got %d todos", len(repo.trash))
+	}
+	if len(storage.files) != 0 {
+		t.Errorf("Expected the media files to be deleted, got %d left", len(storage.files))
+	}
+}
+
+func TestTrashService_PurgeExpired(t *testing.T) {
+	repo := NewMockRepository()
+	todoService := NewTODOService(repo, nil, nil, nil, nil)
+	trashService := NewTrashService(repo, nil, 24*time.Hour, nil)
+	ctx := context.Background()
+
+	expired, _ := todoService.CreateTODO(ctx, "user-123", "Expired", nil, nil, nil, nil, nil, nil, nil, nil)
+	recent, _ := todoService.CreateTODO(ctx, "user-123", "Recent", nil, nil, nil, nil, nil, nil, nil, nil)
+	todoService.DeleteTODO(ctx, expired.ID)
+	todoService.DeleteTODO(ctx, recent.ID)
+	repo.trash[expired.ID].DeletedAt = timePtr(time.Now().Add(-48 * time.Hour))
+
+	purged, err := trashService.PurgeExpired(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("Expected 1 todo to be purged, got %d", purged)
+	}
+	if _, ok := repo.trash[expired.ID]; ok {
+		t.Error("Expected the expired todo to be purged")
+	}
+	if _, ok := repo.trash[recent.ID]; !ok {
+		t.Error("Expected the recently deleted todo to stay in the trash")
+	}
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+const (
+	// twoFactorChallengePurpose marks the tokens issued by the password step
+	// of a two-factor login
+	twoFactorChallengePurpose = "2fa"
+	// twoFactorChallengeTTL is how long a login challenge can be redeemed
+	twoFactorChallengeTTL = 5 * time.Minute
+	// recoveryCodeCount is the number of recovery codes issued on enrollment
+	recoveryCodeCount = 10
+)
+
+// TwoFactorService handles TOTP enrollment and the second step of a
+// two-factor login, whether the first step was a password or an SSO provider
+type TwoFactorService struct {
+	userRepo      domain.UserRepository
+	twoFactorRepo domain.TwoFactorRepository
+	authService   *AuthService
+	issuer        string
+}
+
+// TwoFactorEnrollment is a pending TOTP secret to be added to an authenticator app
+type TwoFactorEnrollment struct {
+	Secret string
+	URI    string
+}
+
+// NewTwoFactorService creates a new two-factor service. issuer is the name
+// authenticator apps display for the account.
+func NewTwoFactorService(
+	userRepo domain.UserRepository,
+	twoFactorRepo domain.TwoFactorRepository,
+	authService *AuthService,
+	issuer string,
+) *TwoFactorService {
+	return &TwoFactorService{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		authService:   authService,
+		issuer:        issuer,
+	}
+}
+
+// BeginEnrollment generates a new TOTP secret for the user. It takes effect
+// once confirmed with a code from the authenticator app.
+func (s *TwoFactorService) BeginEnrollment(ctx context.Context, userID string) (*TwoFactorEnrollment, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "user not found")
+	}
+	if user.TwoFactorEnabled {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.twoFactorRepo.SavePendingEnrollment(ctx, domain.NewTOTPEnrollment(user.ID, secret)); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to save enrollment: %v", err))
+	}
+
+	return &TwoFactorEnrollment{
+		Secret: secret,
+		URI:    auth.TOTPURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables two-factor authentication once the user proves
+// their authenticator app is set up. It returns the recovery codes, which
+// are only stored hashed and cannot be shown again.
+func (s *TwoFactorService) ConfirmEnrollment(ctx context.Context, userID, code string) ([]string, error) {
+	enrollment, err := s.twoFactorRepo.GetEnrollment(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "no two-factor enrollment in progress")
+	}
+	if enrollment.IsConfirmed() {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	step, ok := auth.ValidateTOTP(enrollment.Secret, code, time.Now())
+	if !ok {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "invalid two-factor code")
+	}
+
+	recoveryCodes, err := auth.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, err.Error())
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i, recoveryCode := range recoveryCodes {
+		hashes[i] = auth.HashOpaqueToken(recoveryCode)
+	}
+
+	if err := s.twoFactorRepo.Enable(ctx, userID, step, hashes); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to enable two-factor authentication: %v", err))
+	}
+
+	return recoveryCodes, nil
+}
+
+// Disable turns off two-factor authentication. The caller must present a
+// current TOTP or recovery code.
+func (s *TwoFactorService) Disable(ctx context.Context, userID, code string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return grpcstatus.Error(codes.NotFound, "user not found")
+	}
+	if !user.TwoFactorEnabled {
+		return grpcstatus.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	if err := s.verifyCode(ctx, user.ID, code, codes.InvalidArgument); err != nil {
+		return err
+	}
+
+	if err := s.twoFactorRepo.Disable(ctx, user.ID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to disable two-factor authentication: %v", err))
+	}
+
+	return nil
+}
+
+// CompleteLogin exchanges a login challenge and a TOTP or recovery code for
+// a new session. A challenge can be redeemed only once, whether or not the
+// code is correct, so every guess requires the password again.
+func (s *TwoFactorService) CompleteLogin(ctx context.Context, challenge, code string, client ClientInfo) (*domain.User, *TokenPair, error) {
+	if challenge == "" {
+		return nil, nil, grpcstatus.Error(codes.InvalidArgument, "challenge token is required")
+	}
+	if code == "" {
+		return nil, nil, grpcstatus.Error(codes.InvalidArgument, "code is required")
+	}
+
+	claims, err := s.authService.jwtMgr.ValidatePurpose(challenge, twoFactorChallengePurpose)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "invalid or expired challenge")
+	}
+
+	revoked, err := s.authService.revocations.IsRevoked(ctx, claims)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unavailable, "failed to check challenge revocation")
+	}
+	if revoked {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "invalid or expired challenge")
+	}
+	if err := s.authService.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to redeem challenge: %v", err))
+	}
+
+	user, err := s.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Unauthenticated, "user not found")
+	}
+	if !user.TwoFactorEnabled {
+		return nil, nil, grpcstatus.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	if err := s.verifyCode(ctx, user.ID, code, codes.Unauthenticated); err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.authService.startSession(ctx, user, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// verifyCode accepts either a TOTP code that has not been used before or an
+// unused recovery code. Rejections are reported with failureCode.
+func (s *TwoFactorService) verifyCode(ctx context.Context, userID, code string, failureCode codes.Code) error {
+	enrollment, err := s.twoFactorRepo.GetEnrollment(ctx, userID)
+	if err != nil || !enrollment.IsConfirmed() {
+		return grpcstatus.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	if step, ok := auth.ValidateTOTP(enrollment.Secret, code, time.Now()); ok {
+		err := s.twoFactorRepo.RecordUsedStep(ctx, userID, step)
+		if errors.Is(err, domain.ErrTOTPCodeReused) {
+			return grpcstatus.Error(failureCode, err.Error())
+		}
+		if err != nil {
+			return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to record code: %v", err))
+		}
+		return nil
+	}
+
+	err = s.twoFactorRepo.ConsumeRecoveryCode(ctx, userID, auth.HashOpaqueToken(auth.NormalizeRecoveryCode(code)))
+	if errors.Is(err, domain.ErrRecoveryCodeInvalid) {
+		return grpcstatus.Error(failureCode, "invalid two-factor code")
+	}
+	if err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to redeem recovery code: %v", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// MockTwoFactorRepository is a mock implementation of TwoFactorRepository for testing
+type MockTwoFactorRepository struct {
+	userRepo      *MockUserRepository
+	enrollments   map[string]*domain.TOTPEnrollment
+	recoveryCodes map[string]map[string]bool
+}
+
+func NewMockTwoFactorRepository(userRepo *MockUserRepository) *MockTwoFactorRepository {
+	return &MockTwoFactorRepository{
+		userRepo:      userRepo,
+		enrollments:   make(map[string]*domain.TOTPEnrollment),
+		recoveryCodes: make(map[string]map[string]bool),
+	}
+}
+
+func (m *MockTwoFactorRepository) GetEnrollment(ctx context.Context, userID string) (*domain.TOTPEnrollment, error) {
+	enrollment, ok := m.enrollments[userID]
+	if !ok {
+		return nil, fmt.Errorf("two-factor enrollment not found")
+	}
+	return enrollment, nil
+}
+
+func (m *MockTwoFactorRepository) SavePendingEnrollment(ctx context.Context, enrollment *domain.TOTPEnrollment) error {
+	if existing, ok := m.enrollments[enrollment.UserID]; ok && existing.IsConfirmed() {
+		return fmt.Errorf("two-factor authentication is already enabled")
+	}
+	m.enrollments[enrollment.UserID] = enrollment
+	return nil
+}
+
+func (m *MockTwoFactorRepository) Enable(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	enrollment, ok := m.enrollments[userID]
+	if !ok || enrollment.IsConfirmed() {
+		return fmt.Errorf("pending two-factor enrollment not found")
+	}
+	now := time.Now()
+	enrollment.ConfirmedAt = &now
+	enrollment.LastUsedStep = step
+
+	m.recoveryCodes[userID] = make(map[string]bool)
+	for _, hash := range recoveryCodeHashes {
+		m.recoveryCodes[userID][hash] = true
+	}
+	m.userRepo.users[userID].TwoFactorEnabled = true
+	return nil
+}
+
+func (m *MockTwoFactorRepository) Disable(ctx context.Context, userID string) error {
+	delete(m.enrollments, userID)
+	delete(m.recoveryCodes, userID)
+	m.userRepo.users[userID].TwoFactorEnabled = false
+	return nil
+}
+
+func (m *MockTwoFactorRepository) RecordUsedStep(ctx context.Context, userID string, step int64) error {
+	enrollment, ok := m.enrollments[userID]
+	if !ok || enrollment.LastUsedStep >= step {
+		return domain.ErrTOTPCodeReused
+	}
+	enrollment.LastUsedStep = step
+	return nil
+}
+
+func (m *MockTwoFactorRepository) ConsumeRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	if !m.recoveryCodes[userID][codeHash] {
+		return domain.ErrRecoveryCodeInvalid
+	}
+	m.recoveryCodes[userID][codeHash] = false
+	return nil
+}
+
+// enableTwoFactor registers a user and enrolls them, returning the user, the
+// TOTP secret and the recovery codes
+func enableTwoFactor(t *testing.T, twoFactorService *TwoFactorService, authService *AuthService) (*domain.User, string, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	enrollment, err := twoFactorService.BeginEnrollment(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("failed to begin enrollment: %v", err)
+	}
+	code, _ := auth.TOTPCode(enrollment.Secret, auth.TOTPStep(time.Now())-1)
+	recoveryCodes, err := twoFactorService.ConfirmEnrollment(ctx, user.ID, code)
+	if err != nil {
+		t.Fatalf("failed to confirm enrollment: %v", err)
+	}
+	return user, enrollment.Secret, recoveryCodes
+}
+
+func newTestTwoFactorService(t *testing.T) (*TwoFactorService, *AuthService) {
+	t.Helper()
+	authService := newTestAuthService(t, nil)
+	userRepo := authService.userRepo.(*MockUserRepository)
+	return NewTwoFactorService(userRepo, NewMockTwoFactorRepository(userRepo), authService, "TODO API"), authService
+}
+
+func TestTwoFactorService_Enrollment(t *testing.T) {
+	ctx := context.Background()
+	twoFactorService, authService := newTestTwoFactorService(t)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	enrollment, err := twoFactorService.BeginEnrollment(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := auth.TOTPURI("TODO API", user.Email, enrollment.Secret); enrollment.URI != want {
+		t.Errorf("URI = %v, want %v", enrollment.URI, want)
+	}
+
+	if _, err := twoFactorService.ConfirmEnrollment(ctx, user.ID, "000000"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for wrong code, got %v", err)
+	}
+	if user.TwoFactorEnabled {
+		t.Fatal("expected two-factor authentication to stay off until confirmed")
+	}
+
+	code, _ := auth.TOTPCode(enrollment.Secret, auth.TOTPStep(time.Now()))
+	recoveryCodes, err := twoFactorService.ConfirmEnrollment(ctx, user.ID, code)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recoveryCodes) != recoveryCodeCount {
+		t.Errorf("got %d recovery codes, want %d", len(recoveryCodes), recoveryCodeCount)
+	}
+	if !user.TwoFactorEnabled {
+		t.Error("expected two-factor authentication to be enabled")
+	}
+
+	if _, err := twoFactorService.BeginEnrollment(ctx, user.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition when already enabled, got %v", err)
+	}
+}
+
+func TestTwoFactorService_Login(t *testing.T) {
+	ctx := context.Background()
+	twoFactorService, authService := newTestTwoFactorService(t)
+	_, secret, recoveryCodes := enableTwoFactor(t, twoFactorService, authService)
+
+	challenge := func() string {
+		t.Helper()
+		result, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+		if err != nil {
+			t.Fatalf("failed to login: %v", err)
+		}
+		if result.Tokens != nil || result.Challenge == nil {
+			t.Fatal("expected a challenge instead of tokens")
+		}
+		return result.Challenge.Token
+	}
+
+	t.Run("challenge is not an access token", func(t *testing.T) {
+		if _, err := authService.ValidateToken(ctx, challenge()); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected Unauthenticated, got %v", err)
+		}
+	})
+
+	t.Run("wrong code burns the challenge", func(t *testing.T) {
+		token := challenge()
+		if _, _, err := twoFactorService.CompleteLogin(ctx, token, "000000", ClientInfo{}); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+		code, _ := auth.TOTPCode(secret, auth.TOTPStep(time.Now()))
+		if _, _, err := twoFactorService.CompleteLogin(ctx, token, code, ClientInfo{}); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected challenge to be single-use, got %v", err)
+		}
+	})
+
+	t.Run("totp code", func(t *testing.T) {
+		code, _ := auth.TOTPCode(secret, auth.TOTPStep(time.Now()))
+		_, tokens, err := twoFactorService.CompleteLogin(ctx, challenge(), code, ClientInfo{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := authService.ValidateToken(ctx, tokens.AccessToken); err != nil {
+			t.Errorf("expected valid access token, got %v", err)
+		}
+
+		if _, _, err := twoFactorService.CompleteLogin(ctx, challenge(), code, ClientInfo{}); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected reused code to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("recovery code", func(t *testing.T) {
+		if _, _, err := twoFactorService.CompleteLogin(ctx, challenge(), recoveryCodes[0], ClientInfo{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, _, err := twoFactorService.CompleteLogin(ctx, challenge(), recoveryCodes[0], ClientInfo{}); grpcstatus.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected recovery code to be single-use, got %v", err)
+		}
+	})
+}
+
+func TestTwoFactorService_Disable(t *testing.T) {
+	ctx := context.Background()
+	twoFactorService, authService := newTestTwoFactorService(t)
+	user, _, recoveryCodes := enableTwoFactor(t, twoFactorService, authService)
+
+	if err := twoFactorService.Disable(ctx, user.ID, "000000"); grpcstatus.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for wrong code, got %v", err)
+	}
+	if err := twoFactorService.Disable(ctx, user.ID, recoveryCodes[1]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+	if result.Tokens == nil {
+		t.Error("expected tokens once two-factor authentication is disabled")
+	}
+}
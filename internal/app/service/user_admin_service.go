@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// UserAdminService handles the management of user accounts by system
+// administrators. Callers must be authorized as system administrators.
+// Changes to accounts are recorded in the activity log under the
+// administrator who made them.
+type UserAdminService struct {
+	userRepo       domain.UserRepository
+	activityRepo   domain.ActivityRepository
+	authService    *AuthService
+	accountService *AccountService
+	loginGuard     *LoginGuard
+}
+
+// NewUserAdminService creates a new user admin service. loginGuard may be nil
+// if logins are not throttled.
+func NewUserAdminService(
+	userRepo domain.UserRepository,
+	activityRepo domain.ActivityRepository,
+	authService *AuthService,
+	accountService *AccountService,
+	loginGuard *LoginGuard,
+) *UserAdminService {
+	return &UserAdminService{
+		userRepo:       userRepo,
+		activityRepo:   activityRepo,
+		authService:    authService,
+		accountService: accountService,
+		loginGuard:     loginGuard,
+	}
+}
+
+// ListUsers lists and searches users
+func (s *UserAdminService) ListUsers(ctx context.Context, options domain.UserListOptions) ([]*domain.User, *domain.PaginationResult, error) {
+	users, pagination, err := s.userRepo.List(ctx, options)
+	if err != nil {
+		return nil, nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to list users: %v", err))
+	}
+
+	return users, pagination, nil
+}
+
+// UnlockUser lifts a login lockout of a user's account
+func (s *UserAdminService) UnlockUser(ctx context.Context, adminID, userID string) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if s.loginGuard == nil {
+		return nil
+	}
+	return s.loginGuard.Unlock(ctx, user, adminID)
+}
+
+// DeactivateUser prevents a user from signing in and ends all of their
+// sessions. Deactivating an inactive user has no effect.
+func (s *UserAdminService) DeactivateUser(ctx context.Context, adminID, userID string) (*domain.User, error) {
+	if userID == adminID {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, "administrators cannot deactivate themselves")
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return user, nil
+	}
+
+	user.Deactivate()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to deactivate user: %v", err))
+	}
+	if err := s.authService.revokeAllSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, adminID, domain.ActivityActionAccountDeactivated, user, nil)
+	return user, nil
+}
+
+// ReactivateUser allows a deactivated user to sign in again. Reactivating an
+// active user has no effect.
+func (s *UserAdminService) ReactivateUser(ctx context.Context, adminID, userID string) (*domain.User, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsActive {
+		return user, nil
+	}
+
+	user.Reactivate()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to reactivate user: %v", err))
+	}
+
+	s.audit(ctx, adminID, domain.ActivityActionAccountReactivated, user, nil)
+	return user, nil
+}
+
+// ForcePasswordReset invalidates a user's password, ends all of their
+// sessions, revokes their personal access tokens and emails them a password
+// reset link. The password stays invalid even if the email cannot be sent;
+// the user can then request another link. Deactivated users are not emailed.
+func (s *UserAdminService) ForcePasswordReset(ctx context.Context, adminID, userID string) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	user.PasswordHash = ""
+	user.UpdatedAt = time.Now()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to invalidate password: %v", err))
+	}
+	if err := s.accountService.revokeCredentials(ctx, user.ID); err != nil {
+		return err
+	}
+
+	s.audit(ctx, adminID, domain.ActivityActionPasswordResetForced, user, nil)
+
+	if !user.IsActive {
+		return nil
+	}
+	return s.accountService.SendPasswordReset(ctx, user)
+}
+
+// DeleteUser permanently deletes a user right away, without the grace period
+// of a deletion requested by the user. Their teams and shared TODOs are
+// handed over to other members as described by UserRepository.Delete.
+func (s *UserAdminService) DeleteUser(ctx context.Context, adminID, userID string) error {
+	if userID == adminID {
+		return grpcstatus.Error(codes.FailedPrecondition, "administrators cannot delete themselves")
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Access tokens are revoked first; they would otherwise stay valid until
+	// they expire
+	if err := s.authService.revokeAllSessions(ctx, user.ID); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Delete(ctx, user.ID); err != nil {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to delete user: %v", err))
+	}
+
+	s.audit(ctx, adminID, domain.ActivityActionAccountDeleted, user, nil)
+	return nil
+}
+
+// getUser retrieves the user an administrative action applies to
+func (s *UserAdminService) getUser(ctx context.Context, userID string) (*domain.User, error) {
+	if userID == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.NotFound, "user not found")
+	}
+
+	return user, nil
+}
+
+// audit records an administrative action on a user's account. Failures are
+// logged and do not fail the action.
+func (s *UserAdminService) audit(ctx context.Context, adminID, action string, user *domain.User, details map[string]interface{}) {
+	entry := domain.NewActivityLog(adminID, action, domain.ActivityResourceUser, nil, &user.ID, details)
+	if err := s.activityRepo.Create(ctx, entry); err != nil {
+		log.Printf("Failed to record %s of user %s: %v", action, user.ID, err)
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func newTestUserAdminService(t *testing.T) (*UserAdminService, *AuthService, *MockActivityRepository, *MockMailer) {
+	t.Helper()
+	accountService, authService, m := newTestAccountService(t)
+	activityRepo := &MockActivityRepository{}
+	return NewUserAdminService(authService.userRepo, activityRepo, authService, accountService, nil), authService, activityRepo, m
+}
+
+func TestUserAdminService_ListUsers(t *testing.T) {
+	ctx := context.Background()
+	adminService, authService, _, _ := newTestUserAdminService(t)
+
+	for _, name := range []string{"alice", "bob", "carol"} {
+		if _, err := authService.Register(ctx, name+"@example.com", name, "password123", ""); err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+	}
+	bob, _ := authService.userRepo.GetByEmail(ctx, "bob@example.com")
+	if _, err := adminService.DeactivateUser(ctx, "admin-1", bob.ID); err != nil {
+		t.Fatalf("failed to deactivate user: %v", err)
+	}
+
+	active, inactive := true, false
+	tests := []struct {
+		name       string
+		options    domain.UserListOptions
+		wantEmails []string
+		wantTotal  int32
+		wantNext   bool
+	}{
+		{
+			name:       "all users",
+			options:    domain.UserListOptions{},
+			wantEmails: []string{"alice@example.com", "bob@example.com", "carol@example.com"},
+			wantTotal:  3,
+		},
+		{
+			name:       "search",
+			options:    domain.UserListOptions{Query: "CAR"},
+			wantEmails: []string{"carol@example.com"},
+			wantTotal:  1,
+		},
+		{
+			name:       "active only",
+			options:    domain.UserListOptions{IsActive: &active},
+			wantEmails: []string{"alice@example.com", "carol@example.com"},
+			wantTotal:  2,
+		},
+		{
+			name:       "deactivated only",
+			options:    domain.UserListOptions{IsActive: &inactive},
+			wantEmails: []string{"bob@example.com"},
+			wantTotal:  1,
+		},
+		{
+			name:       "first page",
+			options:    domain.UserListOptions{Page: 1, PageSize: 2},
+			wantEmails: []string{"alice@example.com", "bob@example.com"},
+			wantTotal:  3,
+			wantNext:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, pagination, err := adminService.ListUsers(ctx, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(users) != len(tt.wantEmails) {
+				t.Fatalf("got %d users, want %d", len(users), len(tt.wantEmails))
+			}
+			for i, user := range users {
+				if user.Email != tt.wantEmails[i] {
+					t.Errorf("users[%d] = %v, want %v", i, user.Email, tt.wantEmails[i])
+				}
+			}
+			if pagination.TotalItems != tt.wantTotal || pagination.HasNext != tt.wantNext {
+				t.Errorf("pagination = %+v, want %d items, has next %v", pagination, tt.wantTotal, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestUserAdminService_DeactivateUser(t *testing.T) {
+	ctx := context.Background()
+	adminService, authService, activityRepo, _ := newTestUserAdminService(t)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+	result, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{})
+	if err != nil {
+		t.Fatalf("failed to login: %v", err)
+	}
+
+	if _, err := adminService.DeactivateUser(ctx, user.ID, user.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition when deactivating oneself, got %v", err)
+	}
+	if _, err := adminService.DeactivateUser(ctx, "admin-1", "missing"); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	if _, err := adminService.DeactivateUser(ctx, "admin-1", user.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := authService.ValidateToken(ctx, result.Tokens.AccessToken); err == nil {
+		t.Error("expected access token to be rejected after deactivation")
+	}
+	if _, _, err := authService.RefreshToken(ctx, result.Tokens.RefreshToken); err == nil {
+		t.Error("expected refresh token to be rejected after deactivation")
+	}
+	if _, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{}); grpcstatus.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for deactivated user, got %v", err)
+	}
+
+	if _, err := adminService.ReactivateUser(ctx, "admin-1", user.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{}); err != nil {
+		t.Errorf("expected reactivated user to log in, got %v", err)
+	}
+
+	if len(activityRepo.logs) != 2 ||
+		activityRepo.logs[0].Action != domain.ActivityActionAccountDeactivated ||
+		activityRepo.logs[1].Action != domain.ActivityActionAccountReactivated {
+		t.Errorf("unexpected audit entries: %+v", activityRepo.logs)
+	}
+	if activityRepo.logs[0].UserID != "admin-1" {
+		t.Errorf("audit actor = %v, want admin-1", activityRepo.logs[0].UserID)
+	}
+}
+
+func TestUserAdminService_ForcePasswordReset(t *testing.T) {
+	ctx := context.Background()
+	adminService, authService, _, m := newTestUserAdminService(t)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	accountService := adminService.accountService
+	_, secret, err := accountService.accessTokens.Create(ctx, user.ID, "ci", []string{auth.ScopeTODOsRead}, nil)
+	if err != nil {
+		t.Fatalf("failed to create personal access token: %v", err)
+	}
+
+	if err := adminService.ForcePasswordReset(ctx, "admin-1", user.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := authService.Login(ctx, "test@example.com", "password123", ClientInfo{}); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected old password to be rejected, got %v", err)
+	}
+	if _, _, err := accountService.accessTokens.VerifyPersonalAccessToken(ctx, secret); grpcstatus.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected personal access token to be revoked, got %v", err)
+	}
+
+	if err := accountService.ConfirmPasswordReset(ctx, m.lastToken(t), "newpassword456"); err != nil {
+		t.Fatalf("failed to reset password: %v", err)
+	}
+	if _, err := authService.Login(ctx, "test@example.com", "newpassword456", ClientInfo{}); err != nil {
+		t.Errorf("expected login with new password, got %v", err)
+	}
+}
+
+func TestUserAdminService_DeleteUser(t *testing.T) {
+	ctx := context.Background()
+	adminService, authService, activityRepo, _ := newTestUserAdminService(t)
+
+	user, err := authService.Register(ctx, "test@example.com", "testuser", "password123", "Test User")
+	if err != nil {
+		t.Fatalf("failed to register user: %v", err)
+	}
+
+	if err := adminService.DeleteUser(ctx, user.ID, user.ID); grpcstatus.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition when deleting oneself, got %v", err)
+	}
+	if err := adminService.DeleteUser(ctx, "admin-1", user.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := authService.userRepo.GetByID(ctx, user.ID); err == nil {
+		t.Error("expected user to be deleted")
+	}
+	if err := adminService.DeleteUser(ctx, "admin-1", user.ID); grpcstatus.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for deleted user, got %v", err)
+	}
+
+	if len(activityRepo.logs) != 1 || activityRepo.logs[0].Action != domain.ActivityActionAccountDeleted {
+		t.Errorf("unexpected audit entries: %+v", activityRepo.logs)
+	}
+}
@@ -106,6 +106,13 @@ func (s *WebSocketService) shouldSendToClient(client *WebSocketClient, message W
 				}
 			}
 		}
+		if teamIDs, exists := teamMessage["team_ids"].([]string); exists {
+			for _, teamID := range teamIDs {
+				if containsString(client.TeamIDs, teamID) {
+					return true
+				}
+			}
+		}
 	}
 
 	return false
@@ -161,6 +168,52 @@ func (s *WebSocketService) BroadcastUserNotification(ctx context.Context, userID
 	s.broadcast <- message
 }
 
+// BroadcastEvent sends an arbitrary event to relevant clients. If the payload
+// contains a "team_id" entry, or a "team_ids" entry listing several teams, the
+// event is delivered to those teams' members.
+func (s *WebSocketService) BroadcastEvent(ctx context.Context, eventType string, payload map[string]interface{}, userID string) {
+	message := WebSocketMessage{
+		Type:      eventType,
+		Payload:   payload,
+		UserID:    userID,
+		Timestamp: time.Now(),
+	}
+
+	s.broadcast <- message
+}
+
+// ConnectedUserIDs returns the IDs of users with an open connection,
+// optionally restricted to members of a team
+func (s *WebSocketService) ConnectedUserIDs(teamID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var userIDs []string
+	for client := range s.clients {
+		if seen[client.UserID] {
+			continue
+		}
+		if teamID != "" && !containsString(client.TeamIDs, teamID) {
+			continue
+		}
+		seen[client.UserID] = true
+		userIDs = append(userIDs, client.UserID)
+	}
+
+	return userIDs
+}
+
+// containsString reports whether a slice contains a value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // WebSocketUpgrader upgrades HTTP connections to WebSocket connections
 var WebSocketUpgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,13 +16,19 @@ type Config struct {
 	Auth     AuthConfig
 	Logging  LoggingConfig
 	Storage  StorageConfig
+	Mail     MailConfig
+	SSO      SSOConfig
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	GRPCPort    int
-	HTTPPort    int
-	Environment string
+	GRPCPort             int
+	HTTPPort             int
+	Environment          string
+	ReminderPollInterval time.Duration // how often due reminders are fired
+	TrashRetention       time.Duration // how long deleted TODOs stay in the trash
+	TrashPurgeInterval   time.Duration // how often expired TODOs are purged from the trash
+	IdempotencyKeyTTL    time.Duration // how long responses are replayed for a repeated idempotency key
 }
 
 // DatabaseConfig holds database configuration
@@ -41,6 +48,10 @@ type DatabaseConfig struct {
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
 	JWTSecret                string
+	JWTSigningAlgorithm      string // "HS256", "RS256" or "EdDSA"
+	JWTPrivateKeyFile        string // PKCS#8 PEM key, used if JWTKeyDir is empty
+	JWTKeyDir                string // directory of PKCS#8 PEM keys shared by all instances
+	JWTKeyRotationInterval   time.Duration
 	AccessTokenExpiry        time.Duration
 	RefreshTokenExpiry       time.Duration
 	PasswordMinLength        int
@@ -48,6 +59,23 @@ type AuthConfig struct {
 	PasswordRequireLowercase bool
 	PasswordRequireNumber    bool
 	PasswordRequireSpecial   bool
+	PasswordDenyListFile     string
+	PasswordHashAlgorithm    string // "argon2id" or "bcrypt"
+	PasswordBcryptCost       int
+	PasswordArgon2Time       int
+	PasswordArgon2Memory     int // KiB
+	PasswordArgon2Threads    int
+	EmailVerificationExpiry  time.Duration
+	PasswordResetExpiry      time.Duration
+	TwoFactorIssuer          string // name shown in authenticator apps
+	LoginMaxFailures         int    // failures per account before lockout; 0 disables
+	LoginIPMaxFailures       int    // failures per client IP before lockout; 0 disables
+	LoginFailureWindow       time.Duration
+	LoginLockoutDuration     time.Duration
+	LoginBaseDelay           time.Duration // delay after a failure, doubled per further failure
+	LoginMaxDelay            time.Duration
+	AccountDeletionGrace     time.Duration // time before a requested deletion is carried out
+	AccountPurgeInterval     time.Duration // how often due deletions are carried out
 }
 
 // LoggingConfig holds logging configuration
@@ -74,13 +102,45 @@ type StorageConfig struct {
 	S3Secret  string
 }
 
+// MailConfig holds outgoing email configuration
+type MailConfig struct {
+	Driver       string // "log" or "smtp"
+	From         string
+	BaseURL      string // base URL of the web app used in email links
+	LogFile      string // file the log driver appends to; stdout if empty
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// SSOConfig holds single sign-on configuration
+type SSOConfig struct {
+	StateTTL  time.Duration // how long a user may take to sign in at the provider
+	Providers []SSOProviderConfig
+}
+
+// SSOProviderConfig holds the configuration of an OpenID Connect provider
+type SSOProviderConfig struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	cfg := &Config{
 		Server: ServerConfig{
-			GRPCPort:    getEnvInt("GRPC_PORT", 50051),
-			HTTPPort:    getEnvInt("HTTP_PORT", 8080),
-			Environment: getEnv("ENVIRONMENT", "development"),
+			GRPCPort:             getEnvInt("GRPC_PORT", 50051),
+			HTTPPort:             getEnvInt("HTTP_PORT", 8080),
+			Environment:          getEnv("ENVIRONMENT", "development"),
+			ReminderPollInterval: getEnvDuration("REMINDER_POLL_INTERVAL", 30*time.Second),
+			TrashRetention:       getEnvDuration("TRASH_RETENTION_PERIOD", 30*24*time.Hour),
+			TrashPurgeInterval:   getEnvDuration("TRASH_PURGE_INTERVAL", time.Hour),
+			IdempotencyKeyTTL:    getEnvDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -95,10 +155,35 @@ func Load() (*Config, error) {
 			ConnMaxIdleTime: getEnvDuration("DB_CONN_MAX_IDLE_TIME", 10*time.Minute),
 		},
 		Auth: AuthConfig{
-			JWTSecret:          getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-			AccessTokenExpiry:  getEnvDuration("ACCESS_TOKEN_EXPIRY", 15*time.Minute),
-			RefreshTokenExpiry: getEnvDuration("REFRESH_TOKEN_EXPIRY", 7*24*time.Hour),
-			PasswordMinLength:  getEnvInt("PASSWORD_MIN_LENGTH", 8),
+			JWTSecret:                getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+			JWTSigningAlgorithm:      getEnv("JWT_SIGNING_ALGORITHM", "HS256"),
+			JWTPrivateKeyFile:        getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			JWTKeyDir:                getEnv("JWT_KEY_DIR", ""),
+			JWTKeyRotationInterval:   getEnvDuration("JWT_KEY_ROTATION_INTERVAL", 0),
+			AccessTokenExpiry:        getEnvDuration("ACCESS_TOKEN_EXPIRY", 15*time.Minute),
+			RefreshTokenExpiry:       getEnvDuration("REFRESH_TOKEN_EXPIRY", 7*24*time.Hour),
+			PasswordMinLength:        getEnvInt("PASSWORD_MIN_LENGTH", 8),
+			PasswordRequireUppercase: getEnvBool("PASSWORD_REQUIRE_UPPERCASE", true),
+			PasswordRequireLowercase: getEnvBool("PASSWORD_REQUIRE_LOWERCASE", true),
+			PasswordRequireNumber:    getEnvBool("PASSWORD_REQUIRE_NUMBER", true),
+			PasswordRequireSpecial:   getEnvBool("PASSWORD_REQUIRE_SPECIAL", true),
+			PasswordDenyListFile:     getEnv("PASSWORD_DENYLIST_FILE", ""),
+			PasswordHashAlgorithm:    getEnv("PASSWORD_HASH_ALGORITHM", "argon2id"),
+			PasswordBcryptCost:       getEnvInt("PASSWORD_BCRYPT_COST", 10),
+			PasswordArgon2Time:       getEnvInt("PASSWORD_ARGON2_TIME", 2),
+			PasswordArgon2Memory:     getEnvInt("PASSWORD_ARGON2_MEMORY", 19456),
+			PasswordArgon2Threads:    getEnvInt("PASSWORD_ARGON2_THREADS", 1),
+			EmailVerificationExpiry:  getEnvDuration("EMAIL_VERIFICATION_EXPIRY", 24*time.Hour),
+			PasswordResetExpiry:      getEnvDuration("PASSWORD_RESET_EXPIRY", time.Hour),
+			TwoFactorIssuer:          getEnv("TWO_FACTOR_ISSUER", "TODO API"),
+			LoginMaxFailures:         getEnvInt("LOGIN_MAX_FAILURES", 5),
+			LoginIPMaxFailures:       getEnvInt("LOGIN_IP_MAX_FAILURES", 50),
+			LoginFailureWindow:       getEnvDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			LoginLockoutDuration:     getEnvDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			LoginBaseDelay:           getEnvDuration("LOGIN_BASE_DELAY", time.Second),
+			LoginMaxDelay:            getEnvDuration("LOGIN_MAX_DELAY", 30*time.Second),
+			AccountDeletionGrace:     getEnvDuration("ACCOUNT_DELETION_GRACE_PERIOD", 30*24*time.Hour),
+			AccountPurgeInterval:     getEnvDuration("ACCOUNT_PURGE_INTERVAL", time.Hour),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -118,11 +203,59 @@ func Load() (*Config, error) {
 			S3Key:     getEnv("STORAGE_S3_KEY", ""),
 			S3Secret:  getEnv("STORAGE_S3_SECRET", ""),
 		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "log"),
+			From:         getEnv("MAIL_FROM", "noreply@todo-api.local"),
+			BaseURL:      getEnv("APP_BASE_URL", "http://localhost:8080"),
+			LogFile:      getEnv("MAIL_LOG_FILE", ""),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		},
+		SSO: SSOConfig{
+			StateTTL: getEnvDuration("SSO_STATE_TTL", 10*time.Minute),
+		},
+	}
+
+	providers, err := loadSSOProviders(cfg.Mail.BaseURL)
+	if err != nil {
+		return nil, err
 	}
+	cfg.SSO.Providers = providers
 
 	return cfg, nil
 }
 
+// loadSSOProviders loads the providers listed in SSO_PROVIDERS. Each provider
+// is configured through SSO_<NAME>_* variables.
+func loadSSOProviders(baseURL string) ([]SSOProviderConfig, error) {
+	var providers []SSOProviderConfig
+	for _, name := range splitList(getEnv("SSO_PROVIDERS", "")) {
+		prefix := "SSO_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		provider := SSOProviderConfig{
+			Name:         name,
+			IssuerURL:    getEnv(prefix+"ISSUER_URL", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", strings.TrimRight(baseURL, "/")+"/sso/"+name+"/callback"),
+			Scopes:       splitList(getEnv(prefix+"SCOPES", "profile,email")),
+		}
+		if provider.IssuerURL == "" || provider.ClientID == "" {
+			return nil, fmt.Errorf("sso provider %s requires %sISSUER_URL and %sCLIENT_ID", name, prefix, prefix)
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// splitList splits a comma or space separated list
+func splitList(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
 // DSN returns the database connection string
 func (d *DatabaseConfig) DSN() string {
 	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
@@ -145,6 +278,15 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
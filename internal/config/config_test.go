@@ -166,6 +166,10 @@ func TestLoadConfig_Defaults(t *testing.T) {
 		t.Errorf("Auth.RefreshTokenExpiry = %v, want %v", config.Auth.RefreshTokenExpiry, 7*24*time.Hour)
 	}
 
+	if !config.Auth.PasswordRequireUppercase || !config.Auth.PasswordRequireSpecial {
+		t.Errorf("Auth password character class requirements should default to true")
+	}
+
 	if config.Storage.S3Bucket != "" {
 		t.Errorf("Storage.S3Bucket = %v, want %v", config.Storage.S3Bucket, "")
 	}
@@ -385,3 +389,37 @@ func TestGetEnvDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadSSOProviders(t *testing.T) {
+	t.Setenv("SSO_PROVIDERS", "corp, okta-eu")
+	t.Setenv("SSO_CORP_ISSUER_URL", "https://login.corp.example.com")
+	t.Setenv("SSO_CORP_CLIENT_ID", "todo-api")
+	t.Setenv("SSO_CORP_SCOPES", "profile email groups")
+	t.Setenv("SSO_OKTA_EU_ISSUER_URL", "https://example.okta.com")
+	t.Setenv("SSO_OKTA_EU_CLIENT_ID", "todo-api-eu")
+	t.Setenv("SSO_OKTA_EU_REDIRECT_URL", "https://todo.example.com/callback")
+
+	providers, err := loadSSOProviders("https://todo.example.com/")
+	if err != nil {
+		t.Fatalf("loadSSOProviders() error = %v", err)
+	}
+	if len(providers) != 2 {
+		t.Fatalf("loadSSOProviders() returned %d providers, want 2", len(providers))
+	}
+
+	corp := providers[0]
+	if corp.RedirectURL != "https://todo.example.com/sso/corp/callback" {
+		t.Errorf("RedirectURL = %v, want default callback URL", corp.RedirectURL)
+	}
+	if len(corp.Scopes) != 3 || corp.Scopes[2] != "groups" {
+		t.Errorf("Scopes = %v, want [profile email groups]", corp.Scopes)
+	}
+	if providers[1].RedirectURL != "https://todo.example.com/callback" {
+		t.Errorf("RedirectURL = %v, want configured URL", providers[1].RedirectURL)
+	}
+
+	t.Setenv("SSO_OKTA_EU_CLIENT_ID", "")
+	if _, err := loadSSOProviders("https://todo.example.com"); err == nil {
+		t.Error("expected error for provider without client id")
+	}
+}
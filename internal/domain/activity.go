@@ -7,11 +7,24 @@ import (
 	"github.com/google/uuid"
 )
 
+// Activity log actions for user accounts
+const (
+	ActivityActionAccountLocked       = "account_locked"
+	ActivityActionAccountUnlocked     = "account_unlocked"
+	ActivityActionAccountDeactivated  = "account_deactivated"
+	ActivityActionAccountReactivated  = "account_reactivated"
+	ActivityActionPasswordResetForced = "password_reset_forced"
+	ActivityActionAccountDeleted      = "account_deleted"
+)
+
+// ActivityResourceUser is the resource type of activity on user accounts
+const ActivityResourceUser = "user"
+
 // ActivityLog represents an activity log entry
 type ActivityLog struct {
 	ID           string
 	TeamID       *string
-	UserID       string
+	UserID       string // Empty once the user has been deleted
 	Action       string
 	ResourceType string
 	ResourceID   *string
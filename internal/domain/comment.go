@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Comment is a message in the discussion of a TODO. Replies to a comment
+// form a thread below it.
+type Comment struct {
+	ID       string
+	TODOID   string
+	UserID   string // Empty once the author has been deleted
+	ParentID *string
+	Content  string
+	// ReplyCount is the number of direct replies, as read from the repository
+	ReplyCount int32
+	EditedAt   *time.Time
+	// DeletedAt is set on deleted comments kept because they have replies
+	DeletedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommentListOptions represents options for listing the comments of a TODO
+type CommentListOptions struct {
+	TODOID string
+	// ParentID selects the replies to a comment; top-level comments are
+	// listed if it is nil
+	ParentID *string
+	Page     int32
+	PageSize int32
+}
+
+// NewComment creates a new comment, replying to parentID if it is set
+func NewComment(todoID, userID, content string, parentID *string) *Comment {
+	now := time.Now()
+	return &Comment{
+		ID:        uuid.New().String(),
+		TODOID:    todoID,
+		UserID:    userID,
+		ParentID:  parentID,
+		Content:   content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsDeleted returns true if the comment was deleted
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// Edit replaces the content of the comment
+func (c *Comment) Edit(content string) {
+	now := time.Now()
+	c.Content = content
+	c.EditedAt = &now
+	c.UpdatedAt = now
+}
+
+// MarkDeleted clears the content of a comment that is kept so that its
+// replies stay in their thread
+func (c *Comment) MarkDeleted() {
+	now := time.Now()
+	c.Content = ""
+	c.DeletedAt = &now
+	c.UpdatedAt = now
+}
@@ -18,7 +18,7 @@ type Media struct {
 	MimeType     string
 	ThumbnailURL string
 	Duration     int32
-	UploadedBy   string
+	UploadedBy   string // Empty once the uploader has been deleted
 	UploadedAt   time.Time
 }
 
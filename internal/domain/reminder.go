@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reminder notifies a user about a TODO once, at a fixed time or a fixed
+// period before the TODO is due. Relative reminders follow changes of the
+// due date until they fire.
+type Reminder struct {
+	ID     string
+	TODOID string
+	UserID string
+	// BeforeDue is set for reminders relative to the TODO's due date
+	BeforeDue *time.Duration
+	// RemindAt is set for absolute and snoozed reminders and takes precedence
+	// over BeforeDue
+	RemindAt *time.Time
+	// FireAt is when the reminder fires, as derived from RemindAt or the
+	// TODO's due date. It is nil for a relative reminder on a TODO without a
+	// due date.
+	FireAt      *time.Time
+	FiredAt     *time.Time
+	DismissedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewReminder creates a reminder that fires at remindAt
+func NewReminder(todoID, userID string, remindAt time.Time) *Reminder {
+	reminder := newReminder(todoID, userID)
+	reminder.RemindAt = &remindAt
+	reminder.FireAt = &remindAt
+	return reminder
+}
+
+// NewRelativeReminder creates a reminder that fires beforeDue before the
+// TODO's due date
+func NewRelativeReminder(todoID, userID string, beforeDue time.Duration, dueDate *time.Time) *Reminder {
+	reminder := newReminder(todoID, userID)
+	reminder.BeforeDue = &beforeDue
+	if dueDate != nil {
+		fireAt := dueDate.Add(-beforeDue)
+		reminder.FireAt = &fireAt
+	}
+	return reminder
+}
+
+func newReminder(todoID, userID string) *Reminder {
+	now := time.Now()
+	return &Reminder{
+		ID:        uuid.New().String(),
+		TODOID:    todoID,
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsDismissed returns true if the reminder was dismissed
+func (r *Reminder) IsDismissed() bool {
+	return r.DismissedAt != nil
+}
+
+// Snooze makes the reminder fire again at until, whether or not it has
+// already fired
+func (r *Reminder) Snooze(until time.Time) {
+	r.RemindAt = &until
+	r.FireAt = &until
+	r.FiredAt = nil
+	r.UpdatedAt = time.Now()
+}
+
+// Dismiss stops the reminder from firing
+func (r *Reminder) Dismiss() {
+	now := time.Now()
+	r.DismissedAt = &now
+	r.UpdatedAt = now
+}
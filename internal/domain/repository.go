@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 )
@@ -17,16 +18,24 @@ type TODORepository interface {
 	// Update updates an existing TODO
 	Update(ctx context.Context, todo *TODO) error
 
-	// Delete deletes a TODO by ID
+	// UpdateMany updates several TODOs in one transaction, in which it also
+	// sets the order keys of other TODOs by ID. If any of the TODOs cannot be
+	// updated, none is, and a *BatchSaveError names the TODO.
+	UpdateMany(ctx context.Context, todos []*TODO, orderKeys map[string]string) error
+
+	// CompleteOccurrence updates a completed occurrence of a recurring TODO
+	// and creates the TODOs that continue its series, parents before their
+	// subtasks, in one transaction. If the occurrence cannot be updated,
+	// nothing is created.
+	CompleteOccurrence(ctx context.Context, completed *TODO, created []*TODO) error
+
+	// Delete moves a TODO and its subtasks to the trash
 	Delete(ctx context.Context, id string) error
 
 	// List retrieves TODOs with filtering, sorting, and pagination
 	List(ctx context.Context, options TODOListOptions) ([]*TODO, *PaginationResult, error)
 
-	// BulkUpdateStatus updates status for multiple TODOs
-	BulkUpdateStatus(ctx context.Context, ids []string, status commonv1.Status) error
-
-	// BulkDelete deletes multiple TODOs
+	// BulkDelete moves multiple TODOs and their subtasks to the trash
 	BulkDelete(ctx context.Context, ids []string) error
 
 	// Exists checks if a TODO exists by ID
@@ -37,6 +46,124 @@ type TODORepository interface {
 
 	// GetSharedTeams retrieves teams that a TODO is shared with
 	GetSharedTeams(ctx context.Context, todoID string) ([]string, error)
+
+	// ListTrash retrieves a user's TODOs in the trash, most recently deleted
+	// first. Subtasks deleted together with their parent are not listed.
+	ListTrash(ctx context.Context, userID string, page, pageSize int32) ([]*TODO, *PaginationResult, error)
+
+	// GetDeletedByID retrieves a TODO in the trash by ID
+	GetDeletedByID(ctx context.Context, id string) (*TODO, error)
+
+	// Restore takes a TODO out of the trash together with the subtasks that
+	// were deleted with it
+	Restore(ctx context.Context, id string) error
+
+	// Purge permanently deletes a TODO in the trash and its subtasks, and
+	// returns the file URLs of their media attachments
+	Purge(ctx context.Context, id string) ([]string, error)
+
+	// ListPurgeable retrieves the IDs of up to limit TODOs that were moved to
+	// the trash before the given time, excluding subtasks deleted together
+	// with their parent
+	ListPurgeable(ctx context.Context, before time.Time, limit int) ([]string, error)
+
+	// GetTree retrieves a TODO with its subtasks down to depth levels below
+	// it, each with the counts of all of its subtasks however deep
+	GetTree(ctx context.Context, id string, depth int32) (*TODOTreeNode, error)
+
+	// IsDescendant reports whether a TODO is a subtask of ancestorID, at any
+	// depth
+	IsDescendant(ctx context.Context, id, ancestorID string) (bool, error)
+
+	// ListSiblings retrieves the TODOs in one list, in order: the subtasks of
+	// parentID, or the user's top-level TODOs if parentID is nil
+	ListSiblings(ctx context.Context, userID string, parentID *string) ([]*TODO, error)
+
+	// LastOrderKey returns the greatest order key in the list of TODOs that
+	// ListSiblings retrieves, or an empty string if none has a key
+	LastOrderKey(ctx context.Context, userID string, parentID *string) (string, error)
+
+	// SetOrderKeys sets the order keys of TODOs by ID
+	SetOrderKeys(ctx context.Context, keys map[string]string) error
+}
+
+// ReminderRepository defines the interface for TODO reminder data access.
+// Reminders are read with their FireAt derived from the TODO's due date.
+type ReminderRepository interface {
+	// Create creates a new reminder
+	Create(ctx context.Context, reminder *Reminder) error
+
+	// GetByID retrieves a reminder by ID
+	GetByID(ctx context.Context, id string) (*Reminder, error)
+
+	// ListByTODO retrieves a user's reminders on a TODO, in the order they fire
+	ListByTODO(ctx context.Context, todoID, userID string) ([]*Reminder, error)
+
+	// Update updates an existing reminder
+	Update(ctx context.Context, reminder *Reminder) error
+
+	// Delete deletes a reminder by ID
+	Delete(ctx context.Context, id string) error
+
+	// ClaimDue marks up to limit reminders that are due at now as fired and
+	// returns them. Dismissed reminders and reminders on completed TODOs or
+	// TODOs in the trash are not claimed. Each reminder is claimed by only one
+	// caller, even when several claim concurrently.
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*Reminder, error)
+}
+
+// TODORevisionRepository defines the interface for TODO revision history
+// data access
+type TODORevisionRepository interface {
+	// Append stores a revision as the latest revision of its TODO and sets
+	// its number
+	Append(ctx context.Context, revision *TODORevision) error
+
+	// Get retrieves a revision of a TODO by number
+	Get(ctx context.Context, todoID string, revision int32) (*TODORevision, error)
+
+	// List retrieves the revisions of a TODO, newest first
+	List(ctx context.Context, todoID string, page, pageSize int32) ([]*TODORevision, *PaginationResult, error)
+}
+
+// TODODependencyRepository defines the interface for TODO dependency data
+// access. TODOs in the trash are not listed and do not block.
+type TODODependencyRepository interface {
+	// Add adds a dependency, doing nothing if it already exists. It returns
+	// ErrDependencyCycle if the blocker already depends on the TODO, directly
+	// or through other TODOs.
+	Add(ctx context.Context, dependency *TODODependency) error
+
+	// Remove removes a dependency
+	Remove(ctx context.Context, todoID, blockedByID string) error
+
+	// ListBlockers retrieves the TODOs that a TODO depends on
+	ListBlockers(ctx context.Context, todoID string) ([]*TODO, error)
+
+	// ListBlocked retrieves the TODOs that depend on a TODO
+	ListBlocked(ctx context.Context, todoID string) ([]*TODO, error)
+
+	// CountUnfinishedBlockers counts the TODOs that a TODO depends on that are
+	// neither completed nor cancelled
+	CountUnfinishedBlockers(ctx context.Context, todoID string) (int, error)
+}
+
+// CommentRepository defines the interface for TODO comment data access
+type CommentRepository interface {
+	// Create creates a new comment
+	Create(ctx context.Context, comment *Comment) error
+
+	// GetByID retrieves a comment by ID
+	GetByID(ctx context.Context, id string) (*Comment, error)
+
+	// Update updates an existing comment
+	Update(ctx context.Context, comment *Comment) error
+
+	// Delete deletes a comment by ID
+	Delete(ctx context.Context, id string) error
+
+	// List retrieves one level of a TODO's comment threads, oldest first
+	List(ctx context.Context, options CommentListOptions) ([]*Comment, *PaginationResult, error)
 }
 
 // UserRepository defines the interface for User data access
@@ -61,6 +188,114 @@ type UserRepository interface {
 
 	// Exists checks if a user exists by username
 	ExistsByUsername(ctx context.Context, username string) (bool, error)
+
+	// List retrieves users with filtering and pagination
+	List(ctx context.Context, options UserListOptions) ([]*User, *PaginationResult, error)
+
+	// ListDueForDeletion retrieves users whose scheduled deletion time has passed
+	ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*User, error)
+
+	// Delete deletes a user. Teams they own and TODOs they shared with teams
+	// are handed over to other members first, and their activity and uploads
+	// are kept without a user.
+	Delete(ctx context.Context, id string) error
+}
+
+// SessionRepository defines the interface for Session and refresh token data access
+type SessionRepository interface {
+	// Create creates a new session together with its first refresh token
+	Create(ctx context.Context, session *Session, token *RefreshToken) error
+
+	// GetByID retrieves a session by ID
+	GetByID(ctx context.Context, id string) (*Session, error)
+
+	// GetRefreshToken retrieves a refresh token by its hash
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// Rotate marks the refresh token identified by oldTokenHash as used and
+	// stores next in its place. It returns ErrRefreshTokenReused if the old
+	// token has already been used.
+	Rotate(ctx context.Context, oldTokenHash string, next *RefreshToken) error
+
+	// ListActiveByUser retrieves the active sessions of a user
+	ListActiveByUser(ctx context.Context, userID string) ([]*Session, error)
+
+	// Revoke revokes a session and all of its refresh tokens
+	Revoke(ctx context.Context, id string) error
+
+	// RevokeAllByUser revokes every session of a user
+	RevokeAllByUser(ctx context.Context, userID string) error
+}
+
+// UserTokenRepository defines the interface for single-use user token data access
+type UserTokenRepository interface {
+	// Create stores a new token, invalidating any unused tokens of the same
+	// purpose previously issued to the user
+	Create(ctx context.Context, token *UserToken) error
+
+	// Get returns an unused, unexpired token without marking it as used. It
+	// returns ErrUserTokenInvalid if no such token exists.
+	Get(ctx context.Context, tokenHash string, purpose UserTokenPurpose) (*UserToken, error)
+
+	// Consume marks an unused, unexpired token as used and returns it. It
+	// returns ErrUserTokenInvalid if no such token exists.
+	Consume(ctx context.Context, tokenHash string, purpose UserTokenPurpose) (*UserToken, error)
+}
+
+// UserIdentityRepository defines the interface for external identity data access
+type UserIdentityRepository interface {
+	// Create links an external identity to a user
+	Create(ctx context.Context, identity *UserIdentity) error
+
+	// GetByProviderSubject retrieves the identity with the given subject at a provider
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*UserIdentity, error)
+}
+
+// PersonalAccessTokenRepository defines the interface for personal access token data access
+type PersonalAccessTokenRepository interface {
+	// Create stores a new token
+	Create(ctx context.Context, token *PersonalAccessToken) error
+
+	// GetByHash retrieves a token by its hash
+	GetByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+
+	// ListByUser retrieves the unrevoked tokens of a user
+	ListByUser(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+
+	// Revoke revokes one of a user's tokens
+	Revoke(ctx context.Context, userID, id string) error
+
+	// RevokeAllByUser revokes all of a user's unrevoked tokens
+	RevokeAllByUser(ctx context.Context, userID string) error
+
+	// UpdateLastUsed records when a token was last used
+	UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+// TwoFactorRepository defines the interface for two-factor authentication data access
+type TwoFactorRepository interface {
+	// GetEnrollment retrieves a user's TOTP enrollment
+	GetEnrollment(ctx context.Context, userID string) (*TOTPEnrollment, error)
+
+	// SavePendingEnrollment stores an unconfirmed enrollment, replacing any
+	// previous unconfirmed one
+	SavePendingEnrollment(ctx context.Context, enrollment *TOTPEnrollment) error
+
+	// Enable confirms the user's enrollment at the given time step, replaces
+	// their recovery codes and turns on two-factor authentication
+	Enable(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error
+
+	// Disable removes the user's enrollment and recovery codes and turns off
+	// two-factor authentication
+	Disable(ctx context.Context, userID string) error
+
+	// RecordUsedStep records the time step of an accepted TOTP code. It
+	// returns ErrTOTPCodeReused if that step or a later one was already used.
+	RecordUsedStep(ctx context.Context, userID string, step int64) error
+
+	// ConsumeRecoveryCode marks an unused recovery code as used. It returns
+	// ErrRecoveryCodeInvalid if no such code exists.
+	ConsumeRecoveryCode(ctx context.Context, userID, codeHash string) error
 }
 
 // TeamRepository defines the interface for Team data access
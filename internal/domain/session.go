@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRefreshTokenReused is returned when a refresh token that has already
+// been rotated is presented again
+var ErrRefreshTokenReused = errors.New("refresh token already used")
+
+// Session represents a login session on a single device. A session owns a
+// family of refresh tokens, only the newest of which is usable.
+type Session struct {
+	ID         string
+	UserID     string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+// NewSession creates a new session expiring at expiresAt
+func NewSession(userID, userAgent, ipAddress string, expiresAt time.Time) *Session {
+	now := time.Now()
+	return &Session{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// IsActive reports whether the session is neither revoked nor expired
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && time.Now().Before(s.ExpiresAt)
+}
+
+// RefreshToken represents a single opaque refresh token issued for a session.
+// Only the SHA-256 hash of the token is persisted.
+type RefreshToken struct {
+	TokenHash string
+	SessionID string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// NewRefreshToken creates a new refresh token record for a session
+func NewRefreshToken(sessionID, tokenHash string, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		TokenHash: tokenHash,
+		SessionID: sessionID,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}
+}
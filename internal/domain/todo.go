@@ -1,12 +1,20 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 )
 
+// ErrVersionConflict is returned when a TODO is saved based on a version that
+// is no longer its latest, because it was changed in the meantime
+var ErrVersionConflict = errors.New("todo was modified concurrently")
+
 // TODO represents a TODO item in the domain
 type TODO struct {
 	ID               string
@@ -27,6 +35,20 @@ type TODO struct {
 	AssignedTo       *string
 	ParentID         *string
 	Position         int32
+	// OrderKey orders the TODO among its siblings; see package orderkey. It
+	// is empty for TODOs created before order keys were introduced.
+	OrderKey string
+	// RecurrenceRule is an RFC 5545 RRULE, or empty if the TODO does not
+	// recur. Only the open occurrence of a series carries the rule.
+	RecurrenceRule string
+	// Occurrence is the number of this occurrence in its series, starting at 1
+	Occurrence int32
+	// DeletedAt is when the TODO was moved to the trash, or nil if it is not
+	// in the trash. Subtasks deleted with their parent share its DeletedAt.
+	DeletedAt *time.Time
+	// Version starts at 1 and is incremented by every saved change, so that
+	// a change based on an older version can be detected and rejected
+	Version int64
 }
 
 // MediaAttachment represents media attached to a TODO
@@ -47,15 +69,17 @@ type MediaAttachment struct {
 func NewTODO(userID, title string) *TODO {
 	now := time.Now()
 	return &TODO{
-		ID:        uuid.New().String(),
-		UserID:    userID,
-		Title:     title,
-		Status:    commonv1.Status_STATUS_NOT_STARTED,
-		Priority:  commonv1.Priority_PRIORITY_MEDIUM,
-		IsShared:  false,
-		CreatedAt: now,
-		UpdatedAt: now,
-		Position:  0,
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		Title:      title,
+		Status:     commonv1.Status_STATUS_NOT_STARTED,
+		Priority:   commonv1.Priority_PRIORITY_MEDIUM,
+		IsShared:   false,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Position:   0,
+		Occurrence: 1,
+		Version:    1,
 	}
 }
 
@@ -79,6 +103,39 @@ func (t *TODO) Reopen() {
 	t.UpdatedAt = time.Now()
 }
 
+// IsRecurring returns true if the TODO recurs
+func (t *TODO) IsRecurring() bool {
+	return t.RecurrenceRule != ""
+}
+
+// Duplicate returns a copy of the TODO with a new ID that has not been
+// started yet. Media attachments and team shares are not copied.
+func (t *TODO) Duplicate() *TODO {
+	dup := NewTODO(t.UserID, t.Title)
+	dup.Description = t.Description
+	dup.Priority = t.Priority
+	dup.DueDate = t.DueDate
+	if t.Tags != nil {
+		dup.Tags = append([]string{}, t.Tags...)
+	}
+	dup.AssignedTo = t.AssignedTo
+	dup.ParentID = t.ParentID
+	dup.Position = t.Position
+	dup.OrderKey = t.OrderKey
+	dup.RecurrenceRule = t.RecurrenceRule
+	dup.Occurrence = t.Occurrence
+	return dup
+}
+
+// NextOccurrence returns the occurrence of a recurring TODO that follows
+// this one, due at dueDate
+func (t *TODO) NextOccurrence(dueDate time.Time) *TODO {
+	next := t.Duplicate()
+	next.DueDate = &dueDate
+	next.Occurrence = t.Occurrence + 1
+	return next
+}
+
 // Update updates TODO fields
 func (t *TODO) Update(title, description *string, status *commonv1.Status, priority *commonv1.Priority, dueDate *time.Time, tags []string, assignedTo, parentID *string, position *int32) {
 	if title != nil {
@@ -117,6 +174,135 @@ func (t *TODO) Update(title, description *string, status *commonv1.Status, prior
 	t.UpdatedAt = time.Now()
 }
 
+// PatchableFields lists the TODO fields that can be named in a field mask
+var PatchableFields = []string{
+	"title",
+	"description",
+	"status",
+	"priority",
+	"due_date",
+	"tags",
+	"assigned_to",
+	"parent_id",
+	"position",
+	"recurrence_rule",
+}
+
+// ValidatePatchPaths returns an error naming the first path that is not one
+// of PatchableFields
+func ValidatePatchPaths(paths []string) error {
+	for _, path := range paths {
+		if !slices.Contains(PatchableFields, path) {
+			return fmt.Errorf("field %q cannot be updated; updatable fields are %s", path, strings.Join(PatchableFields, ", "))
+		}
+	}
+	return nil
+}
+
+// Patch sets the fields of the TODO named in paths to their values in
+// values. Fields that are unset in values are cleared. The paths must have
+// been checked with ValidatePatchPaths.
+func (t *TODO) Patch(values *TODO, paths []string) {
+	for _, path := range paths {
+		switch path {
+		case "title":
+			t.Title = values.Title
+		case "description":
+			t.Description = values.Description
+		case "status":
+			t.Update(nil, nil, &values.Status, nil, nil, nil, nil, nil, nil)
+		case "priority":
+			t.Priority = values.Priority
+		case "due_date":
+			t.DueDate = values.DueDate
+		case "tags":
+			t.Tags = values.Tags
+		case "assigned_to":
+			t.AssignedTo = values.AssignedTo
+		case "parent_id":
+			t.ParentID = values.ParentID
+		case "position":
+			t.Position = values.Position
+		case "recurrence_rule":
+			t.RecurrenceRule = values.RecurrenceRule
+		}
+	}
+	t.UpdatedAt = time.Now()
+}
+
+// BulkChanges are the changes made to every TODO of a bulk update. Nil and
+// empty fields leave the TODOs unchanged.
+type BulkChanges struct {
+	Status     *commonv1.Status
+	Priority   *commonv1.Priority
+	AddTags    []string
+	RemoveTags []string
+	// AssignedTo is the new assignee; an empty ID unassigns the TODOs
+	AssignedTo   *string
+	DueDate      *time.Time
+	ClearDueDate bool
+	// ParentID is the new parent; an empty ID makes the TODOs top-level
+	ParentID *string
+}
+
+// IsEmpty returns true if the changes would leave every TODO unchanged
+func (c *BulkChanges) IsEmpty() bool {
+	return c.Status == nil && c.Priority == nil && len(c.AddTags) == 0 && len(c.RemoveTags) == 0 &&
+		c.AssignedTo == nil && c.DueDate == nil && !c.ClearDueDate && c.ParentID == nil
+}
+
+// ApplyBulkChanges makes the changes of a bulk update to the TODO. Tags are
+// removed before they are added, and are not added twice.
+func (t *TODO) ApplyBulkChanges(c *BulkChanges) {
+	t.Update(nil, nil, c.Status, c.Priority, c.DueDate, nil, nil, nil, nil)
+	if c.ClearDueDate {
+		t.DueDate = nil
+	}
+
+	if len(c.AddTags) > 0 || len(c.RemoveTags) > 0 {
+		tags := make([]string, 0, len(t.Tags)+len(c.AddTags))
+		for _, tag := range t.Tags {
+			if !slices.Contains(c.RemoveTags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		for _, tag := range c.AddTags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		t.Tags = tags
+	}
+
+	if c.AssignedTo != nil {
+		t.AssignedTo = nil
+		if *c.AssignedTo != "" {
+			t.AssignedTo = c.AssignedTo
+		}
+	}
+	if c.ParentID != nil {
+		t.ParentID = nil
+		if *c.ParentID != "" {
+			t.ParentID = c.ParentID
+		}
+	}
+}
+
+// BatchSaveError is returned when TODOs saved together are not saved because
+// one of them could not be
+type BatchSaveError struct {
+	ID  string // The TODO that could not be saved
+	Err error
+}
+
+func (e *BatchSaveError) Error() string {
+	return fmt.Sprintf("failed to save todo %s: %v", e.ID, e.Err)
+}
+
+func (e *BatchSaveError) Unwrap() error {
+	return e.Err
+}
+
 // TODOFilter represents filtering criteria for TODO queries
 type TODOFilter struct {
 	IDs               []string
@@ -136,6 +322,9 @@ type TODOFilter struct {
 	IsShared          *bool
 	SearchQuery       *string
 	SearchFields      []string // Fields to search in: title, description, tags
+	// ReadyToStart keeps only TODOs that are not started and whose blockers
+	// are all completed or cancelled
+	ReadyToStart bool
 }
 
 // SortOption represents sorting criteria
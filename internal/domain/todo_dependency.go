@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+)
+
+// ErrDependencyCycle is returned when a dependency would make a TODO block
+// itself, directly or through other TODOs
+var ErrDependencyCycle = errors.New("dependency would create a cycle")
+
+// TODODependency records that a TODO cannot start until another TODO, its
+// blocker, is finished
+type TODODependency struct {
+	TODOID      string
+	BlockedByID string
+	CreatedBy   string // Empty once the user has been deleted
+	CreatedAt   time.Time
+}
+
+// NewTODODependency creates a dependency of todoID on blockedByID
+func NewTODODependency(todoID, blockedByID, createdBy string) *TODODependency {
+	return &TODODependency{
+		TODOID:      todoID,
+		BlockedByID: blockedByID,
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// IsFinished reports whether the TODO is completed or cancelled, so that it
+// no longer blocks the TODOs that depend on it
+func (t *TODO) IsFinished() bool {
+	return t.Status == commonv1.Status_STATUS_COMPLETED || t.Status == commonv1.Status_STATUS_CANCELLED
+}
+
+// StartsWork reports whether moving a TODO to status starts or completes
+// work on it, which a TODO with unfinished blockers may not do
+func StartsWork(status commonv1.Status) bool {
+	return status == commonv1.Status_STATUS_IN_PROGRESS || status == commonv1.Status_STATUS_COMPLETED
+}
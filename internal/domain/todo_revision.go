@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+)
+
+// Revision actions, one for each kind of change to a TODO
+const (
+	RevisionActionCreated           = "created"
+	RevisionActionUpdated           = "updated"
+	RevisionActionMoved             = "moved"
+	RevisionActionCompleted         = "completed"
+	RevisionActionReopened          = "reopened"
+	RevisionActionOccurrenceSkipped = "occurrence_skipped"
+	RevisionActionRecurrenceEnded   = "recurrence_ended"
+	RevisionActionRestored          = "restored"
+)
+
+// TODOSnapshot is the state of the fields of a TODO that users change
+type TODOSnapshot struct {
+	Title          string            `json:"title"`
+	Description    string            `json:"description,omitempty"`
+	Status         commonv1.Status   `json:"status"`
+	Priority       commonv1.Priority `json:"priority"`
+	DueDate        *time.Time        `json:"due_date,omitempty"`
+	Tags           []string          `json:"tags,omitempty"`
+	AssignedTo     *string           `json:"assigned_to,omitempty"`
+	ParentID       *string           `json:"parent_id,omitempty"`
+	Position       int32             `json:"position"`
+	OrderKey       string            `json:"order_key,omitempty"`
+	RecurrenceRule string            `json:"recurrence_rule,omitempty"`
+	Occurrence     int32             `json:"occurrence"`
+}
+
+// FieldChange is the change of one field between two snapshots. Values are
+// nil for unset fields; enums are given by name and times in RFC 3339.
+type FieldChange struct {
+	Field    string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// TODORevision is the state of a TODO after one change, together with who
+// made the change and which fields it changed. Revisions of a TODO are
+// numbered from 1.
+type TODORevision struct {
+	ID            string
+	TODOID        string
+	Revision      int32
+	ActorID       string // Empty once the actor has been deleted
+	Action        string
+	ChangedFields []string
+	Snapshot      TODOSnapshot
+	CreatedAt     time.Time
+}
+
+// NewTODORevision creates the revision recording a change to a TODO.
+// previous is the state before the change, or nil if the TODO was created.
+func NewTODORevision(todo *TODO, actorID, action string, previous *TODOSnapshot) *TODORevision {
+	snapshot := todo.Snapshot()
+	var from TODOSnapshot
+	if previous != nil {
+		from = *previous
+	}
+
+	var changed []string
+	for _, change := range DiffSnapshots(from, snapshot) {
+		changed = append(changed, change.Field)
+	}
+
+	return &TODORevision{
+		ID:            uuid.New().String(),
+		TODOID:        todo.ID,
+		ActorID:       actorID,
+		Action:        action,
+		ChangedFields: changed,
+		Snapshot:      snapshot,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// Snapshot returns the current state of the TODO's fields
+func (t *TODO) Snapshot() TODOSnapshot {
+	snapshot := TODOSnapshot{
+		Title:          t.Title,
+		Description:    t.Description,
+		Status:         t.Status,
+		Priority:       t.Priority,
+		DueDate:        t.DueDate,
+		AssignedTo:     t.AssignedTo,
+		ParentID:       t.ParentID,
+		Position:       t.Position,
+		OrderKey:       t.OrderKey,
+		RecurrenceRule: t.RecurrenceRule,
+		Occurrence:     t.Occurrence,
+	}
+	if len(t.Tags) > 0 {
+		snapshot.Tags = append([]string{}, t.Tags...)
+	}
+	return snapshot
+}
+
+// Restore sets the TODO's fields to a snapshot. The recurrence rule and the
+// occurrence are kept, since they belong to the series rather than to this
+// TODO.
+func (t *TODO) Restore(snapshot TODOSnapshot) {
+	t.Title = snapshot.Title
+	t.Description = snapshot.Description
+	t.Priority = snapshot.Priority
+	t.DueDate = snapshot.DueDate
+	t.Tags = append([]string{}, snapshot.Tags...)
+	t.AssignedTo = snapshot.AssignedTo
+	t.ParentID = snapshot.ParentID
+	t.Position = snapshot.Position
+	t.OrderKey = snapshot.OrderKey
+	t.Update(nil, nil, &snapshot.Status, nil, nil, nil, nil, nil, nil)
+}
+
+// DiffSnapshots returns the fields that differ between two snapshots, in a
+// fixed order
+func DiffSnapshots(from, to TODOSnapshot) []FieldChange {
+	var changes []FieldChange
+	toFields := to.fields()
+	for i, field := range from.fields() {
+		if !reflect.DeepEqual(field.value, toFields[i].value) {
+			changes = append(changes, FieldChange{
+				Field:    field.name,
+				OldValue: field.value,
+				NewValue: toFields[i].value,
+			})
+		}
+	}
+	return changes
+}
+
+type snapshotField struct {
+	name  string
+	value interface{}
+}
+
+// fields returns the snapshot's fields by name, with the values used in
+// field changes
+func (s TODOSnapshot) fields() []snapshotField {
+	var dueDate, tags interface{}
+	if s.DueDate != nil {
+		dueDate = s.DueDate.UTC().Format(time.RFC3339)
+	}
+	if len(s.Tags) > 0 {
+		tags = s.Tags
+	}
+
+	return []snapshotField{
+		{"title", s.Title},
+		{"description", s.Description},
+		{"status", s.Status.String()},
+		{"priority", s.Priority.String()},
+		{"due_date", dueDate},
+		{"tags", tags},
+		{"assigned_to", optionalString(s.AssignedTo)},
+		{"parent_id", optionalString(s.ParentID)},
+		{"position", s.Position},
+		{"order_key", s.OrderKey},
+		{"recurrence_rule", s.RecurrenceRule},
+		{"occurrence", s.Occurrence},
+	}
+}
+
+// optionalString returns the value of s, or nil if s is nil or empty
+func optionalString(s *string) interface{} {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return *s
+}
@@ -148,3 +148,162 @@ func TestTODO_Update_StatusFromCompleted(t *testing.T) {
 		t.Error("Expected CompletedAt to be cleared when status changes from COMPLETED")
 	}
 }
+
+func TestTODO_ApplyBulkChanges(t *testing.T) {
+	assignee := "user-456"
+	parentID := "parent-123"
+	dueDate := time.Now()
+	todo := NewTODO("user-123", "Test TODO")
+	todo.Tags = []string{"a", "b"}
+	todo.AssignedTo = &assignee
+	todo.ParentID = &parentID
+	todo.DueDate = &dueDate
+
+	empty := ""
+	todo.ApplyBulkChanges(&BulkChanges{
+		AddTags:      []string{"b", "c"},
+		RemoveTags:   []string{"a"},
+		AssignedTo:   &empty,
+		ParentID:     &empty,
+		ClearDueDate: true,
+	})
+
+	if len(todo.Tags) != 2 || todo.Tags[0] != "b" || todo.Tags[1] != "c" {
+		t.Errorf("Expected tags [b c], got %v", todo.Tags)
+	}
+	if todo.AssignedTo != nil || todo.ParentID != nil || todo.DueDate != nil {
+		t.Error("Expected empty IDs and ClearDueDate to clear the fields")
+	}
+	if todo.Title != "Test TODO" || todo.Priority != commonv1.Priority_PRIORITY_MEDIUM {
+		t.Error("Expected fields without changes to be unchanged")
+	}
+}
+
+func TestTODO_NextOccurrence(t *testing.T) {
+	todo := NewTODO("user-123", "Water the plants")
+	assignedTo := "user-456"
+	dueDate := time.Now()
+	todo.Update(nil, nil, nil, nil, &dueDate, []string{"home"}, &assignedTo, nil, nil)
+	todo.RecurrenceRule = "FREQ=WEEKLY"
+	todo.Complete()
+
+	nextDue := dueDate.AddDate(0, 0, 7)
+	next := todo.NextOccurrence(nextDue)
+
+	if next.ID == todo.ID {
+		t.Error("Expected the next occurrence to have a new ID")
+	}
+	if next.Status != commonv1.Status_STATUS_NOT_STARTED || next.CompletedAt != nil {
+		t.Error("Expected the next occurrence not to be started")
+	}
+	if next.DueDate == nil || !next.DueDate.Equal(nextDue) {
+		t.Errorf("Expected DueDate to be %v, got %v", nextDue, next.DueDate)
+	}
+	if next.Occurrence != 2 {
+		t.Errorf("Expected Occurrence to be 2, got %d", next.Occurrence)
+	}
+	if next.RecurrenceRule != todo.RecurrenceRule {
+		t.Errorf("Expected RecurrenceRule to be %s, got %s", todo.RecurrenceRule, next.RecurrenceRule)
+	}
+	if next.AssignedTo == nil || *next.AssignedTo != assignedTo {
+		t.Error("Expected AssignedTo to be copied")
+	}
+
+	next.Tags[0] = "garden"
+	if todo.Tags[0] != "home" {
+		t.Error("Expected tags not to be shared with the previous occurrence")
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	todo := NewTODO("user-123", "Write report")
+	before := todo.Snapshot()
+
+	title := "Write final report"
+	status := commonv1.Status_STATUS_IN_PROGRESS
+	dueDate := time.Date(2026, time.May, 4, 17, 0, 0, 0, time.UTC)
+	todo.Update(&title, nil, &status, nil, &dueDate, []string{"work"}, nil, nil, nil)
+
+	changes := DiffSnapshots(before, todo.Snapshot())
+
+	var fields []string
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+	if want := []string{"title", "status", "due_date", "tags"}; len(fields) != len(want) {
+		t.Fatalf("Expected changed fields %v, got %v", want, fields)
+	}
+	if changes[1].OldValue != "STATUS_NOT_STARTED" || changes[1].NewValue != "STATUS_IN_PROGRESS" {
+		t.Errorf("Expected status to be given by name, got %v -> %v", changes[1].OldValue, changes[1].NewValue)
+	}
+	if changes[2].OldValue != nil || changes[2].NewValue != "2026-05-04T17:00:00Z" {
+		t.Errorf("Expected due date to change from nil to RFC 3339, got %v -> %v", changes[2].OldValue, changes[2].NewValue)
+	}
+
+	if changes := DiffSnapshots(before, before); len(changes) != 0 {
+		t.Errorf("Expected no changes between equal snapshots, got %v", changes)
+	}
+}
+
+func TestTODO_Restore(t *testing.T) {
+	todo := NewTODO("user-123", "Original")
+	todo.RecurrenceRule = "FREQ=DAILY"
+	snapshot := todo.Snapshot()
+
+	title := "Changed"
+	todo.Update(&title, nil, nil, nil, nil, []string{"urgent"}, nil, nil, nil)
+	todo.Complete()
+	todo.RecurrenceRule = ""
+
+	todo.Restore(snapshot)
+
+	if todo.Title != "Original" || len(todo.Tags) != 0 {
+		t.Errorf("Expected title and tags to be restored, got %q %v", todo.Title, todo.Tags)
+	}
+	if todo.IsCompleted() || todo.CompletedAt != nil {
+		t.Error("Expected the restored TODO not to be completed")
+	}
+	if todo.RecurrenceRule != "" {
+		t.Error("Expected the recurrence rule not to be restored")
+	}
+}
+
+func TestBuildTODOTree(t *testing.T) {
+	root := &TODO{ID: "root"}
+	child := &TODO{ID: "child", ParentID: &root.ID}
+	grandchild := &TODO{ID: "grandchild", ParentID: &child.ID, Status: commonv1.Status_STATUS_COMPLETED}
+	elsewhere := "elsewhere"
+	orphan := &TODO{ID: "orphan", ParentID: &elsewhere}
+
+	tree := BuildTODOTree([]*TODOTreeNode{
+		{TODO: root, TotalSubtasks: 2, CompletedSubtasks: 1},
+		{TODO: child, TotalSubtasks: 1, CompletedSubtasks: 1},
+		{TODO: grandchild},
+		{TODO: orphan},
+	})
+
+	if len(tree.Children) != 1 || tree.Children[0].TODO != child {
+		t.Fatalf("Expected the root to have only the child, got %d children", len(tree.Children))
+	}
+	if len(tree.Children[0].Children) != 1 || tree.Children[0].Children[0].TODO != grandchild {
+		t.Fatal("Expected the grandchild under the child")
+	}
+
+	tests := []struct {
+		name string
+		node *TODOTreeNode
+		want float64
+	}{
+		{"half of subtasks", tree, 50},
+		{"all subtasks", tree.Children[0], 100},
+		{"completed leaf", tree.Children[0].Children[0], 100},
+		{"open leaf", &TODOTreeNode{TODO: &TODO{}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.node.Progress(); got != tt.want {
+				t.Errorf("Progress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+// TODOTreeNode is a TODO in a tree of subtasks. The subtask counts cover all
+// of the TODO's subtasks, however deep, including those below the depth to
+// which the tree was retrieved.
+type TODOTreeNode struct {
+	TODO              *TODO
+	Children          []*TODOTreeNode
+	CompletedSubtasks int32
+	TotalSubtasks     int32
+}
+
+// Progress returns the percentage of the TODO's subtasks that are completed.
+// A TODO without subtasks is either 0 or 100 percent done.
+func (n *TODOTreeNode) Progress() float64 {
+	if n.TotalSubtasks == 0 {
+		if n.TODO.IsCompleted() {
+			return 100
+		}
+		return 0
+	}
+	return float64(n.CompletedSubtasks) * 100 / float64(n.TotalSubtasks)
+}
+
+// BuildTODOTree links nodes into the tree rooted at the first node. Every
+// other node must come after its parent; nodes whose parent is not among
+// them are left out.
+func BuildTODOTree(nodes []*TODOTreeNode) *TODOTreeNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	byID := map[string]*TODOTreeNode{nodes[0].TODO.ID: nodes[0]}
+	for _, node := range nodes[1:] {
+		if node.TODO.ParentID == nil {
+			continue
+		}
+		parent, ok := byID[*node.TODO.ParentID]
+		if !ok {
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+		byID[node.TODO.ID] = node
+	}
+
+	return nodes[0]
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrTOTPCodeReused is returned when a TOTP code for a time step that was
+	// already used is presented again
+	ErrTOTPCodeReused = errors.New("code has already been used")
+	// ErrRecoveryCodeInvalid is returned when a recovery code does not exist
+	// or has already been used
+	ErrRecoveryCodeInvalid = errors.New("recovery code is invalid or has already been used")
+)
+
+// TOTPEnrollment holds a user's TOTP secret. It is pending until the user
+// confirms it with a first code.
+type TOTPEnrollment struct {
+	UserID       string
+	Secret       string
+	ConfirmedAt  *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+// NewTOTPEnrollment creates a new pending enrollment
+func NewTOTPEnrollment(userID, secret string) *TOTPEnrollment {
+	return &TOTPEnrollment{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsConfirmed reports whether the enrollment has been confirmed
+func (e *TOTPEnrollment) IsConfirmed() bool {
+	return e.ConfirmedAt != nil
+}
@@ -8,16 +8,24 @@ import (
 
 // User represents a user in the system
 type User struct {
-	ID           string
-	Email        string
-	Username     string
-	PasswordHash string
-	FullName     string
-	AvatarURL    string
-	IsActive     bool
-	LastLoginAt  *time.Time
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID            string
+	Email         string
+	Username      string
+	PasswordHash  string
+	FullName      string
+	AvatarURL     string
+	IsActive      bool
+	EmailVerified bool
+	// TwoFactorEnabled is maintained by TwoFactorRepository
+	TwoFactorEnabled bool
+	// IsAdmin grants access to system administration, such as unlocking accounts
+	IsAdmin     bool
+	LastLoginAt *time.Time
+	// DeletionScheduledAt is when the account will be deleted, if the user
+	// asked for its deletion
+	DeletionScheduledAt *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 // NewUser creates a new user
@@ -34,9 +42,48 @@ func NewUser(email, username, passwordHash string) *User {
 	}
 }
 
+// MarkEmailVerified marks the user's email address as verified
+func (u *User) MarkEmailVerified() {
+	u.EmailVerified = true
+	u.UpdatedAt = time.Now()
+}
+
 // UpdateLastLogin updates the last login timestamp
 func (u *User) UpdateLastLogin() {
 	now := time.Now()
 	u.LastLoginAt = &now
 	u.UpdatedAt = now
 }
+
+// Deactivate prevents the user from signing in
+func (u *User) Deactivate() {
+	u.IsActive = false
+	u.UpdatedAt = time.Now()
+}
+
+// Reactivate allows a deactivated user to sign in again
+func (u *User) Reactivate() {
+	u.IsActive = true
+	u.UpdatedAt = time.Now()
+}
+
+// ScheduleDeletion marks the account for deletion at the given time
+func (u *User) ScheduleDeletion(at time.Time) {
+	u.DeletionScheduledAt = &at
+	u.UpdatedAt = time.Now()
+}
+
+// CancelDeletion keeps an account that was marked for deletion
+func (u *User) CancelDeletion() {
+	u.DeletionScheduledAt = nil
+	u.UpdatedAt = time.Now()
+}
+
+// UserListOptions represents options for listing users
+type UserListOptions struct {
+	// Query matches part of the email address, username or full name
+	Query    string
+	IsActive *bool
+	Page     int32
+	PageSize int32
+}
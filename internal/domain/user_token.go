@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUserTokenInvalid is returned when a user token does not exist, has
+// expired or has already been used
+var ErrUserTokenInvalid = errors.New("token is invalid or has expired")
+
+// UserTokenPurpose identifies what a user token may be used for
+type UserTokenPurpose string
+
+const (
+	// UserTokenEmailVerification verifies ownership of an email address
+	UserTokenEmailVerification UserTokenPurpose = "email_verification"
+	// UserTokenPasswordReset authorizes a password reset
+	UserTokenPasswordReset UserTokenPurpose = "password_reset"
+)
+
+// UserToken is a single-use, expiring token sent to a user by email.
+// Only the SHA-256 hash of the token is persisted.
+type UserToken struct {
+	TokenHash string
+	UserID    string
+	Purpose   UserTokenPurpose
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// NewUserToken creates a new user token record
+func NewUserToken(userID, tokenHash string, purpose UserTokenPurpose, expiresAt time.Time) *UserToken {
+	return &UserToken{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		Purpose:   purpose,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// PostgresActivityRepository implements ActivityRepository using PostgreSQL
+type PostgresActivityRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresActivityRepository creates a new PostgreSQL activity repository
+func NewPostgresActivityRepository(db *sql.DB) *PostgresActivityRepository {
+	return &PostgresActivityRepository{db: db}
+}
+
+// Create creates a new activity log entry
+func (r *PostgresActivityRepository) Create(ctx context.Context, log *domain.ActivityLog) error {
+	details, err := log.ToJSONB()
+	if err != nil {
+		return fmt.Errorf("failed to encode activity details: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO activity_logs (id, team_id, user_id, action, resource_type, resource_id, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		log.ID,
+		log.TeamID,
+		log.UserID,
+		log.Action,
+		log.ResourceType,
+		log.ResourceID,
+		details,
+		log.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create activity log: %w", err)
+	}
+
+	return nil
+}
+
+// ListByTeam retrieves activity logs for a team, newest first
+func (r *PostgresActivityRepository) ListByTeam(ctx context.Context, teamID string, limit int) ([]*domain.ActivityLog, error) {
+	query := `
+		SELECT id, team_id, user_id, action, resource_type, resource_id, details, created_at
+		FROM activity_logs
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	return r.list(ctx, query, teamID, limit)
+}
+
+// ListByUser retrieves activity logs for a user, newest first
+func (r *PostgresActivityRepository) ListByUser(ctx context.Context, userID string, limit int) ([]*domain.ActivityLog, error) {
+	query := `
+		SELECT id, team_id, user_id, action, resource_type, resource_id, details, created_at
+		FROM activity_logs
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	return r.list(ctx, query, userID, limit)
+}
+
+// list runs an activity log query
+func (r *PostgresActivityRepository) list(ctx context.Context, query string, args ...interface{}) ([]*domain.ActivityLog, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []*domain.ActivityLog
+	for rows.Next() {
+		var log domain.ActivityLog
+		var teamID, userID, resourceID sql.NullString
+		var details []byte
+
+		if err := rows.Scan(
+			&log.ID,
+			&teamID,
+			&userID,
+			&log.Action,
+			&log.ResourceType,
+			&resourceID,
+			&details,
+			&log.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if teamID.Valid {
+			log.TeamID = &teamID.String
+		}
+		log.UserID = userID.String
+		if resourceID.Valid {
+			log.ResourceID = &resourceID.String
+		}
+		if len(details) > 0 {
+			if err := json.Unmarshal(details, &log.Details); err != nil {
+				return nil, fmt.Errorf("failed to decode activity details: %w", err)
+			}
+		}
+
+		logs = append(logs, &log)
+	}
+
+	return logs, rows.Err()
+}
@@ -0,0 +1,207 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// commentColumns lists the columns scanned by scanComment, selected from
+// comments c
+const commentColumns = `c.id, c.todo_id, c.user_id, c.parent_id, c.content,
+	(SELECT COUNT(*) FROM comments r WHERE r.parent_id = c.id),
+	c.edited_at, c.deleted_at, c.created_at, c.updated_at`
+
+// PostgresCommentRepository implements CommentRepository using PostgreSQL
+type PostgresCommentRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresCommentRepository creates a new PostgreSQL comment repository
+func NewPostgresCommentRepository(db *sql.DB) *PostgresCommentRepository {
+	return &PostgresCommentRepository{db: db}
+}
+
+// Create creates a new comment
+func (r *PostgresCommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO comments (id, todo_id, user_id, parent_id, content, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		comment.ID,
+		comment.TODOID,
+		comment.UserID,
+		comment.ParentID,
+		comment.Content,
+		comment.CreatedAt,
+		comment.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a comment by ID
+func (r *PostgresCommentRepository) GetByID(ctx context.Context, id string) (*domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments c WHERE c.id = $1`
+
+	comment, err := scanComment(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// Update updates an existing comment
+func (r *PostgresCommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE comments
+		SET content = $2, edited_at = $3, deleted_at = $4, updated_at = $5
+		WHERE id = $1
+	`,
+		comment.ID,
+		comment.Content,
+		comment.EditedAt,
+		comment.DeletedAt,
+		comment.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("comment not found")
+	}
+
+	return nil
+}
+
+// Delete deletes a comment and its replies by ID
+func (r *PostgresCommentRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM comments WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("comment not found")
+	}
+
+	return nil
+}
+
+// List retrieves one level of a TODO's comment threads, oldest first
+func (r *PostgresCommentRepository) List(ctx context.Context, options domain.CommentListOptions) ([]*domain.Comment, *domain.PaginationResult, error) {
+	whereClause := "WHERE c.todo_id = $1 AND c.parent_id IS NULL"
+	args := []interface{}{options.TODOID}
+	if options.ParentID != nil {
+		whereClause = "WHERE c.todo_id = $1 AND c.parent_id = $2"
+		args = append(args, *options.ParentID)
+	}
+
+	page := options.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := options.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	var totalItems int32
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM comments c "+whereClause, args...).Scan(&totalItems); err != nil {
+		return nil, nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM comments c %s ORDER BY c.created_at, c.id LIMIT $%d OFFSET $%d`,
+		commentColumns, whereClause, len(args)+1, len(args)+2)
+	args = append(args, pageSize, (page-1)*pageSize)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := (totalItems + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return comments, &domain.PaginationResult{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+		HasNext:     page < totalPages,
+		HasPrev:     page > 1,
+	}, nil
+}
+
+// scanComment scans a comment row
+func scanComment(row rowScanner) (*domain.Comment, error) {
+	var comment domain.Comment
+	var userID, parentID sql.NullString
+	var editedAt, deletedAt sql.NullTime
+
+	err := row.Scan(
+		&comment.ID,
+		&comment.TODOID,
+		&userID,
+		&parentID,
+		&comment.Content,
+		&comment.ReplyCount,
+		&editedAt,
+		&deletedAt,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.UserID = userID.String
+	if parentID.Valid {
+		comment.ParentID = &parentID.String
+	}
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
+	if deletedAt.Valid {
+		comment.DeletedAt = &deletedAt.Time
+	}
+
+	return &comment, nil
+}
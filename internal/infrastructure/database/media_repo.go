@@ -57,7 +57,7 @@ func (r *PostgresMediaRepository) GetMediaByID(ctx context.Context, id string) (
 	query := `
 		SELECT 
 			id, todo_id, file_name, file_url, file_type, file_size,
-			mime_type, thumbnail_url, duration, uploaded_by, uploaded_at
+			mime_type, thumbnail_url, duration, COALESCE(uploaded_by::text, ''), uploaded_at
 		FROM media_attachments 
 		WHERE id = $1
 	`
@@ -96,7 +96,7 @@ func (r *PostgresMediaRepository) ListMediaByTODOID(ctx context.Context, todoID
 	query := `
 		SELECT 
 			id, todo_id, file_name, file_url, file_type, file_size,
-			mime_type, thumbnail_url, duration, uploaded_by, uploaded_at
+			mime_type, thumbnail_url, duration, COALESCE(uploaded_by::text, ''), uploaded_at
 		FROM media_attachments 
 		WHERE todo_id = $1
 		ORDER BY uploaded_at DESC
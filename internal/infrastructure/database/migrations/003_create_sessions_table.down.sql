@@ -0,0 +1,3 @@
+-- Drop refresh_tokens and sessions tables
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,30 @@
+-- Create sessions table, one row per logged-in device
+CREATE TABLE sessions
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID        NOT NULL,
+    user_agent   TEXT        NOT NULL     DEFAULT '',
+    ip_address   VARCHAR(64) NOT NULL     DEFAULT '',
+    created_at   TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at   TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT fk_sessions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Create refresh_tokens table; only SHA-256 hashes of tokens are stored
+CREATE TABLE refresh_tokens
+(
+    token_hash VARCHAR(64) PRIMARY KEY,
+    session_id UUID        NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at    TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT fk_refresh_tokens_session FOREIGN KEY (session_id) REFERENCES sessions (id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_sessions_user_id ON sessions (user_id);
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
@@ -0,0 +1,3 @@
+-- Drop user_tokens table and email verification flag
+DROP TABLE IF EXISTS user_tokens;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified;
@@ -0,0 +1,20 @@
+-- Track whether a user's email address has been verified
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create user_tokens table for email verification and password reset;
+-- only SHA-256 hashes of tokens are stored
+CREATE TABLE user_tokens
+(
+    token_hash VARCHAR(64) PRIMARY KEY,
+    user_id    UUID        NOT NULL,
+    purpose    VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at    TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT fk_user_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_user_tokens_user_purpose ON user_tokens (user_id, purpose);
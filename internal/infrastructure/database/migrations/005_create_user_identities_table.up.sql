@@ -0,0 +1,17 @@
+-- Create user_identities table linking users to accounts at external
+-- OpenID Connect providers
+CREATE TABLE user_identities
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID         NOT NULL,
+    provider   VARCHAR(100) NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    email      VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT uq_user_identities_provider_subject UNIQUE (provider, subject)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
@@ -0,0 +1,19 @@
+-- Create personal_access_tokens table for scoped API tokens;
+-- only SHA-256 hashes of tokens are stored
+CREATE TABLE personal_access_tokens
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID         NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    token_hash   VARCHAR(64)  NOT NULL UNIQUE,
+    scopes       TEXT[]       NOT NULL DEFAULT '{}',
+    created_at   TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at   TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT fk_personal_access_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_personal_access_tokens_user_id ON personal_access_tokens (user_id);
@@ -0,0 +1,4 @@
+-- Drop two-factor authentication tables and flag
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_enabled;
@@ -0,0 +1,28 @@
+-- Track whether a user has two-factor authentication enabled
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS two_factor_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create user_totp table holding TOTP secrets; an enrollment is pending
+-- until confirmed_at is set
+CREATE TABLE user_totp
+(
+    user_id        UUID PRIMARY KEY,
+    secret         VARCHAR(64) NOT NULL,
+    confirmed_at   TIMESTAMP WITH TIME ZONE,
+    last_used_step BIGINT      NOT NULL DEFAULT 0,
+    created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_user_totp_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Create user_recovery_codes table; only SHA-256 hashes of codes are stored
+CREATE TABLE user_recovery_codes
+(
+    user_id    UUID        NOT NULL,
+    code_hash  VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    used_at    TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (user_id, code_hash),
+    CONSTRAINT fk_user_recovery_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
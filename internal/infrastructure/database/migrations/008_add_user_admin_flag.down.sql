@@ -0,0 +1,2 @@
+-- Drop the system administrator flag
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,4 @@
+-- Mark system administrators, who may manage other users' accounts.
+-- Grant with: UPDATE users SET is_admin = TRUE WHERE email = '...';
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,16 @@
+-- Restore the foreign keys that prevent deleting referenced users
+ALTER TABLE activity_logs
+    DROP CONSTRAINT IF EXISTS activity_logs_user_id_fkey,
+    ADD CONSTRAINT activity_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id);
+
+ALTER TABLE todos
+    DROP CONSTRAINT IF EXISTS todos_shared_by_fkey,
+    ADD CONSTRAINT todos_shared_by_fkey FOREIGN KEY (shared_by) REFERENCES users (id);
+
+ALTER TABLE todos
+    DROP CONSTRAINT IF EXISTS todos_assigned_to_fkey,
+    ADD CONSTRAINT todos_assigned_to_fkey FOREIGN KEY (assigned_to) REFERENCES users (id);
+
+ALTER TABLE shared_todos
+    DROP CONSTRAINT IF EXISTS shared_todos_shared_by_fkey,
+    ADD CONSTRAINT shared_todos_shared_by_fkey FOREIGN KEY (shared_by) REFERENCES users (id);
@@ -0,0 +1,22 @@
+-- Let users be deleted. Activity, shares and uploads of a deleted user are
+-- removed; TODOs they shared or were assigned keep existing without them.
+-- Teams they created still block the deletion.
+ALTER TABLE activity_logs
+    DROP CONSTRAINT IF EXISTS activity_logs_user_id_fkey,
+    ADD CONSTRAINT activity_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE todos
+    DROP CONSTRAINT IF EXISTS todos_shared_by_fkey,
+    ADD CONSTRAINT todos_shared_by_fkey FOREIGN KEY (shared_by) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE todos
+    DROP CONSTRAINT IF EXISTS todos_assigned_to_fkey,
+    ADD CONSTRAINT todos_assigned_to_fkey FOREIGN KEY (assigned_to) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE shared_todos
+    DROP CONSTRAINT IF EXISTS shared_todos_shared_by_fkey,
+    ADD CONSTRAINT shared_todos_shared_by_fkey FOREIGN KEY (shared_by) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE media_attachments
+    DROP CONSTRAINT IF EXISTS fk_media_user,
+    ADD CONSTRAINT fk_media_user FOREIGN KEY (uploaded_by) REFERENCES users (id) ON DELETE CASCADE;
@@ -0,0 +1,15 @@
+-- Restore cascading deletes of activity and uploads; anonymized rows are dropped
+DELETE FROM activity_logs WHERE user_id IS NULL;
+ALTER TABLE activity_logs
+    DROP CONSTRAINT IF EXISTS activity_logs_user_id_fkey,
+    ALTER COLUMN user_id SET NOT NULL,
+    ADD CONSTRAINT activity_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+
+DELETE FROM media_attachments WHERE uploaded_by IS NULL;
+ALTER TABLE media_attachments
+    DROP CONSTRAINT IF EXISTS fk_media_user,
+    ALTER COLUMN uploaded_by SET NOT NULL,
+    ADD CONSTRAINT fk_media_user FOREIGN KEY (uploaded_by) REFERENCES users (id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_users_deletion_scheduled_at;
+ALTER TABLE users DROP COLUMN IF EXISTS deletion_scheduled_at;
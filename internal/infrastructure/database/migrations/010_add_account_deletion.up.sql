@@ -0,0 +1,17 @@
+-- Accounts are deleted once their grace period ends
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_users_deletion_scheduled_at ON users (deletion_scheduled_at)
+    WHERE deletion_scheduled_at IS NOT NULL;
+
+-- Activity and uploads of deleted users are kept without the user
+ALTER TABLE activity_logs
+    ALTER COLUMN user_id DROP NOT NULL,
+    DROP CONSTRAINT IF EXISTS activity_logs_user_id_fkey,
+    ADD CONSTRAINT activity_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE media_attachments
+    ALTER COLUMN uploaded_by DROP NOT NULL,
+    DROP CONSTRAINT IF EXISTS fk_media_user,
+    ADD CONSTRAINT fk_media_user FOREIGN KEY (uploaded_by) REFERENCES users (id) ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+-- Drop TODO recurrence
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS occurrence,
+    DROP COLUMN IF EXISTS recurrence_rule;
@@ -0,0 +1,5 @@
+-- Recurring TODOs: the open occurrence of a series carries an RFC 5545 RRULE,
+-- and each occurrence records its number within the series
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS recurrence_rule TEXT,
+    ADD COLUMN IF NOT EXISTS occurrence INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,24 @@
+-- Create reminders table for TODO reminders. A reminder fires at remind_at
+-- if set (absolute or snoozed reminders), otherwise before_due_seconds before
+-- the TODO's due date.
+CREATE TABLE reminders
+(
+    id                 UUID PRIMARY KEY,
+    todo_id            UUID NOT NULL,
+    user_id            UUID NOT NULL,
+    before_due_seconds BIGINT,
+    remind_at          TIMESTAMP WITH TIME ZONE,
+    fired_at           TIMESTAMP WITH TIME ZONE,
+    dismissed_at       TIMESTAMP WITH TIME ZONE,
+    created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_reminders_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_reminders_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT chk_reminders_trigger CHECK (before_due_seconds IS NOT NULL OR remind_at IS NOT NULL)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_reminders_todo_user ON reminders (todo_id, user_id);
+CREATE INDEX idx_reminders_pending ON reminders (remind_at)
+    WHERE fired_at IS NULL AND dismissed_at IS NULL;
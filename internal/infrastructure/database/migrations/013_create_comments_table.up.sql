@@ -0,0 +1,23 @@
+-- Create comments table for discussions on TODOs. Replies reference their
+-- parent comment; deleted comments with replies are kept with deleted_at set.
+CREATE TABLE comments
+(
+    id         UUID PRIMARY KEY,
+    todo_id    UUID NOT NULL,
+    user_id    UUID,
+    parent_id  UUID,
+    content    TEXT NOT NULL,
+    edited_at  TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_comments_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL,
+    CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments (id) ON DELETE CASCADE
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_comments_todo_id ON comments (todo_id, created_at);
+CREATE INDEX idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX idx_comments_user_id ON comments (user_id);
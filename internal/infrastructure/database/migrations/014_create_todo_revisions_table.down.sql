@@ -0,0 +1,2 @@
+-- Drop todo_revisions table
+DROP TABLE IF EXISTS todo_revisions;
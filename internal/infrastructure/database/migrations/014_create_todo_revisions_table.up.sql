@@ -0,0 +1,20 @@
+-- Create todo_revisions table for the revision history of TODOs. Each row
+-- stores the state of a TODO after one change in snapshot.
+CREATE TABLE todo_revisions
+(
+    id             UUID PRIMARY KEY,
+    todo_id        UUID    NOT NULL,
+    revision       INTEGER NOT NULL,
+    actor_id       UUID,
+    action         VARCHAR(50) NOT NULL,
+    changed_fields TEXT[],
+    snapshot       JSONB   NOT NULL,
+    created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_todo_revisions_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_todo_revisions_actor FOREIGN KEY (actor_id) REFERENCES users (id) ON DELETE SET NULL,
+    CONSTRAINT uq_todo_revisions_revision UNIQUE (todo_id, revision)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_todo_revisions_actor_id ON todo_revisions (actor_id);
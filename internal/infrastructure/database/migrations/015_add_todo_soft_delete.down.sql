@@ -0,0 +1,5 @@
+-- Drop TODO soft deletion
+DROP INDEX IF EXISTS idx_todos_deleted_at;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,6 @@
+-- Soft deletion: deleted TODOs stay in the trash until they are restored or
+-- purged. Subtasks deleted with their parent share its deleted_at.
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_todos_deleted_at ON todos (deleted_at) WHERE deleted_at IS NOT NULL;
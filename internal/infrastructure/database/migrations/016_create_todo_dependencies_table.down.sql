@@ -0,0 +1,2 @@
+-- Drop todo_dependencies table
+DROP TABLE IF EXISTS todo_dependencies;
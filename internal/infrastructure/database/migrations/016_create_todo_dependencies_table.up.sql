@@ -0,0 +1,19 @@
+-- Create todo_dependencies table for "blocked by" relationships between
+-- TODOs. A TODO cannot start until the TODOs it is blocked by are finished.
+CREATE TABLE todo_dependencies
+(
+    todo_id       UUID NOT NULL,
+    blocked_by_id UUID NOT NULL,
+    created_by    UUID,
+    created_at    TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (todo_id, blocked_by_id),
+    CONSTRAINT fk_todo_dependencies_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_todo_dependencies_blocked_by FOREIGN KEY (blocked_by_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_todo_dependencies_created_by FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL,
+    CONSTRAINT chk_todo_dependencies_not_self CHECK (todo_id <> blocked_by_id)
+);
+
+-- Create indexes for better query performance
+CREATE INDEX idx_todo_dependencies_blocked_by_id ON todo_dependencies (blocked_by_id);
+CREATE INDEX idx_todo_dependencies_created_by ON todo_dependencies (created_by);
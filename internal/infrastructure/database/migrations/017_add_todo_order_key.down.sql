@@ -0,0 +1,5 @@
+-- Drop TODO order keys
+DROP INDEX IF EXISTS idx_todos_parent_order;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS order_key;
@@ -0,0 +1,7 @@
+-- Fractional order keys of TODOs among their siblings. Keys are compared
+-- byte by byte, hence the C collation. TODOs created before this migration
+-- have no key until their list is first reordered.
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS order_key TEXT COLLATE "C";
+
+CREATE INDEX IF NOT EXISTS idx_todos_parent_order ON todos (parent_id, order_key);
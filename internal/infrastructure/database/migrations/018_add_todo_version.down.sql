@@ -0,0 +1,3 @@
+-- Drop the TODO version counter
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,4 @@
+-- Version counter for optimistic concurrency control: every saved change
+-- increments it, and a change based on an older version is rejected.
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
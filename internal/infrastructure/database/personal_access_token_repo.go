@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// personalAccessTokenColumns lists the columns scanned by scanPersonalAccessToken
+const personalAccessTokenColumns = `id, user_id, name, token_hash, scopes, created_at, expires_at, last_used_at, revoked_at`
+
+// PostgresPersonalAccessTokenRepository implements PersonalAccessTokenRepository using PostgreSQL
+type PostgresPersonalAccessTokenRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPersonalAccessTokenRepository creates a new PostgreSQL personal access token repository
+func NewPostgresPersonalAccessTokenRepository(db *sql.DB) *PostgresPersonalAccessTokenRepository {
+	return &PostgresPersonalAccessTokenRepository{db: db}
+}
+
+// Create stores a new token
+func (r *PostgresPersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		token.ID,
+		token.UserID,
+		token.Name,
+		token.TokenHash,
+		pq.Array(token.Scopes),
+		token.CreatedAt,
+		token.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create personal access token: %w", err)
+	}
+	return nil
+}
+
+// GetByHash retrieves a token by its hash
+func (r *PostgresPersonalAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	query := `SELECT ` + personalAccessTokenColumns + ` FROM personal_access_tokens WHERE token_hash = $1`
+
+	token, err := scanPersonalAccessToken(r.db.QueryRowContext(ctx, query, tokenHash))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("personal access token not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// ListByUser retrieves the unrevoked tokens of a user
+func (r *PostgresPersonalAccessTokenRepository) ListByUser(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	query := `
+		SELECT ` + personalAccessTokenColumns + `
+		FROM personal_access_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []*domain.PersonalAccessToken
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// Revoke revokes one of a user's tokens
+func (r *PostgresPersonalAccessTokenRepository) Revoke(ctx context.Context, userID, id string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE personal_access_tokens SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL",
+		id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke personal access token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("personal access token not found")
+	}
+
+	return nil
+}
+
+// RevokeAllByUser revokes all of a user's unrevoked tokens
+func (r *PostgresPersonalAccessTokenRepository) RevokeAllByUser(ctx context.Context, userID string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE personal_access_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL",
+		userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke personal access tokens: %w", err)
+	}
+	return nil
+}
+
+// UpdateLastUsed records when a token was last used
+func (r *PostgresPersonalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE personal_access_tokens SET last_used_at = $1 WHERE id = $2", usedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update personal access token: %w", err)
+	}
+	return nil
+}
+
+// scanPersonalAccessToken scans a personal access token row
+func scanPersonalAccessToken(row rowScanner) (*domain.PersonalAccessToken, error) {
+	var token domain.PersonalAccessToken
+	var expiresAt, lastUsedAt, revokedAt sql.NullTime
+
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.TokenHash,
+		pq.Array(&token.Scopes),
+		&token.CreatedAt,
+		&expiresAt,
+		&lastUsedAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		token.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+
+	return &token, nil
+}
@@ -48,13 +48,29 @@ func (r *PostgresRepository) Close() error {
 	return r.db.Close()
 }
 
+// Ping verifies the database connection is alive
+func (r *PostgresRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
 // Create creates a new TODO
 func (r *PostgresRepository) Create(ctx context.Context, todo *domain.TODO) error {
+	return r.create(ctx, r.db, todo)
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// create inserts a new TODO
+func (r *PostgresRepository) create(ctx context.Context, e execer, todo *domain.TODO) error {
 	query := `
 		INSERT INTO todos (
 			id, user_id, title, description, status, priority, due_date,
-			tags, is_shared, shared_by, created_at, updated_at, completed_at, assigned_to, parent_id, position
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			tags, is_shared, shared_by, created_at, updated_at, completed_at, assigned_to, parent_id, position,
+			recurrence_rule, occurrence, order_key, version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
 	`
 
 	var dueDate, completedAt interface{}
@@ -65,7 +81,7 @@ func (r *PostgresRepository) Create(ctx context.Context, todo *domain.TODO) erro
 		completedAt = todo.CompletedAt
 	}
 
-	var assignedTo, parentID, sharedBy interface{}
+	var assignedTo, parentID, sharedBy, recurrenceRule, orderKey interface{}
 	if todo.AssignedTo != nil {
 		assignedTo = *todo.AssignedTo
 	}
@@ -75,8 +91,14 @@ func (r *PostgresRepository) Create(ctx context.Context, todo *domain.TODO) erro
 	if todo.SharedBy != nil {
 		sharedBy = *todo.SharedBy
 	}
+	if todo.RecurrenceRule != "" {
+		recurrenceRule = todo.RecurrenceRule
+	}
+	if todo.OrderKey != "" {
+		orderKey = todo.OrderKey
+	}
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := e.ExecContext(ctx, query,
 		todo.ID,
 		todo.UserID,
 		todo.Title,
@@ -93,44 +115,20 @@ func (r *PostgresRepository) Create(ctx context.Context, todo *domain.TODO) erro
 		assignedTo,
 		parentID,
 		todo.Position,
+		recurrenceRule,
+		todo.Occurrence,
+		orderKey,
+		todo.Version,
 	)
 
 	return err
 }
 
-// GetByID retrieves a TODO by ID
+// GetByID retrieves a TODO by ID. TODOs in the trash are not found.
 func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*domain.TODO, error) {
-	query := `
-		SELECT id, user_id, title, description, status, priority, due_date,
-		       tags, is_shared, shared_by, created_at, updated_at, completed_at, assigned_to, parent_id, position
-		FROM todos
-		WHERE id = $1
-	`
-
-	var todo domain.TODO
-	var dueDate, completedAt sql.NullTime
-	var assignedToStr, parentIDStr, sharedByStr sql.NullString
-	var tags pq.StringArray
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&todo.ID,
-		&todo.UserID,
-		&todo.Title,
-		&todo.Description,
-		&todo.Status,
-		&todo.Priority,
-		&dueDate,
-		&tags,
-		&todo.IsShared,
-		&sharedByStr,
-		&todo.CreatedAt,
-		&todo.UpdatedAt,
-		&completedAt,
-		&assignedToStr,
-		&parentIDStr,
-		&todo.Position,
-	)
+	query := `SELECT ` + todoColumns + ` FROM todos WHERE id = $1 AND deleted_at IS NULL`
 
+	todo, err := scanTODO(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("todo not found: %w", err)
 	}
@@ -138,34 +136,99 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*domain.TO
 		return nil, err
 	}
 
-	if dueDate.Valid {
-		todo.DueDate = &dueDate.Time
+	return todo, nil
+}
+
+// Update updates an existing TODO if it is still at todo.Version, and then
+// sets todo.Version to the incremented version. domain.ErrVersionConflict is
+// returned if the TODO has been changed since that version was read.
+func (r *PostgresRepository) Update(ctx context.Context, todo *domain.TODO) error {
+	version, err := r.update(ctx, r.db, todo)
+	if err != nil {
+		return err
 	}
-	if completedAt.Valid {
-		todo.CompletedAt = &completedAt.Time
+
+	todo.Version = version
+	return nil
+}
+
+// UpdateMany updates several TODOs and sets the order keys of others in one
+// transaction. The versions of the TODOs are only incremented once the
+// transaction has been committed.
+func (r *PostgresRepository) UpdateMany(ctx context.Context, todos []*domain.TODO, orderKeys map[string]string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
 	}
-	if assignedToStr.Valid {
-		todo.AssignedTo = &assignedToStr.String
+
+	if err := setOrderKeys(ctx, tx, orderKeys); err != nil {
+		tx.Rollback()
+		return err
 	}
-	if parentIDStr.Valid {
-		todo.ParentID = &parentIDStr.String
+
+	versions := make([]int64, len(todos))
+	for i, todo := range todos {
+		if versions[i], err = r.update(ctx, tx, todo); err != nil {
+			tx.Rollback()
+			return &domain.BatchSaveError{ID: todo.ID, Err: err}
+		}
 	}
-	if sharedByStr.Valid {
-		todo.SharedBy = &sharedByStr.String
+
+	if err := tx.Commit(); err != nil {
+		return err
 	}
-	todo.Tags = []string(tags)
 
-	return &todo, nil
+	for i, todo := range todos {
+		todo.Version = versions[i]
+	}
+	return nil
 }
 
-// Update updates an existing TODO
-func (r *PostgresRepository) Update(ctx context.Context, todo *domain.TODO) error {
+// CompleteOccurrence saves a completed occurrence of a recurring TODO if it
+// is still at its version, and creates the TODOs that continue its series, in
+// one transaction. The version of the occurrence is only incremented once the
+// transaction has been committed.
+func (r *PostgresRepository) CompleteOccurrence(ctx context.Context, completed *domain.TODO, created []*domain.TODO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	version, err := r.update(ctx, tx, completed)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, todo := range created {
+		if err := r.create(ctx, tx, todo); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to create todo %s: %w", todo.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	completed.Version = version
+	return nil
+}
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// update saves a TODO based on its version and returns its new version
+func (r *PostgresRepository) update(ctx context.Context, q queryRower, todo *domain.TODO) (int64, error) {
 	query := `
 		UPDATE todos
 		SET title = $2, description = $3, status = $4, priority = $5, due_date = $6,
 		    tags = $7, is_shared = $8, shared_by = $9, updated_at = $10, completed_at = $11, 
-		    assigned_to = $12, parent_id = $13, position = $14
-		WHERE id = $1
+		    assigned_to = $12, parent_id = $13, position = $14, recurrence_rule = $15, occurrence = $16,
+		    order_key = $17, version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL AND version = $18
+		RETURNING version
 	`
 
 	var dueDate, completedAt interface{}
@@ -176,7 +239,7 @@ func (r *PostgresRepository) Update(ctx context.Context, todo *domain.TODO) erro
 		completedAt = todo.CompletedAt
 	}
 
-	var assignedTo, parentID, sharedBy interface{}
+	var assignedTo, parentID, sharedBy, recurrenceRule, orderKey interface{}
 	if todo.AssignedTo != nil {
 		assignedTo = *todo.AssignedTo
 	}
@@ -186,8 +249,15 @@ func (r *PostgresRepository) Update(ctx context.Context, todo *domain.TODO) erro
 	if todo.SharedBy != nil {
 		sharedBy = *todo.SharedBy
 	}
+	if todo.RecurrenceRule != "" {
+		recurrenceRule = todo.RecurrenceRule
+	}
+	if todo.OrderKey != "" {
+		orderKey = todo.OrderKey
+	}
 
-	result, err := r.db.ExecContext(ctx, query,
+	var version int64
+	err := q.QueryRowContext(ctx, query,
 		todo.ID,
 		todo.Title,
 		todo.Description,
@@ -202,29 +272,34 @@ func (r *PostgresRepository) Update(ctx context.Context, todo *domain.TODO) erro
 		assignedTo,
 		parentID,
 		todo.Position,
-	)
-
-	if err != nil {
-		return err
+		recurrenceRule,
+		todo.Occurrence,
+		orderKey,
+		todo.Version,
+	).Scan(&version)
+	if err == sql.ErrNoRows {
+		// Tell a stale version apart from a missing TODO
+		exists, err := r.Exists(ctx, todo.ID)
+		if err != nil {
+			return 0, err
+		}
+		if exists {
+			return 0, domain.ErrVersionConflict
+		}
+		return 0, fmt.Errorf("todo not found")
 	}
-
-	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("todo not found")
-	}
-
-	return nil
+	return version, nil
 }
 
-// Delete deletes a TODO by ID
+// Delete moves a TODO and its subtasks to the trash. They are all given
+// the same deletion time, which marks them as deleted together; subtasks that
+// are already in the trash keep their own.
 func (r *PostgresRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM todos WHERE id = $1`
-
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, trashSubtreeQuery, id, time.Now())
 	if err != nil {
 		return err
 	}
@@ -241,9 +316,20 @@ func (r *PostgresRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// List retrieves TODOs with filtering, sorting, and pagination
+// trashSubtreeQuery moves the TODO $1 and its subtasks to the trash at $2
+const trashSubtreeQuery = `
+	WITH RECURSIVE subtree AS (
+	    SELECT id FROM todos WHERE id = $1 AND deleted_at IS NULL
+	    UNION ALL
+	    SELECT c.id FROM todos c JOIN subtree s ON c.parent_id = s.id WHERE c.deleted_at IS NULL
+	)
+	UPDATE todos SET deleted_at = $2, version = version + 1 WHERE id IN (SELECT id FROM subtree)
+`
+
+// List retrieves TODOs with filtering, sorting, and pagination. TODOs in the
+// trash are excluded.
 func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOptions) ([]*domain.TODO, *domain.PaginationResult, error) {
-	var conditions []string
+	conditions := []string{"deleted_at IS NULL"}
 	var args []interface{}
 	argIndex := 1
 
@@ -366,6 +452,16 @@ func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOp
 		argIndex++
 	}
 
+	if options.Filter.ReadyToStart {
+		conditions = append(conditions, fmt.Sprintf(`status = $%d AND NOT EXISTS (
+			SELECT 1 FROM todo_dependencies d JOIN todos b ON b.id = d.blocked_by_id
+			WHERE d.todo_id = todos.id AND b.deleted_at IS NULL AND b.status NOT IN ($%d, $%d))`,
+			argIndex, argIndex+1, argIndex+2))
+		args = append(args, int32(commonv1.Status_STATUS_NOT_STARTED),
+			int32(commonv1.Status_STATUS_COMPLETED), int32(commonv1.Status_STATUS_CANCELLED))
+		argIndex += 3
+	}
+
 	if options.Filter.SearchQuery != nil {
 		searchQuery := "%" + *options.Filter.SearchQuery + "%"
 		searchFields := options.Filter.SearchFields
@@ -398,8 +494,9 @@ func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOp
 		whereClause = "WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	// Build ORDER BY clause
-	orderBy := "ORDER BY created_at DESC"
+	// Build ORDER BY clause. Without sort options TODOs are listed in their
+	// manual order, grouped by parent; the ID breaks any remaining ties.
+	orderBy := "ORDER BY parent_id NULLS FIRST, " + manualOrder
 	if len(options.SortOptions) > 0 {
 		var orderParts []string
 		for _, sort := range options.SortOptions {
@@ -410,7 +507,7 @@ func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOp
 			}
 			orderParts = append(orderParts, fmt.Sprintf("%s %s", field, direction))
 		}
-		orderBy = "ORDER BY " + strings.Join(orderParts, ", ")
+		orderBy = "ORDER BY " + strings.Join(orderParts, ", ") + ", id"
 	}
 
 	// Build pagination
@@ -437,9 +534,9 @@ func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOp
 
 	// Fetch items using safe string building
 	var queryBuilder strings.Builder
-	queryBuilder.WriteString("SELECT id, user_id, title, description, status, priority, due_date, ")
-	queryBuilder.WriteString("tags, is_shared, shared_by, created_at, updated_at, completed_at, assigned_to, parent_id, position ")
-	queryBuilder.WriteString("FROM todos ")
+	queryBuilder.WriteString("SELECT ")
+	queryBuilder.WriteString(todoColumns)
+	queryBuilder.WriteString(" FROM todos ")
 	queryBuilder.WriteString(whereClause)
 	queryBuilder.WriteString(" ")
 	queryBuilder.WriteString(orderBy)
@@ -458,51 +555,11 @@ func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOp
 
 	var todos []*domain.TODO
 	for rows.Next() {
-		var todo domain.TODO
-		var dueDate, completedAt sql.NullTime
-		var assignedToStr, parentIDStr, sharedByStr sql.NullString
-		var tags pq.StringArray
-
-		err := rows.Scan(
-			&todo.ID,
-			&todo.UserID,
-			&todo.Title,
-			&todo.Description,
-			&todo.Status,
-			&todo.Priority,
-			&dueDate,
-			&tags,
-			&todo.IsShared,
-			&sharedByStr,
-			&todo.CreatedAt,
-			&todo.UpdatedAt,
-			&completedAt,
-			&assignedToStr,
-			&parentIDStr,
-			&todo.Position,
-		)
+		todo, err := scanTODO(rows)
 		if err != nil {
 			return nil, nil, err
 		}
-
-		if dueDate.Valid {
-			todo.DueDate = &dueDate.Time
-		}
-		if completedAt.Valid {
-			todo.CompletedAt = &completedAt.Time
-		}
-		if assignedToStr.Valid {
-			todo.AssignedTo = &assignedToStr.String
-		}
-		if parentIDStr.Valid {
-			todo.ParentID = &parentIDStr.String
-		}
-		if sharedByStr.Valid {
-			todo.SharedBy = &sharedByStr.String
-		}
-		todo.Tags = []string(tags)
-
-		todos = append(todos, &todo)
+		todos = append(todos, todo)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -527,56 +584,13 @@ func (r *PostgresRepository) List(ctx context.Context, options domain.TODOListOp
 	return todos, pagination, nil
 }
 
-// BulkUpdateStatus updates status for multiple TODOs
-func (r *PostgresRepository) BulkUpdateStatus(ctx context.Context, ids []string, status commonv1.Status) error {
-	if len(ids) == 0 {
-		return nil
-	}
-
-	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids)+1)
-	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
-	}
-	args[len(ids)] = int32(status)
-
-	// Build parameter placeholders for the IN clause using safe concatenation
-	var inClauseBuilder strings.Builder
-	inClauseBuilder.WriteString("$1")
-	for i := 2; i <= len(ids); i++ {
-		inClauseBuilder.WriteString(", $")
-		inClauseBuilder.WriteString(strconv.Itoa(i))
-	}
-
-	// Build query using safe string building
-	var queryBuilder strings.Builder
-	queryBuilder.WriteString("UPDATE todos SET status = $")
-	queryBuilder.WriteString(strconv.Itoa(len(ids) + 1))
-	queryBuilder.WriteString(", updated_at = $")
-	queryBuilder.WriteString(strconv.Itoa(len(ids) + 2))
-	queryBuilder.WriteString(" WHERE id IN (")
-	queryBuilder.WriteString(inClauseBuilder.String())
-	queryBuilder.WriteString(")")
-
-	// Reorder args: ids first, then status, then updated_at
-	newArgs := make([]interface{}, 0, len(ids)+2)
-	newArgs = append(newArgs, args...)
-	newArgs = append(newArgs, int32(status))
-	newArgs = append(newArgs, time.Now())
-
-	_, err := r.db.ExecContext(ctx, queryBuilder.String(), newArgs...)
-	return err
-}
-
-// BulkDelete deletes multiple TODOs
+// BulkDelete moves multiple TODOs and their subtasks to the trash
 func (r *PostgresRepository) BulkDelete(ctx context.Context, ids []string) error {
 	if len(ids) == 0 {
 		return nil
 	}
 
-	// Use individual DELETE statements in a transaction
-	// This is the safest approach as it avoids any dynamic SQL construction
+	// Trash each subtree in a transaction, with one deletion time for all
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -588,8 +602,9 @@ func (r *PostgresRepository) BulkDelete(ctx context.Context, ids []string) error
 		}
 	}()
 
+	deletedAt := time.Now()
 	for _, id := range ids {
-		_, err := tx.ExecContext(ctx, "DELETE FROM todos WHERE id = $1", id)
+		_, err := tx.ExecContext(ctx, trashSubtreeQuery, id, deletedAt)
 		if err != nil {
 			tx.Rollback()
 			return err
@@ -599,9 +614,9 @@ func (r *PostgresRepository) BulkDelete(ctx context.Context, ids []string) error
 	return tx.Commit()
 }
 
-// Exists checks if a TODO exists by ID
+// Exists checks if a TODO exists by ID. TODOs in the trash do not exist.
 func (r *PostgresRepository) Exists(ctx context.Context, id string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM todos WHERE id = $1)`
+	query := `SELECT EXISTS(SELECT 1 FROM todos WHERE id = $1 AND deleted_at IS NULL)`
 	var exists bool
 	err := r.db.QueryRowContext(ctx, query, id).Scan(&exists)
 	return exists, err
@@ -688,6 +703,422 @@ func (r *PostgresRepository) GetSharedTeams(ctx context.Context, todoID string)
 	return teamIDs, rows.Err()
 }
 
+// trashRootCondition selects TODOs t in the trash that were not deleted
+// together with their parent
+const trashRootCondition = `t.deleted_at IS NOT NULL AND NOT EXISTS (
+	    SELECT 1 FROM todos p WHERE p.id = t.parent_id AND p.deleted_at = t.deleted_at
+	)`
+
+// ListTrash retrieves a user's TODOs in the trash, most recently deleted
+// first. Subtasks deleted together with their parent are not listed.
+func (r *PostgresRepository) ListTrash(ctx context.Context, userID string, page, pageSize int32) ([]*domain.TODO, *domain.PaginationResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	var totalItems int32
+	countQuery := `SELECT COUNT(*) FROM todos t WHERE t.user_id = $1 AND ` + trashRootCondition
+	if err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&totalItems); err != nil {
+		return nil, nil, err
+	}
+
+	query := `SELECT ` + todoColumns + ` FROM todos t
+		WHERE t.user_id = $1 AND ` + trashRootCondition + `
+		ORDER BY t.deleted_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var todos []*domain.TODO
+	for rows.Next() {
+		todo, err := scanTODO(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		todos = append(todos, todo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := (totalItems + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return todos, &domain.PaginationResult{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+		HasNext:     page < totalPages,
+		HasPrev:     page > 1,
+	}, nil
+}
+
+// GetDeletedByID retrieves a TODO in the trash by ID
+func (r *PostgresRepository) GetDeletedByID(ctx context.Context, id string) (*domain.TODO, error) {
+	query := `SELECT ` + todoColumns + ` FROM todos WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	todo, err := scanTODO(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("todo not found in trash: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// Restore takes a TODO out of the trash together with the subtasks that were
+// deleted with it. Subtasks deleted before it stay in the trash.
+func (r *PostgresRepository) Restore(ctx context.Context, id string) error {
+	query := `
+		WITH RECURSIVE subtree AS (
+		    SELECT id, deleted_at FROM todos WHERE id = $1 AND deleted_at IS NOT NULL
+		    UNION ALL
+		    SELECT c.id, c.deleted_at FROM todos c JOIN subtree s ON c.parent_id = s.id
+		    WHERE c.deleted_at = s.deleted_at
+		)
+		UPDATE todos SET deleted_at = NULL, updated_at = $2, version = version + 1 WHERE id IN (SELECT id FROM subtree)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, time.Now())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("todo not found in trash")
+	}
+
+	return nil
+}
+
+// Purge permanently deletes a TODO in the trash. Its subtasks, media
+// attachments and other data are removed with it by ON DELETE CASCADE; the
+// file URLs of the attachments are returned first, so that the stored files
+// can be deleted too.
+func (r *PostgresRepository) Purge(ctx context.Context, id string) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+		    SELECT id FROM todos WHERE id = $1 AND deleted_at IS NOT NULL
+		    UNION ALL
+		    SELECT c.id FROM todos c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT file_url FROM media_attachments WHERE todo_id IN (SELECT id FROM subtree)
+	`, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to list media: %w", err)
+	}
+
+	var fileURLs []string
+	for rows.Next() {
+		var fileURL string
+		if err := rows.Scan(&fileURL); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		fileURLs = append(fileURLs, fileURL)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM todos WHERE id = $1 AND deleted_at IS NOT NULL", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to purge todo: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return nil, fmt.Errorf("todo not found in trash")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return fileURLs, nil
+}
+
+// ListPurgeable retrieves the IDs of up to limit TODOs that were moved to the
+// trash before the given time, oldest first, excluding subtasks deleted
+// together with their parent
+func (r *PostgresRepository) ListPurgeable(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	query := `SELECT t.id FROM todos t
+		WHERE ` + trashRootCondition + ` AND t.deleted_at <= $1
+		ORDER BY t.deleted_at
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// GetTree retrieves a TODO with its subtasks down to depth levels below it.
+// The whole subtree is walked so that each TODO's subtask counts include the
+// subtasks below the requested depth. The path of each TODO guards the walk
+// against parent cycles.
+func (r *PostgresRepository) GetTree(ctx context.Context, id string, depth int32) (*domain.TODOTreeNode, error) {
+	query := `
+		WITH RECURSIVE tree AS (
+		    SELECT id AS node_id, status AS node_status, ARRAY[id] AS path
+		    FROM todos WHERE id = $1 AND deleted_at IS NULL
+		    UNION ALL
+		    SELECT c.id, c.status, t.path || c.id
+		    FROM todos c JOIN tree t ON c.parent_id = t.node_id
+		    WHERE c.deleted_at IS NULL AND NOT c.id = ANY(t.path)
+		), nodes AS (
+		    SELECT n.node_id, cardinality(n.path) - 1 AS depth,
+		        COUNT(d.node_id) FILTER (WHERE d.node_status = $3) AS completed_subtasks,
+		        COUNT(d.node_id) AS total_subtasks
+		    FROM tree n LEFT JOIN tree d ON d.node_id <> n.node_id AND n.node_id = ANY(d.path)
+		    WHERE cardinality(n.path) - 1 <= $2
+		    GROUP BY n.node_id, n.path
+		)
+		SELECT ` + todoColumns + `, nodes.completed_subtasks, nodes.total_subtasks
+		FROM todos JOIN nodes ON id = nodes.node_id
+		ORDER BY nodes.depth, ` + manualOrder + `
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, id, depth, int32(commonv1.Status_STATUS_COMPLETED))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []*domain.TODOTreeNode
+	for rows.Next() {
+		var node domain.TODOTreeNode
+		todo, err := scanTODO(treeRow{rows, &node})
+		if err != nil {
+			return nil, err
+		}
+		node.TODO = todo
+		nodes = append(nodes, &node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("todo not found")
+	}
+
+	return domain.BuildTODOTree(nodes), nil
+}
+
+// treeRow scans a TODO row followed by the subtask counts of a tree node
+type treeRow struct {
+	rows *sql.Rows
+	node *domain.TODOTreeNode
+}
+
+// Scan scans the TODO columns into dest and the counts into the node
+func (r treeRow) Scan(dest ...interface{}) error {
+	return r.rows.Scan(append(dest, &r.node.CompletedSubtasks, &r.node.TotalSubtasks)...)
+}
+
+// IsDescendant reports whether a TODO is a subtask of ancestorID, at any
+// depth, by walking up its parents
+func (r *PostgresRepository) IsDescendant(ctx context.Context, id, ancestorID string) (bool, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+		    SELECT parent_id, ARRAY[id] AS path FROM todos WHERE id = $1
+		    UNION ALL
+		    SELECT t.parent_id, a.path || t.id
+		    FROM todos t JOIN ancestors a ON t.id = a.parent_id
+		    WHERE NOT t.id = ANY(a.path)
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE parent_id = $2)
+	`
+
+	var descendant bool
+	err := r.db.QueryRowContext(ctx, query, id, ancestorID).Scan(&descendant)
+	return descendant, err
+}
+
+// manualOrder orders the TODOs in a list by their order keys. TODOs without
+// a key, created before order keys were introduced, come first in their old
+// order.
+const manualOrder = `order_key NULLS FIRST, position, created_at, id`
+
+// ListSiblings retrieves the TODOs in one list, in order: the subtasks of
+// parentID, or the user's top-level TODOs if parentID is nil
+func (r *PostgresRepository) ListSiblings(ctx context.Context, userID string, parentID *string) ([]*domain.TODO, error) {
+	condition, args := siblingCondition(userID, parentID)
+	query := `SELECT ` + todoColumns + ` FROM todos WHERE ` + condition + ` ORDER BY ` + manualOrder
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var todos []*domain.TODO
+	for rows.Next() {
+		todo, err := scanTODO(rows)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, todo)
+	}
+
+	return todos, rows.Err()
+}
+
+// LastOrderKey returns the greatest order key in a list of TODOs, or an empty
+// string if none has a key
+func (r *PostgresRepository) LastOrderKey(ctx context.Context, userID string, parentID *string) (string, error) {
+	condition, args := siblingCondition(userID, parentID)
+	query := `SELECT COALESCE(MAX(order_key), '') FROM todos WHERE ` + condition
+
+	var key string
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&key)
+	return key, err
+}
+
+// siblingCondition selects the live TODOs in the list of parentID's subtasks,
+// or of userID's top-level TODOs if parentID is nil
+func siblingCondition(userID string, parentID *string) (string, []interface{}) {
+	if parentID != nil && *parentID != "" {
+		return "parent_id = $1 AND deleted_at IS NULL", []interface{}{*parentID}
+	}
+	return "user_id = $1 AND parent_id IS NULL AND deleted_at IS NULL", []interface{}{userID}
+}
+
+// SetOrderKeys sets the order keys of TODOs by ID in one transaction
+func (r *PostgresRepository) SetOrderKeys(ctx context.Context, keys map[string]string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := setOrderKeys(ctx, tx, keys); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setOrderKeys sets the order keys of TODOs by ID within a transaction
+func setOrderKeys(ctx context.Context, tx *sql.Tx, keys map[string]string) error {
+	for id, key := range keys {
+		if _, err := tx.ExecContext(ctx, "UPDATE todos SET order_key = $2, version = version + 1 WHERE id = $1", id, key); err != nil {
+			return fmt.Errorf("failed to set order key: %w", err)
+		}
+	}
+	return nil
+}
+
+// todoColumns lists the columns scanned by scanTODO
+const todoColumns = `id, user_id, title, description, status, priority, due_date,
+	tags, is_shared, shared_by, created_at, updated_at, completed_at, assigned_to, parent_id, position,
+	COALESCE(recurrence_rule, ''), occurrence, deleted_at, COALESCE(order_key, ''), version`
+
+// scanTODO scans a TODO row
+func scanTODO(row rowScanner) (*domain.TODO, error) {
+	var todo domain.TODO
+	var dueDate, completedAt, deletedAt sql.NullTime
+	var assignedToStr, parentIDStr, sharedByStr sql.NullString
+	var tags pq.StringArray
+
+	err := row.Scan(
+		&todo.ID,
+		&todo.UserID,
+		&todo.Title,
+		&todo.Description,
+		&todo.Status,
+		&todo.Priority,
+		&dueDate,
+		&tags,
+		&todo.IsShared,
+		&sharedByStr,
+		&todo.CreatedAt,
+		&todo.UpdatedAt,
+		&completedAt,
+		&assignedToStr,
+		&parentIDStr,
+		&todo.Position,
+		&todo.RecurrenceRule,
+		&todo.Occurrence,
+		&deletedAt,
+		&todo.OrderKey,
+		&todo.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if dueDate.Valid {
+		todo.DueDate = &dueDate.Time
+	}
+	if completedAt.Valid {
+		todo.CompletedAt = &completedAt.Time
+	}
+	if deletedAt.Valid {
+		todo.DeletedAt = &deletedAt.Time
+	}
+	if assignedToStr.Valid {
+		todo.AssignedTo = &assignedToStr.String
+	}
+	if parentIDStr.Valid {
+		todo.ParentID = &parentIDStr.String
+	}
+	if sharedByStr.Valid {
+		todo.SharedBy = &sharedByStr.String
+	}
+	todo.Tags = []string(tags)
+
+	return &todo, nil
+}
+
 // Migrate runs database migrations
 func (r *PostgresRepository) Migrate(ctx context.Context) error {
 	// Create schema_migrations table if it doesn't exist
@@ -876,6 +1307,268 @@ func (r *PostgresRepository) Migrate(ctx context.Context) error {
 				CREATE INDEX IF NOT EXISTS idx_media_created_at ON media_attachments(created_at);
 			`,
 		},
+		{
+			version: "003",
+			upSQL: `
+				-- Login sessions, one per device
+				CREATE TABLE IF NOT EXISTS sessions (
+				    id UUID PRIMARY KEY,
+				    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				    user_agent TEXT NOT NULL DEFAULT '',
+				    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    last_used_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+				    revoked_at TIMESTAMP WITH TIME ZONE
+				);
+
+				-- Refresh tokens issued for a session; only hashes are stored
+				CREATE TABLE IF NOT EXISTS refresh_tokens (
+				    token_hash VARCHAR(64) PRIMARY KEY,
+				    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+				    used_at TIMESTAMP WITH TIME ZONE
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+				CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+			`,
+		},
+		{
+			version: "004",
+			upSQL: `
+				-- Track whether a user's email address has been verified
+				ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+				-- Single-use tokens for email verification and password reset; only hashes are stored
+				CREATE TABLE IF NOT EXISTS user_tokens (
+				    token_hash VARCHAR(64) PRIMARY KEY,
+				    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				    purpose VARCHAR(32) NOT NULL,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+				    used_at TIMESTAMP WITH TIME ZONE
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_user_tokens_user_purpose ON user_tokens(user_id, purpose);
+			`,
+		},
+		{
+			version: "005",
+			upSQL: `
+				-- Accounts at external identity providers linked to users
+				CREATE TABLE IF NOT EXISTS user_identities (
+				    id UUID PRIMARY KEY,
+				    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				    provider VARCHAR(100) NOT NULL,
+				    subject VARCHAR(255) NOT NULL,
+				    email VARCHAR(255),
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    UNIQUE (provider, subject)
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
+			`,
+		},
+		{
+			version: "006",
+			upSQL: `
+				-- Scoped personal access tokens; only hashes are stored
+				CREATE TABLE IF NOT EXISTS personal_access_tokens (
+				    id UUID PRIMARY KEY,
+				    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				    name VARCHAR(100) NOT NULL,
+				    token_hash VARCHAR(64) UNIQUE NOT NULL,
+				    scopes TEXT[] NOT NULL DEFAULT '{}',
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    expires_at TIMESTAMP WITH TIME ZONE,
+				    last_used_at TIMESTAMP WITH TIME ZONE,
+				    revoked_at TIMESTAMP WITH TIME ZONE
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens(user_id);
+			`,
+		},
+		{
+			version: "007",
+			upSQL: `
+				-- TOTP two-factor authentication
+				ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+				CREATE TABLE IF NOT EXISTS user_totp (
+				    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+				    secret VARCHAR(64) NOT NULL,
+				    confirmed_at TIMESTAMP WITH TIME ZONE,
+				    last_used_step BIGINT NOT NULL DEFAULT 0,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+				);
+
+				-- One-time recovery codes; only hashes are stored
+				CREATE TABLE IF NOT EXISTS user_recovery_codes (
+				    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				    code_hash VARCHAR(64) NOT NULL,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    used_at TIMESTAMP WITH TIME ZONE,
+				    PRIMARY KEY (user_id, code_hash)
+				);
+			`,
+		},
+		{
+			version: "008",
+			upSQL: `
+				-- System administrators
+				ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+			`,
+		},
+		{
+			version: "009",
+			upSQL: `
+				-- Let users be deleted without losing shared data
+				ALTER TABLE activity_logs DROP CONSTRAINT IF EXISTS activity_logs_user_id_fkey,
+				    ADD CONSTRAINT activity_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+				ALTER TABLE todos DROP CONSTRAINT IF EXISTS todos_shared_by_fkey,
+				    ADD CONSTRAINT todos_shared_by_fkey FOREIGN KEY (shared_by) REFERENCES users(id) ON DELETE SET NULL;
+				ALTER TABLE todos DROP CONSTRAINT IF EXISTS todos_assigned_to_fkey,
+				    ADD CONSTRAINT todos_assigned_to_fkey FOREIGN KEY (assigned_to) REFERENCES users(id) ON DELETE SET NULL;
+				ALTER TABLE shared_todos DROP CONSTRAINT IF EXISTS shared_todos_shared_by_fkey,
+				    ADD CONSTRAINT shared_todos_shared_by_fkey FOREIGN KEY (shared_by) REFERENCES users(id) ON DELETE CASCADE;
+				ALTER TABLE media_attachments DROP CONSTRAINT IF EXISTS media_attachments_uploaded_by_fkey,
+				    ADD CONSTRAINT media_attachments_uploaded_by_fkey FOREIGN KEY (uploaded_by) REFERENCES users(id) ON DELETE CASCADE;
+			`,
+		},
+		{
+			version: "010",
+			upSQL: `
+				-- Scheduled account deletion
+				ALTER TABLE users ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMP WITH TIME ZONE;
+				CREATE INDEX IF NOT EXISTS idx_users_deletion_scheduled_at ON users(deletion_scheduled_at)
+				    WHERE deletion_scheduled_at IS NOT NULL;
+
+				-- Keep the activity and uploads of deleted users, anonymized
+				ALTER TABLE activity_logs ALTER COLUMN user_id DROP NOT NULL,
+				    DROP CONSTRAINT IF EXISTS activity_logs_user_id_fkey,
+				    ADD CONSTRAINT activity_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL;
+				ALTER TABLE media_attachments ALTER COLUMN uploaded_by DROP NOT NULL,
+				    DROP CONSTRAINT IF EXISTS media_attachments_uploaded_by_fkey,
+				    ADD CONSTRAINT media_attachments_uploaded_by_fkey FOREIGN KEY (uploaded_by) REFERENCES users(id) ON DELETE SET NULL;
+			`,
+		},
+		{
+			version: "011",
+			upSQL: `
+				-- Recurring TODOs
+				ALTER TABLE todos ADD COLUMN IF NOT EXISTS recurrence_rule TEXT,
+				    ADD COLUMN IF NOT EXISTS occurrence INTEGER NOT NULL DEFAULT 1;
+			`,
+		},
+		{
+			version: "012",
+			upSQL: `
+				-- TODO reminders, fired once by the reminder scheduler
+				CREATE TABLE IF NOT EXISTS reminders (
+				    id UUID PRIMARY KEY,
+				    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+				    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				    before_due_seconds BIGINT,
+				    remind_at TIMESTAMP WITH TIME ZONE,
+				    fired_at TIMESTAMP WITH TIME ZONE,
+				    dismissed_at TIMESTAMP WITH TIME ZONE,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    CHECK (before_due_seconds IS NOT NULL OR remind_at IS NOT NULL)
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_reminders_todo_user ON reminders(todo_id, user_id);
+				CREATE INDEX IF NOT EXISTS idx_reminders_pending ON reminders(remind_at)
+				    WHERE fired_at IS NULL AND dismissed_at IS NULL;
+			`,
+		},
+		{
+			version: "013",
+			upSQL: `
+				-- Threaded comments on TODOs; comments of deleted users are kept
+				CREATE TABLE IF NOT EXISTS comments (
+				    id UUID PRIMARY KEY,
+				    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+				    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+				    parent_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+				    content TEXT NOT NULL,
+				    edited_at TIMESTAMP WITH TIME ZONE,
+				    deleted_at TIMESTAMP WITH TIME ZONE,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_comments_todo_id ON comments(todo_id, created_at);
+				CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments(parent_id);
+				CREATE INDEX IF NOT EXISTS idx_comments_user_id ON comments(user_id);
+			`,
+		},
+		{
+			version: "014",
+			upSQL: `
+				-- Revision history of TODOs; revisions of deleted users are kept
+				CREATE TABLE IF NOT EXISTS todo_revisions (
+				    id UUID PRIMARY KEY,
+				    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+				    revision INTEGER NOT NULL,
+				    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+				    action VARCHAR(50) NOT NULL,
+				    changed_fields TEXT[],
+				    snapshot JSONB NOT NULL,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    UNIQUE (todo_id, revision)
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_todo_revisions_actor_id ON todo_revisions(actor_id);
+			`,
+		},
+		{
+			version: "015",
+			upSQL: `
+				-- Soft deletion of TODOs into a trash
+				ALTER TABLE todos
+				    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+				CREATE INDEX IF NOT EXISTS idx_todos_deleted_at ON todos(deleted_at) WHERE deleted_at IS NOT NULL;
+			`,
+		},
+		{
+			version: "016",
+			upSQL: `
+				-- "Blocked by" relationships between TODOs
+				CREATE TABLE IF NOT EXISTS todo_dependencies (
+				    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+				    blocked_by_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+				    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+				    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+				    PRIMARY KEY (todo_id, blocked_by_id),
+				    CHECK (todo_id <> blocked_by_id)
+				);
+
+				CREATE INDEX IF NOT EXISTS idx_todo_dependencies_blocked_by_id ON todo_dependencies(blocked_by_id);
+				CREATE INDEX IF NOT EXISTS idx_todo_dependencies_created_by ON todo_dependencies(created_by);
+			`,
+		},
+		{
+			version: "017",
+			upSQL: `
+				-- Fractional order keys of TODOs among their siblings
+				ALTER TABLE todos
+				    ADD COLUMN IF NOT EXISTS order_key TEXT COLLATE "C";
+
+				CREATE INDEX IF NOT EXISTS idx_todos_parent_order ON todos(parent_id, order_key);
+			`,
+		},
+		{
+			version: "018",
+			upSQL: `
+				-- Version counter for optimistic concurrency control of TODOs
+				ALTER TABLE todos
+				    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+			`,
+		},
 	}
 
 	// Apply migrations that haven't been applied yet
@@ -950,7 +1643,7 @@ func (r *PostgresRepository) CheckMigrationStatus(ctx context.Context) error {
 	}
 
 	// Expected migrations
-	expectedMigrations := []string{"001", "002"}
+	expectedMigrations := []string{"001", "002", "003", "004", "005", "006", "007", "008", "009", "010", "011", "012", "013", "014", "015", "016", "017", "018"}
 
 	// Check if all expected migrations are applied
 	appliedMap := make(map[string]bool)
@@ -1003,6 +1696,8 @@ func mapSortField(field string) string {
 		return "parent_id"
 	case "position":
 		return "position"
+	case "order_key", "orderKey":
+		return "order_key"
 	default:
 		return "created_at"
 	}
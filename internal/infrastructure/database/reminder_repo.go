@@ -0,0 +1,226 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// reminderColumns lists the columns scanned by scanReminder. They are
+// selected from reminders r joined with todos t, which provides the due date
+// relative reminders fire before.
+const reminderColumns = `r.id, r.todo_id, r.user_id, r.before_due_seconds, r.remind_at,
+	COALESCE(r.remind_at, t.due_date - r.before_due_seconds * INTERVAL '1 second'),
+	r.fired_at, r.dismissed_at, r.created_at, r.updated_at`
+
+// PostgresReminderRepository implements ReminderRepository using PostgreSQL
+type PostgresReminderRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresReminderRepository creates a new PostgreSQL reminder repository
+func NewPostgresReminderRepository(db *sql.DB) *PostgresReminderRepository {
+	return &PostgresReminderRepository{db: db}
+}
+
+// Create creates a new reminder
+func (r *PostgresReminderRepository) Create(ctx context.Context, reminder *domain.Reminder) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO reminders (id, todo_id, user_id, before_due_seconds, remind_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		reminder.ID,
+		reminder.TODOID,
+		reminder.UserID,
+		beforeDueSeconds(reminder),
+		reminder.RemindAt,
+		reminder.CreatedAt,
+		reminder.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reminder: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a reminder by ID
+func (r *PostgresReminderRepository) GetByID(ctx context.Context, id string) (*domain.Reminder, error) {
+	query := `SELECT ` + reminderColumns + ` FROM reminders r JOIN todos t ON t.id = r.todo_id WHERE r.id = $1`
+
+	reminder, err := scanReminder(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("reminder not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+// ListByTODO retrieves a user's reminders on a TODO, in the order they fire
+func (r *PostgresReminderRepository) ListByTODO(ctx context.Context, todoID, userID string) ([]*domain.Reminder, error) {
+	query := `
+		SELECT ` + reminderColumns + `
+		FROM reminders r JOIN todos t ON t.id = r.todo_id
+		WHERE r.todo_id = $1 AND r.user_id = $2
+		ORDER BY 6 NULLS LAST, r.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, todoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []*domain.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	return reminders, rows.Err()
+}
+
+// Update updates an existing reminder
+func (r *PostgresReminderRepository) Update(ctx context.Context, reminder *domain.Reminder) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE reminders
+		SET before_due_seconds = $2, remind_at = $3, fired_at = $4, dismissed_at = $5, updated_at = $6
+		WHERE id = $1
+	`,
+		reminder.ID,
+		beforeDueSeconds(reminder),
+		reminder.RemindAt,
+		reminder.FiredAt,
+		reminder.DismissedAt,
+		reminder.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("reminder not found")
+	}
+
+	return nil
+}
+
+// Delete deletes a reminder by ID
+func (r *PostgresReminderRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM reminders WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("reminder not found")
+	}
+
+	return nil
+}
+
+// ClaimDue marks up to limit reminders that are due at now as fired and
+// returns them. Rows being claimed by another server are skipped rather than
+// waited for, and the fired_at check is repeated once a row is locked, so
+// that each reminder is claimed exactly once.
+func (r *PostgresReminderRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domain.Reminder, error) {
+	query := `
+		UPDATE reminders r
+		SET fired_at = $1, updated_at = $1
+		FROM todos t
+		WHERE t.id = r.todo_id AND r.fired_at IS NULL AND r.id IN (
+		    SELECT r2.id
+		    FROM reminders r2 JOIN todos t2 ON t2.id = r2.todo_id
+		    WHERE r2.fired_at IS NULL AND r2.dismissed_at IS NULL AND t2.status <> $3 AND t2.deleted_at IS NULL
+		      AND COALESCE(r2.remind_at, t2.due_date - r2.before_due_seconds * INTERVAL '1 second') <= $1
+		    LIMIT $2
+		    FOR UPDATE OF r2 SKIP LOCKED
+		)
+		RETURNING ` + reminderColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now, limit, int32(commonv1.Status_STATUS_COMPLETED))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []*domain.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	return reminders, rows.Err()
+}
+
+// beforeDueSeconds returns the offset of a relative reminder in whole seconds
+func beforeDueSeconds(reminder *domain.Reminder) interface{} {
+	if reminder.BeforeDue == nil {
+		return nil
+	}
+	return int64(reminder.BeforeDue.Seconds())
+}
+
+// scanReminder scans a reminder row
+func scanReminder(row rowScanner) (*domain.Reminder, error) {
+	var reminder domain.Reminder
+	var beforeDue sql.NullInt64
+	var remindAt, fireAt, firedAt, dismissedAt sql.NullTime
+
+	err := row.Scan(
+		&reminder.ID,
+		&reminder.TODOID,
+		&reminder.UserID,
+		&beforeDue,
+		&remindAt,
+		&fireAt,
+		&firedAt,
+		&dismissedAt,
+		&reminder.CreatedAt,
+		&reminder.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if beforeDue.Valid {
+		d := time.Duration(beforeDue.Int64) * time.Second
+		reminder.BeforeDue = &d
+	}
+	if remindAt.Valid {
+		reminder.RemindAt = &remindAt.Time
+	}
+	if fireAt.Valid {
+		reminder.FireAt = &fireAt.Time
+	}
+	if firedAt.Valid {
+		reminder.FiredAt = &firedAt.Time
+	}
+	if dismissedAt.Valid {
+		reminder.DismissedAt = &dismissedAt.Time
+	}
+
+	return &reminder, nil
+}
@@ -0,0 +1,253 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// PostgresSessionRepository implements SessionRepository using PostgreSQL
+type PostgresSessionRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresSessionRepository creates a new PostgreSQL session repository
+func NewPostgresSessionRepository(db *sql.DB) *PostgresSessionRepository {
+	return &PostgresSessionRepository{db: db}
+}
+
+// Create creates a new session together with its first refresh token
+func (r *PostgresSessionRepository) Create(ctx context.Context, session *domain.Session, token *domain.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO sessions (
+			id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		session.ID,
+		session.UserID,
+		session.UserAgent,
+		session.IPAddress,
+		session.CreatedAt,
+		session.LastUsedAt,
+		session.ExpiresAt,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	if err := insertRefreshToken(ctx, tx, token); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID retrieves a session by ID
+func (r *PostgresSessionRepository) GetByID(ctx context.Context, id string) (*domain.Session, error) {
+	query := `
+		SELECT id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
+		FROM sessions
+		WHERE id = $1
+	`
+
+	session, err := scanSession(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("session not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// GetRefreshToken retrieves a refresh token by its hash
+func (r *PostgresSessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	query := `
+		SELECT token_hash, session_id, created_at, expires_at, used_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var token domain.RefreshToken
+	var usedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.TokenHash,
+		&token.SessionID,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&usedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("refresh token not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+
+	return &token, nil
+}
+
+// Rotate marks the old refresh token as used and stores the next one
+func (r *PostgresSessionRepository) Rotate(ctx context.Context, oldTokenHash string, next *domain.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	// Only an unused token may be rotated; a concurrent replay loses the race here
+	result, err := tx.ExecContext(ctx,
+		"UPDATE refresh_tokens SET used_at = $1 WHERE token_hash = $2 AND used_at IS NULL",
+		now, oldTokenHash)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to mark refresh token as used: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return domain.ErrRefreshTokenReused
+	}
+
+	if err := insertRefreshToken(ctx, tx, next); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE sessions SET last_used_at = $1, expires_at = $2 WHERE id = $3",
+		now, next.ExpiresAt, next.SessionID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// ListActiveByUser retrieves the active sessions of a user
+func (r *PostgresSessionRepository) ListActiveByUser(ctx context.Context, userID string) ([]*domain.Session, error) {
+	query := `
+		SELECT id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY last_used_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+// Revoke revokes a session and all of its refresh tokens
+func (r *PostgresSessionRepository) Revoke(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL", id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("session not found")
+	}
+
+	return nil
+}
+
+// RevokeAllByUser revokes every session of a user
+func (r *PostgresSessionRepository) RevokeAllByUser(ctx context.Context, userID string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL", userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// insertRefreshToken stores a refresh token within a transaction
+func insertRefreshToken(ctx context.Context, tx *sql.Tx, token *domain.RefreshToken) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (token_hash, session_id, created_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`,
+		token.TokenHash,
+		token.SessionID,
+		token.CreatedAt,
+		token.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSession scans a session row
+func scanSession(row rowScanner) (*domain.Session, error) {
+	var session domain.Session
+	var revokedAt sql.NullTime
+
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.ExpiresAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		session.RevokedAt = &revokedAt.Time
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// PostgresTODODependencyRepository implements TODODependencyRepository using
+// PostgreSQL
+type PostgresTODODependencyRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresTODODependencyRepository creates a new PostgreSQL TODO
+// dependency repository
+func NewPostgresTODODependencyRepository(db *sql.DB) *PostgresTODODependencyRepository {
+	return &PostgresTODODependencyRepository{db: db}
+}
+
+// Add adds a dependency, doing nothing if it already exists. Additions are
+// serialized by a table lock, so that two concurrent additions cannot
+// together close a cycle that neither sees on its own.
+func (r *PostgresTODODependencyRepository) Add(ctx context.Context, dependency *domain.TODODependency) error {
+	if dependency.TODOID == dependency.BlockedByID {
+		return domain.ErrDependencyCycle
+	}
+
+	var createdBy interface{}
+	if dependency.CreatedBy != "" {
+		createdBy = dependency.CreatedBy
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE todo_dependencies IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to lock dependencies: %w", err)
+	}
+
+	// The dependency closes a cycle if the TODO is among the blockers of its
+	// new blocker
+	var cycle bool
+	err = tx.QueryRowContext(ctx, `
+		WITH RECURSIVE blockers AS (
+		    SELECT blocked_by_id AS id FROM todo_dependencies WHERE todo_id = $2
+		    UNION
+		    SELECT d.blocked_by_id FROM todo_dependencies d JOIN blockers b ON d.todo_id = b.id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE id = $1)
+	`, dependency.TODOID, dependency.BlockedByID).Scan(&cycle)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to check for cycles: %w", err)
+	}
+	if cycle {
+		tx.Rollback()
+		return domain.ErrDependencyCycle
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO todo_dependencies (todo_id, blocked_by_id, created_by, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (todo_id, blocked_by_id) DO NOTHING
+	`, dependency.TODOID, dependency.BlockedByID, createdBy, dependency.CreatedAt)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to add dependency: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Remove removes a dependency
+func (r *PostgresTODODependencyRepository) Remove(ctx context.Context, todoID, blockedByID string) error {
+	query := `DELETE FROM todo_dependencies WHERE todo_id = $1 AND blocked_by_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, todoID, blockedByID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("dependency not found")
+	}
+
+	return nil
+}
+
+// ListBlockers retrieves the TODOs that a TODO depends on
+func (r *PostgresTODODependencyRepository) ListBlockers(ctx context.Context, todoID string) ([]*domain.TODO, error) {
+	query := `SELECT ` + todoColumns + ` FROM todos
+		WHERE id IN (SELECT blocked_by_id FROM todo_dependencies WHERE todo_id = $1) AND deleted_at IS NULL
+		ORDER BY created_at`
+
+	return r.listTODOs(ctx, query, todoID)
+}
+
+// ListBlocked retrieves the TODOs that depend on a TODO
+func (r *PostgresTODODependencyRepository) ListBlocked(ctx context.Context, todoID string) ([]*domain.TODO, error) {
+	query := `SELECT ` + todoColumns + ` FROM todos
+		WHERE id IN (SELECT todo_id FROM todo_dependencies WHERE blocked_by_id = $1) AND deleted_at IS NULL
+		ORDER BY created_at`
+
+	return r.listTODOs(ctx, query, todoID)
+}
+
+// CountUnfinishedBlockers counts the TODOs that a TODO depends on that are
+// neither completed nor cancelled
+func (r *PostgresTODODependencyRepository) CountUnfinishedBlockers(ctx context.Context, todoID string) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM todo_dependencies d JOIN todos b ON b.id = d.blocked_by_id
+		WHERE d.todo_id = $1 AND b.deleted_at IS NULL AND b.status NOT IN ($2, $3)
+	`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, todoID,
+		int32(commonv1.Status_STATUS_COMPLETED), int32(commonv1.Status_STATUS_CANCELLED)).Scan(&count)
+	return count, err
+}
+
+// listTODOs runs a query selecting todoColumns
+func (r *PostgresTODODependencyRepository) listTODOs(ctx context.Context, query string, args ...interface{}) ([]*domain.TODO, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var todos []*domain.TODO
+	for rows.Next() {
+		todo, err := scanTODO(rows)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, todo)
+	}
+
+	return todos, rows.Err()
+}
@@ -0,0 +1,170 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// todoRevisionColumns lists the columns scanned by scanTODORevision
+const todoRevisionColumns = `id, todo_id, revision, actor_id, action, changed_fields, snapshot, created_at`
+
+// PostgresTODORevisionRepository implements TODORevisionRepository using
+// PostgreSQL
+type PostgresTODORevisionRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresTODORevisionRepository creates a new PostgreSQL TODO revision
+// repository
+func NewPostgresTODORevisionRepository(db *sql.DB) *PostgresTODORevisionRepository {
+	return &PostgresTODORevisionRepository{db: db}
+}
+
+// Append stores a revision as the latest revision of its TODO. The TODO row
+// is locked while the revision is numbered, so concurrent changes receive
+// consecutive numbers.
+func (r *PostgresTODORevisionRepository) Append(ctx context.Context, revision *domain.TODORevision) error {
+	snapshot, err := json.Marshal(revision.Snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision snapshot: %w", err)
+	}
+
+	var actorID interface{}
+	if revision.ActorID != "" {
+		actorID = revision.ActorID
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT 1 FROM todos WHERE id = $1 FOR UPDATE", revision.TODOID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to lock todo: %w", err)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO todo_revisions (id, todo_id, revision, actor_id, action, changed_fields, snapshot, created_at)
+		SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5, $6, $7
+		FROM todo_revisions WHERE todo_id = $2
+		RETURNING revision
+	`,
+		revision.ID,
+		revision.TODOID,
+		actorID,
+		revision.Action,
+		pq.Array(revision.ChangedFields),
+		snapshot,
+		revision.CreatedAt,
+	).Scan(&revision.Revision)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to append revision: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Get retrieves a revision of a TODO by number
+func (r *PostgresTODORevisionRepository) Get(ctx context.Context, todoID string, revision int32) (*domain.TODORevision, error) {
+	query := `SELECT ` + todoRevisionColumns + ` FROM todo_revisions WHERE todo_id = $1 AND revision = $2`
+
+	rev, err := scanTODORevision(r.db.QueryRowContext(ctx, query, todoID, revision))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("revision not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rev, nil
+}
+
+// List retrieves the revisions of a TODO, newest first
+func (r *PostgresTODORevisionRepository) List(ctx context.Context, todoID string, page, pageSize int32) ([]*domain.TODORevision, *domain.PaginationResult, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	var totalItems int32
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM todo_revisions WHERE todo_id = $1", todoID).Scan(&totalItems); err != nil {
+		return nil, nil, err
+	}
+
+	query := `SELECT ` + todoRevisionColumns + ` FROM todo_revisions
+		WHERE todo_id = $1
+		ORDER BY revision DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, todoID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*domain.TODORevision
+	for rows.Next() {
+		rev, err := scanTODORevision(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := (totalItems + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return revisions, &domain.PaginationResult{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+		HasNext:     page < totalPages,
+		HasPrev:     page > 1,
+	}, nil
+}
+
+// scanTODORevision scans a TODO revision row
+func scanTODORevision(row rowScanner) (*domain.TODORevision, error) {
+	var rev domain.TODORevision
+	var actorID sql.NullString
+	var snapshot []byte
+
+	err := row.Scan(
+		&rev.ID,
+		&rev.TODOID,
+		&rev.Revision,
+		&actorID,
+		&rev.Action,
+		pq.Array(&rev.ChangedFields),
+		&snapshot,
+		&rev.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rev.ActorID = actorID.String
+	if err := json.Unmarshal(snapshot, &rev.Snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode revision snapshot: %w", err)
+	}
+
+	return &rev, nil
+}
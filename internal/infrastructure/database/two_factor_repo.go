@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// PostgresTwoFactorRepository implements TwoFactorRepository using PostgreSQL
+type PostgresTwoFactorRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresTwoFactorRepository creates a new PostgreSQL two-factor repository
+func NewPostgresTwoFactorRepository(db *sql.DB) *PostgresTwoFactorRepository {
+	return &PostgresTwoFactorRepository{db: db}
+}
+
+// GetEnrollment retrieves a user's TOTP enrollment
+func (r *PostgresTwoFactorRepository) GetEnrollment(ctx context.Context, userID string) (*domain.TOTPEnrollment, error) {
+	query := `
+		SELECT user_id, secret, confirmed_at, last_used_step, created_at
+		FROM user_totp
+		WHERE user_id = $1
+	`
+
+	var enrollment domain.TOTPEnrollment
+	var confirmedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&enrollment.UserID,
+		&enrollment.Secret,
+		&confirmedAt,
+		&enrollment.LastUsedStep,
+		&enrollment.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("two-factor enrollment not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if confirmedAt.Valid {
+		enrollment.ConfirmedAt = &confirmedAt.Time
+	}
+
+	return &enrollment, nil
+}
+
+// SavePendingEnrollment stores an unconfirmed enrollment, replacing any
+// previous unconfirmed one. A confirmed enrollment is left untouched.
+func (r *PostgresTwoFactorRepository) SavePendingEnrollment(ctx context.Context, enrollment *domain.TOTPEnrollment) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_totp (user_id, secret, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, created_at = EXCLUDED.created_at, last_used_step = 0
+		WHERE user_totp.confirmed_at IS NULL
+	`,
+		enrollment.UserID,
+		enrollment.Secret,
+		enrollment.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save two-factor enrollment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("two-factor authentication is already enabled")
+	}
+
+	return nil
+}
+
+// Enable confirms the user's enrollment, replaces their recovery codes and
+// turns on two-factor authentication
+func (r *PostgresTwoFactorRepository) Enable(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE user_totp SET confirmed_at = $1, last_used_step = $2 WHERE user_id = $3 AND confirmed_at IS NULL",
+		now, step, userID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to confirm two-factor enrollment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("pending two-factor enrollment not found")
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes, now); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE users SET two_factor_enabled = TRUE, updated_at = $1 WHERE id = $2", now, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to enable two-factor authentication: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Disable removes the user's enrollment and recovery codes and turns off
+// two-factor authentication
+func (r *PostgresTwoFactorRepository) Disable(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = $1", userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_totp WHERE user_id = $1", userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete two-factor enrollment: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE users SET two_factor_enabled = FALSE, updated_at = $1 WHERE id = $2", time.Now(), userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// RecordUsedStep records the time step of an accepted TOTP code
+func (r *PostgresTwoFactorRepository) RecordUsedStep(ctx context.Context, userID string, step int64) error {
+	// Only a later step may be recorded; a concurrent replay loses the race here
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE user_totp SET last_used_step = $1 WHERE user_id = $2 AND last_used_step < $1",
+		step, userID)
+	if err != nil {
+		return fmt.Errorf("failed to record used code: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTOTPCodeReused
+	}
+
+	return nil
+}
+
+// ConsumeRecoveryCode marks an unused recovery code as used
+func (r *PostgresTwoFactorRepository) ConsumeRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE user_recovery_codes SET used_at = NOW() WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL",
+		userID, codeHash)
+	if err != nil {
+		return fmt.Errorf("failed to consume recovery code: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrRecoveryCodeInvalid
+	}
+
+	return nil
+}
+
+// replaceRecoveryCodes swaps a user's recovery codes within a transaction
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID string, codeHashes []string, now time.Time) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO user_recovery_codes (user_id, code_hash, created_at) VALUES ($1, $2, $3)",
+			userID, hash, now); err != nil {
+			return fmt.Errorf("failed to store recovery code: %w", err)
+		}
+	}
+
+	return nil
+}
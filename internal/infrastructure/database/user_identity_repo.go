@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// PostgresUserIdentityRepository implements UserIdentityRepository using PostgreSQL
+type PostgresUserIdentityRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresUserIdentityRepository creates a new PostgreSQL user identity repository
+func NewPostgresUserIdentityRepository(db *sql.DB) *PostgresUserIdentityRepository {
+	return &PostgresUserIdentityRepository{db: db}
+}
+
+// Create links an external identity to a user
+func (r *PostgresUserIdentityRepository) Create(ctx context.Context, identity *domain.UserIdentity) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_identities (id, user_id, provider, subject, email, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`,
+		identity.ID,
+		identity.UserID,
+		identity.Provider,
+		identity.Subject,
+		identity.Email,
+		identity.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create user identity: %w", err)
+	}
+	return nil
+}
+
+// GetByProviderSubject retrieves the identity with the given subject at a provider
+func (r *PostgresUserIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM user_identities
+		WHERE provider = $1 AND subject = $2
+	`
+
+	var identity domain.UserIdentity
+	var email sql.NullString
+
+	err := r.db.QueryRowContext(ctx, query, provider, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&email,
+		&identity.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user identity not found: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	identity.Email = email.String
+	return &identity, nil
+}
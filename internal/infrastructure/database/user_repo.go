@@ -4,10 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
+	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	"github.com/venslupro/todo-api/internal/domain"
 )
 
+// userColumns lists the users columns read by scanUser, in order
+const userColumns = `id, email, username, password_hash, full_name, avatar_url,
+	is_active, email_verified, two_factor_enabled, is_admin, last_login_at, deletion_scheduled_at,
+	created_at, updated_at`
+
 // PostgresUserRepository implements UserRepository using PostgreSQL
 type PostgresUserRepository struct {
 	db *sql.DB
@@ -22,9 +30,9 @@ func NewPostgresUserRepository(db *sql.DB) *PostgresUserRepository {
 func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
 		INSERT INTO users (
-			id, email, username, password_hash, full_name, avatar_url, 
-			is_active, last_login_at, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			id, email, username, password_hash, full_name, avatar_url,
+			is_active, email_verified, last_login_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	var lastLoginAt interface{}
@@ -40,6 +48,7 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User)
 		user.FullName,
 		user.AvatarURL,
 		user.IsActive,
+		user.EmailVerified,
 		lastLoginAt,
 		user.CreatedAt,
 		user.UpdatedAt,
@@ -50,29 +59,9 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User)
 
 // GetByID retrieves a user by ID
 func (r *PostgresUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
-	query := `
-		SELECT id, email, username, password_hash, full_name, avatar_url,
-		       is_active, last_login_at, created_at, updated_at
-		FROM users
-		WHERE id = $1
-	`
-
-	var user domain.User
-	var lastLoginAt sql.NullTime
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&user.ID,
-		&user.Email,
-		&user.Username,
-		&user.PasswordHash,
-		&user.FullName,
-		&user.AvatarURL,
-		&user.IsActive,
-		&lastLoginAt,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
 
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
@@ -80,38 +69,14 @@ func (r *PostgresUserRepository) GetByID(ctx context.Context, id string) (*domai
 		return nil, err
 	}
 
-	if lastLoginAt.Valid {
-		user.LastLoginAt = &lastLoginAt.Time
-	}
-
-	return &user, nil
+	return user, nil
 }
 
 // GetByEmail retrieves a user by email
 func (r *PostgresUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
-	query := `
-		SELECT id, email, username, password_hash, full_name, avatar_url,
-		       is_active, last_login_at, created_at, updated_at
-		FROM users
-		WHERE email = $1
-	`
-
-	var user domain.User
-	var lastLoginAt sql.NullTime
-
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
-		&user.ID,
-		&user.Email,
-		&user.Username,
-		&user.PasswordHash,
-		&user.FullName,
-		&user.AvatarURL,
-		&user.IsActive,
-		&lastLoginAt,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
 
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, email))
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
@@ -119,38 +84,14 @@ func (r *PostgresUserRepository) GetByEmail(ctx context.Context, email string) (
 		return nil, err
 	}
 
-	if lastLoginAt.Valid {
-		user.LastLoginAt = &lastLoginAt.Time
-	}
-
-	return &user, nil
+	return user, nil
 }
 
 // GetByUsername retrieves a user by username
 func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
-	query := `
-		SELECT id, email, username, password_hash, full_name, avatar_url,
-		       is_active, last_login_at, created_at, updated_at
-		FROM users
-		WHERE username = $1
-	`
-
-	var user domain.User
-	var lastLoginAt sql.NullTime
-
-	err := r.db.QueryRowContext(ctx, query, username).Scan(
-		&user.ID,
-		&user.Email,
-		&user.Username,
-		&user.PasswordHash,
-		&user.FullName,
-		&user.AvatarURL,
-		&user.IsActive,
-		&lastLoginAt,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	query := `SELECT ` + userColumns + ` FROM users WHERE username = $1`
 
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, username))
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
@@ -158,11 +99,7 @@ func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username str
 		return nil, err
 	}
 
-	if lastLoginAt.Valid {
-		user.LastLoginAt = &lastLoginAt.Time
-	}
-
-	return &user, nil
+	return user, nil
 }
 
 // Update updates an existing user
@@ -170,14 +107,18 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User)
 	query := `
 		UPDATE users
 		SET email = $2, username = $3, password_hash = $4, full_name = $5,
-		    avatar_url = $6, is_active = $7, last_login_at = $8, updated_at = $9
+		    avatar_url = $6, is_active = $7, email_verified = $8, last_login_at = $9,
+		    deletion_scheduled_at = $10, updated_at = $11
 		WHERE id = $1
 	`
 
-	var lastLoginAt interface{}
+	var lastLoginAt, deletionScheduledAt interface{}
 	if user.LastLoginAt != nil {
 		lastLoginAt = user.LastLoginAt
 	}
+	if user.DeletionScheduledAt != nil {
+		deletionScheduledAt = user.DeletionScheduledAt
+	}
 
 	result, err := r.db.ExecContext(ctx, query,
 		user.ID,
@@ -187,7 +128,9 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User)
 		user.FullName,
 		user.AvatarURL,
 		user.IsActive,
+		user.EmailVerified,
 		lastLoginAt,
+		deletionScheduledAt,
 		user.UpdatedAt,
 	)
 
@@ -222,3 +165,270 @@ func (r *PostgresUserRepository) ExistsByUsername(ctx context.Context, username
 	err := r.db.QueryRowContext(ctx, query, username).Scan(&exists)
 	return exists, err
 }
+
+// List retrieves users matching the options, newest first
+func (r *PostgresUserRepository) List(ctx context.Context, options domain.UserListOptions) ([]*domain.User, *domain.PaginationResult, error) {
+	var conditions []string
+	var args []interface{}
+
+	if options.Query != "" {
+		args = append(args, "%"+options.Query+"%")
+		conditions = append(conditions, fmt.Sprintf("(email ILIKE $%d OR username ILIKE $%d OR full_name ILIKE $%d)",
+			len(args), len(args), len(args)))
+	}
+	if options.IsActive != nil {
+		args = append(args, *options.IsActive)
+		conditions = append(conditions, fmt.Sprintf("is_active = $%d", len(args)))
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	page := options.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := options.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	var totalItems int32
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users "+whereClause, args...).Scan(&totalItems); err != nil {
+		return nil, nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM users %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`,
+		userColumns, whereClause, len(args)+1, len(args)+2)
+	args = append(args, pageSize, (page-1)*pageSize)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := (totalItems + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return users, &domain.PaginationResult{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+		HasNext:     page < totalPages,
+		HasPrev:     page > 1,
+	}, nil
+}
+
+// ListDueForDeletion retrieves users whose scheduled deletion time has passed
+func (r *PostgresUserRepository) ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users
+		WHERE deletion_scheduled_at <= $1
+		ORDER BY deletion_scheduled_at
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// Delete deletes a user in one transaction:
+//   - each team the user owns passes to its highest-ranking remaining member,
+//     who becomes an owner; teams without other members are deleted
+//   - TODOs the user shared with teams, and their subtasks, pass to the owner
+//     of the team they were first shared with
+//   - shares the user made of other users' TODOs are attributed to the TODO owner
+//   - the user's activity log entries and uploads are kept without the user
+//
+// Everything else the user owns is removed by cascading foreign keys.
+func (r *PostgresUserRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := handOverTeams(ctx, tx, id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to hand over teams: %w", err)
+	}
+
+	statements := []struct {
+		description string
+		query       string
+	}{
+		{"hand over shared todos", `
+			WITH RECURSIVE handover AS (
+			    SELECT t.id, (
+			        SELECT tm.created_by FROM shared_todos st JOIN teams tm ON tm.id = st.team_id
+			        WHERE st.todo_id = t.id ORDER BY st.shared_at LIMIT 1
+			    ) AS new_owner
+			    FROM todos t
+			    WHERE t.user_id = $1 AND EXISTS (SELECT 1 FROM shared_todos st WHERE st.todo_id = t.id)
+			    UNION
+			    SELECT c.id, h.new_owner FROM todos c JOIN handover h ON c.parent_id = h.id
+			    WHERE c.user_id = $1
+			)
+			UPDATE todos SET user_id = handover.new_owner, updated_at = NOW(), version = version + 1
+			FROM handover WHERE todos.id = handover.id`},
+		{"reattribute shares", `
+			UPDATE shared_todos st SET shared_by = t.user_id
+			FROM todos t WHERE st.todo_id = t.id AND st.shared_by = $1 AND t.user_id <> $1`},
+		{"anonymize activity", `UPDATE activity_logs SET user_id = NULL WHERE user_id = $1`},
+		{"anonymize uploads", `UPDATE media_attachments SET uploaded_by = NULL WHERE uploaded_by = $1`},
+		{"anonymize comments", `UPDATE comments SET user_id = NULL WHERE user_id = $1`},
+		{"anonymize revisions", `UPDATE todo_revisions SET actor_id = NULL WHERE actor_id = $1`},
+		{"anonymize dependencies", `UPDATE todo_dependencies SET created_by = NULL WHERE created_by = $1`},
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt.query, id); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to %s: %w", stmt.description, err)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("user not found")
+	}
+
+	return tx.Commit()
+}
+
+// handOverTeams passes the teams a user owns to other members before the
+// user is deleted
+func handOverTeams(ctx context.Context, tx *sql.Tx, userID string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id FROM teams WHERE created_by = $1
+		UNION
+		SELECT team_id FROM team_members WHERE user_id = $1 AND role = $2`,
+		userID, int32(commonv1.Role_ROLE_OWNER))
+	if err != nil {
+		return err
+	}
+
+	var teamIDs []string
+	for rows.Next() {
+		var teamID string
+		if err := rows.Scan(&teamID); err != nil {
+			rows.Close()
+			return err
+		}
+		teamIDs = append(teamIDs, teamID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, teamID := range teamIDs {
+		// Other owners rank first, so a team that has one keeps it
+		var successor string
+		err := tx.QueryRowContext(ctx, `
+			SELECT user_id FROM team_members
+			WHERE team_id = $1 AND user_id <> $2
+			ORDER BY role DESC, joined_at
+			LIMIT 1`, teamID, userID).Scan(&successor)
+		if err == sql.ErrNoRows {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM teams WHERE id = $1`, teamID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE team_members SET role = $3 WHERE team_id = $1 AND user_id = $2`,
+			teamID, successor, int32(commonv1.Role_ROLE_OWNER)); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE teams SET created_by = $2, updated_at = NOW() WHERE id = $1 AND created_by = $3`,
+			teamID, successor, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanUser scans a user row selected with userColumns
+func scanUser(row rowScanner) (*domain.User, error) {
+	var user domain.User
+	var lastLoginAt, deletionScheduledAt sql.NullTime
+
+	err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.Username,
+		&user.PasswordHash,
+		&user.FullName,
+		&user.AvatarURL,
+		&user.IsActive,
+		&user.EmailVerified,
+		&user.TwoFactorEnabled,
+		&user.IsAdmin,
+		&lastLoginAt,
+		&deletionScheduledAt,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastLoginAt.Valid {
+		user.LastLoginAt = &lastLoginAt.Time
+	}
+	if deletionScheduledAt.Valid {
+		user.DeletionScheduledAt = &deletionScheduledAt.Time
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/venslupro/todo-api/internal/domain"
+)
+
+// PostgresUserTokenRepository implements UserTokenRepository using PostgreSQL
+type PostgresUserTokenRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresUserTokenRepository creates a new PostgreSQL user token repository
+func NewPostgresUserTokenRepository(db *sql.DB) *PostgresUserTokenRepository {
+	return &PostgresUserTokenRepository{db: db}
+}
+
+// Create stores a new token, invalidating earlier unused tokens of the same purpose
+func (r *PostgresUserTokenRepository) Create(ctx context.Context, token *domain.UserToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"DELETE FROM user_tokens WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL",
+		token.UserID, string(token.Purpose))
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to invalidate previous tokens: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO user_tokens (token_hash, user_id, purpose, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`,
+		token.TokenHash,
+		token.UserID,
+		string(token.Purpose),
+		token.CreatedAt,
+		token.ExpiresAt,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Get retrieves an unused, unexpired token without marking it as used
+func (r *PostgresUserTokenRepository) Get(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	query := `
+		SELECT token_hash, user_id, purpose, created_at, expires_at, used_at
+		FROM user_tokens
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+	`
+	return r.scanToken(r.db.QueryRowContext(ctx, query, tokenHash, string(purpose)))
+}
+
+// Consume marks an unused, unexpired token as used and returns it
+func (r *PostgresUserTokenRepository) Consume(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	query := `
+		UPDATE user_tokens
+		SET used_at = NOW()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+		RETURNING token_hash, user_id, purpose, created_at, expires_at, used_at
+	`
+
+	return r.scanToken(r.db.QueryRowContext(ctx, query, tokenHash, string(purpose)))
+}
+
+// scanToken scans a token row, returning ErrUserTokenInvalid if there is none
+func (r *PostgresUserTokenRepository) scanToken(row *sql.Row) (*domain.UserToken, error) {
+	var token domain.UserToken
+	var tokenPurpose string
+	var usedAt sql.NullTime
+
+	err := row.Scan(
+		&token.TokenHash,
+		&token.UserID,
+		&tokenPurpose,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&usedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrUserTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token.Purpose = domain.UserTokenPurpose(tokenPurpose)
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+
+	return &token, nil
+}
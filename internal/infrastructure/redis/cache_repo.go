@@ -35,6 +35,15 @@ func (r *CacheRepository) Set(ctx context.Context, key string, value interface{}
 	return r.client.Set(ctx, key, data, expiration)
 }
 
+// Take atomically retrieves a value from cache and removes it
+func (r *CacheRepository) Take(ctx context.Context, key string, dest interface{}) error {
+	val, err := r.client.GetDel(ctx, key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(val), dest)
+}
+
 // Delete removes a key from cache
 func (r *CacheRepository) Delete(ctx context.Context, keys ...string) error {
 	return r.client.Delete(ctx, keys...)
@@ -46,6 +55,30 @@ func (r *CacheRepository) Exists(ctx context.Context, key string) (bool, error)
 	return count > 0, err
 }
 
+// SetNX stores a value only if the key does not exist yet and reports
+// whether it was stored
+func (r *CacheRepository) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	return r.client.SetNX(ctx, key, data, expiration)
+}
+
+// Increment increments a counter; the expiration starts when it is created
+func (r *CacheRepository) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	return r.client.Incr(ctx, key, expiration)
+}
+
+// TTL returns the remaining time to live of a key, or zero if it does not expire
+func (r *CacheRepository) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.TTL(ctx, key)
+	if err != nil || ttl < 0 {
+		return 0, err
+	}
+	return ttl, nil
+}
+
 // Cache keys
 const (
 	CacheKeyUser        = "user:%s"
@@ -53,7 +86,13 @@ const (
 	CacheKeyTeam        = "team:%s"
 	CacheKeyTeamMembers = "team:%s:members"
 	CacheKeyUserSession = "session:%s"
+	CacheKeyUserRevoked = "session:user:%s:revoked_before"
 	CacheKeyTODOList    = "todos:user:%s:filter:%s"
+	CacheKeySSOState    = "sso:state:%s"
+	CacheKeyLoginFails  = "login:%s:failures"
+	CacheKeyLoginDelay  = "login:%s:delay"
+	CacheKeyLoginLock   = "login:%s:locked"
+	CacheKeyIdempotency = "idempotency:%s"
 )
 
 // GenerateUserCacheKey generates a cache key for a user
@@ -75,3 +114,33 @@ func GenerateTeamCacheKey(teamID string) string {
 func GenerateSessionCacheKey(sessionID string) string {
 	return fmt.Sprintf(CacheKeyUserSession, sessionID)
 }
+
+// GenerateUserRevokedCacheKey generates a cache key for a user's token revocation cutoff
+func GenerateUserRevokedCacheKey(userID string) string {
+	return fmt.Sprintf(CacheKeyUserRevoked, userID)
+}
+
+// GenerateSSOStateCacheKey generates a cache key for a pending single sign-on login
+func GenerateSSOStateCacheKey(state string) string {
+	return fmt.Sprintf(CacheKeySSOState, state)
+}
+
+// GenerateLoginFailuresCacheKey generates a cache key for the failed login count of a key
+func GenerateLoginFailuresCacheKey(key string) string {
+	return fmt.Sprintf(CacheKeyLoginFails, key)
+}
+
+// GenerateLoginDelayCacheKey generates a cache key for the delay imposed after a failed login
+func GenerateLoginDelayCacheKey(key string) string {
+	return fmt.Sprintf(CacheKeyLoginDelay, key)
+}
+
+// GenerateLoginLockCacheKey generates a cache key for a login lockout
+func GenerateLoginLockCacheKey(key string) string {
+	return fmt.Sprintf(CacheKeyLoginLock, key)
+}
+
+// GenerateIdempotencyCacheKey generates a cache key for the record of an idempotency key
+func GenerateIdempotencyCacheKey(key string) string {
+	return fmt.Sprintf(CacheKeyIdempotency, key)
+}
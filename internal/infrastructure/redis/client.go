@@ -44,6 +44,11 @@ func (c *Client) Close() error {
 	return c.client.Close()
 }
 
+// Ping verifies the Redis connection is alive
+func (c *Client) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
 // GetClient returns the underlying Redis client
 func (c *Client) GetClient() *redis.Client {
 	return c.client
@@ -59,6 +64,11 @@ func (c *Client) Get(ctx context.Context, key string) (string, error) {
 	return c.client.Get(ctx, key).Result()
 }
 
+// GetDel retrieves a value by key and deletes the key
+func (c *Client) GetDel(ctx context.Context, key string) (string, error) {
+	return c.client.GetDel(ctx, key).Result()
+}
+
 // Delete removes a key
 func (c *Client) Delete(ctx context.Context, keys ...string) error {
 	return c.client.Del(ctx, keys...).Err()
@@ -83,3 +93,23 @@ func (c *Client) Subscribe(ctx context.Context, channels ...string) *redis.PubSu
 func (c *Client) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
 	return c.client.SetNX(ctx, key, value, expiration).Result()
 }
+
+// Incr increments a counter, starting its expiration when it is created
+func (c *Client) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	count, err := c.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 && expiration > 0 {
+		if err := c.client.Expire(ctx, key, expiration).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// TTL returns the remaining time to live of a key, or a negative duration if
+// the key does not exist or has no expiration
+func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.client.TTL(ctx, key).Result()
+}
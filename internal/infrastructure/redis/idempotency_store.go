@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/venslupro/todo-api/internal/pkg/idempotency"
+)
+
+// IdempotencyStore implements idempotency.Store on top of the cache
+type IdempotencyStore struct {
+	cache *CacheRepository
+}
+
+// NewIdempotencyStore creates a new idempotency key store
+func NewIdempotencyStore(cache *CacheRepository) *IdempotencyStore {
+	return &IdempotencyStore{cache: cache}
+}
+
+// Reserve stores a record under an idempotency key unless the key is taken,
+// in which case it returns the record already stored. A record that expires
+// between the two steps is retried once.
+func (s *IdempotencyStore) Reserve(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) (*idempotency.Record, error) {
+	cacheKey := GenerateIdempotencyCacheKey(key)
+	for attempt := 0; attempt < 2; attempt++ {
+		reserved, err := s.cache.SetNX(ctx, cacheKey, record, ttl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+		if reserved {
+			return nil, nil
+		}
+
+		var existing idempotency.Record
+		err = s.cache.Get(ctx, cacheKey, &existing)
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load idempotency key: %w", err)
+		}
+		return &existing, nil
+	}
+	return nil, fmt.Errorf("failed to reserve idempotency key: key expired while it was being reserved")
+}
+
+// Save replaces the record stored under an idempotency key
+func (s *IdempotencyStore) Save(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) error {
+	if err := s.cache.Set(ctx, GenerateIdempotencyCacheKey(key), record, ttl); err != nil {
+		return fmt.Errorf("failed to save idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release removes the record stored under an idempotency key
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	if err := s.cache.Delete(ctx, GenerateIdempotencyCacheKey(key)); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
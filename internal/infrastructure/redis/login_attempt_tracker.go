@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+)
+
+// LoginAttemptTracker implements auth.LoginAttemptTracker on top of the
+// cache. Failures are counted in a key that expires with the policy window;
+// delays and lockouts are keys whose TTL is the remaining wait.
+type LoginAttemptTracker struct {
+	cache *CacheRepository
+}
+
+// NewLoginAttemptTracker creates a new login attempt tracker
+func NewLoginAttemptTracker(cache *CacheRepository) *LoginAttemptTracker {
+	return &LoginAttemptTracker{cache: cache}
+}
+
+// Blocked returns how long attempts for key must still wait, or zero
+func (t *LoginAttemptTracker) Blocked(ctx context.Context, key string) (time.Duration, error) {
+	var wait time.Duration
+	for _, cacheKey := range []string{GenerateLoginLockCacheKey(key), GenerateLoginDelayCacheKey(key)} {
+		ttl, err := t.cache.TTL(ctx, cacheKey)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check login block: %w", err)
+		}
+		if ttl > wait {
+			wait = ttl
+		}
+	}
+	return wait, nil
+}
+
+// RecordFailure counts a failed attempt and blocks the key for the delay or
+// lockout the policy prescribes
+func (t *LoginAttemptTracker) RecordFailure(ctx context.Context, key string, policy auth.LockoutPolicy) (int, bool, error) {
+	count, err := t.cache.Increment(ctx, GenerateLoginFailuresCacheKey(key), policy.Window)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to count login failure: %w", err)
+	}
+	failures := int(count)
+
+	if policy.MaxFailures > 0 && failures >= policy.MaxFailures {
+		// Concurrent failures race for the lock; only the winner reports the lockout
+		locked, err := t.cache.SetNX(ctx, GenerateLoginLockCacheKey(key), true, policy.LockoutDuration)
+		if err != nil {
+			return failures, false, fmt.Errorf("failed to lock out login: %w", err)
+		}
+		// Attempts after the lockout start counting from zero again
+		if err := t.cache.Delete(ctx, GenerateLoginFailuresCacheKey(key)); err != nil {
+			return failures, locked, fmt.Errorf("failed to reset login failures: %w", err)
+		}
+		return failures, locked, nil
+	}
+
+	if delay := policy.Delay(failures); delay > 0 {
+		if err := t.cache.Set(ctx, GenerateLoginDelayCacheKey(key), true, delay); err != nil {
+			return failures, false, fmt.Errorf("failed to delay login: %w", err)
+		}
+	}
+
+	return failures, false, nil
+}
+
+// Reset clears the failures and any block for key
+func (t *LoginAttemptTracker) Reset(ctx context.Context, key string) error {
+	err := t.cache.Delete(ctx,
+		GenerateLoginFailuresCacheKey(key),
+		GenerateLoginDelayCacheKey(key),
+		GenerateLoginLockCacheKey(key),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/venslupro/todo-api/internal/pkg/sso"
+)
+
+// SSOStateStore implements sso.StateStore on top of the cache
+type SSOStateStore struct {
+	cache *CacheRepository
+}
+
+// NewSSOStateStore creates a new single sign-on state store
+func NewSSOStateStore(cache *CacheRepository) *SSOStateStore {
+	return &SSOStateStore{cache: cache}
+}
+
+// Save stores a pending login until it is taken or the ttl elapses
+func (s *SSOStateStore) Save(ctx context.Context, login *sso.LoginState, ttl time.Duration) error {
+	if err := s.cache.Set(ctx, GenerateSSOStateCacheKey(login.State), login, ttl); err != nil {
+		return fmt.Errorf("failed to save login state: %w", err)
+	}
+	return nil
+}
+
+// Take removes and returns a pending login, so that each state is used once
+func (s *SSOStateStore) Take(ctx context.Context, state string) (*sso.LoginState, error) {
+	var login sso.LoginState
+	err := s.cache.Take(ctx, GenerateSSOStateCacheKey(state), &login)
+	if errors.Is(err, redis.Nil) {
+		return nil, sso.ErrLoginStateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load login state: %w", err)
+	}
+	return &login, nil
+}
@@ -0,0 +1,98 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/venslupro/todo-api/internal/pkg/auth"
+)
+
+// TokenDenylist implements auth.RevocationStore on top of the cache.
+// Revoked token and session IDs are stored under their session cache key;
+// entries and per-user cutoffs are kept for as long as any access token issued
+// before them could still be valid.
+type TokenDenylist struct {
+	cache    *CacheRepository
+	tokenTTL time.Duration
+}
+
+// NewTokenDenylist creates a new token denylist. tokenTTL is the lifetime of
+// access tokens.
+func NewTokenDenylist(cache *CacheRepository, tokenTTL time.Duration) *TokenDenylist {
+	return &TokenDenylist{
+		cache:    cache,
+		tokenTTL: tokenTTL,
+	}
+}
+
+// Revoke adds a token ID to the denylist until the token expires
+func (d *TokenDenylist) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	if tokenID == "" {
+		return nil
+	}
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := d.cache.Set(ctx, GenerateSessionCacheKey(tokenID), true, ttl); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// RevokeSession adds a session ID to the denylist so that access tokens
+// issued for it are rejected until they expire
+func (d *TokenDenylist) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := d.cache.Set(ctx, GenerateSessionCacheKey(sessionID), true, d.tokenTTL); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllBefore revokes every token issued to a user before the given time.
+// Token issue times have second precision, so the cutoff is truncated to the
+// second; otherwise a token issued right after the cutoff would be rejected.
+func (d *TokenDenylist) RevokeAllBefore(ctx context.Context, userID string, before time.Time) error {
+	cutoff := before.Truncate(time.Second).Unix()
+	if err := d.cache.Set(ctx, GenerateUserRevokedCacheKey(userID), cutoff, d.tokenTTL); err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked reports whether a token or its session has been revoked, or the
+// token was issued before its user's revocation cutoff
+func (d *TokenDenylist) IsRevoked(ctx context.Context, claims *auth.Claims) (bool, error) {
+	for _, id := range []string{claims.ID, claims.SessionID} {
+		if id == "" {
+			continue
+		}
+		revoked, err := d.cache.Exists(ctx, GenerateSessionCacheKey(id))
+		if err != nil {
+			return false, fmt.Errorf("failed to check token revocation: %w", err)
+		}
+		if revoked {
+			return true, nil
+		}
+	}
+
+	var cutoff int64
+	err := d.cache.Get(ctx, GenerateUserRevokedCacheKey(claims.UserID), &cutoff)
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check user revocation: %w", err)
+	}
+
+	if claims.IssuedAt == nil {
+		return true, nil
+	}
+	return claims.IssuedAt.Time.Before(time.Unix(cutoff, 0)), nil
+}
@@ -5,11 +5,14 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-// JWTManager handles JWT token generation and validation
+// JWTManager handles JWT token generation and validation. Tokens are signed
+// with an HS256 shared secret, or with asymmetric keys when a key set is used.
 type JWTManager struct {
 	secretKey     string
+	keys          *KeySet
 	tokenDuration time.Duration
 }
 
@@ -18,6 +21,10 @@ type Claims struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	Email    string `json:"email"`
+	// SessionID identifies the login session the token was issued for
+	SessionID string `json:"sid,omitempty"`
+	// Purpose marks tokens that are not access tokens, such as login challenges
+	Purpose string `json:"pur,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -29,30 +36,82 @@ func NewJWTManager(secretKey string, tokenDuration time.Duration) *JWTManager {
 	}
 }
 
+// NewJWTManagerWithKeySet creates a JWT manager that signs tokens with the
+// current key of the key set and verifies them with any key it still holds
+func NewJWTManagerWithKeySet(keys *KeySet, tokenDuration time.Duration) *JWTManager {
+	return &JWTManager{
+		keys:          keys,
+		tokenDuration: tokenDuration,
+	}
+}
+
+// KeySet returns the asymmetric key set, or nil for HS256 managers
+func (m *JWTManager) KeySet() *KeySet {
+	return m.keys
+}
+
+// TokenDuration returns the lifetime of generated tokens
+func (m *JWTManager) TokenDuration() time.Duration {
+	return m.tokenDuration
+}
+
 // Generate generates a new JWT token for a user
 func (m *JWTManager) Generate(userID, username, email string) (string, error) {
-	claims := &Claims{
-		UserID:   userID,
-		Username: username,
-		Email:    email,
+	return m.GenerateForSession(userID, username, email, "")
+}
+
+// GenerateForSession generates a new JWT token bound to a login session
+func (m *JWTManager) GenerateForSession(userID, username, email, sessionID string) (string, error) {
+	return m.sign(&Claims{
+		UserID:    userID,
+		Username:  username,
+		Email:     email,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.tokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
+	})
+}
+
+// GeneratePurpose generates a short-lived token for a purpose other than
+// API access. Validate rejects such tokens.
+func (m *JWTManager) GeneratePurpose(userID, purpose string, ttl time.Duration) (string, time.Time, error) {
+	expiresAt := time.Now().Add(ttl)
+	token, err := m.sign(&Claims{
+		UserID:  userID,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	})
+	return token, expiresAt, err
+}
+
+// sign signs claims with the current key
+func (m *JWTManager) sign(claims *Claims) (string, error) {
+	if m.keys != nil {
+		key := m.keys.Current()
+		token := jwt.NewWithClaims(key.signingMethod(), claims)
+		token.Header["kid"] = key.ID
+		return token.SignedString(key.private)
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(m.secretKey))
 }
 
-// Validate validates a JWT token and returns the claims
+// Validate validates an access token and returns the claims
 func (m *JWTManager) Validate(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(m.secretKey), nil
-	})
+	return m.ValidatePurpose(tokenString, "")
+}
+
+// ValidatePurpose validates a token generated for the given purpose
+func (m *JWTManager) ValidatePurpose(tokenString, purpose string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, m.verificationKey)
 
 	if err != nil {
 		return nil, err
@@ -63,5 +122,29 @@ func (m *JWTManager) Validate(tokenString string) (*Claims, error) {
 		return nil, fmt.Errorf("invalid token")
 	}
 
+	if claims.Purpose != purpose {
+		return nil, fmt.Errorf("token was not issued for this purpose")
+	}
+
 	return claims, nil
 }
+
+// verificationKey selects the key that verifies a token
+func (m *JWTManager) verificationKey(token *jwt.Token) (interface{}, error) {
+	if m.keys == nil {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(m.secretKey), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := m.keys.Lookup(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+	if token.Method.Alg() != key.signingMethod().Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.public, nil
+}
@@ -179,6 +179,35 @@ func TestJWTManager_InvalidSecretKey(t *testing.T) {
 	}
 }
 
+func TestJWTManager_Purpose(t *testing.T) {
+	jwtMgr := NewJWTManager("test-secret", 24*time.Hour)
+
+	token, expiresAt, err := jwtMgr.GeneratePurpose("user-123", "challenge", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("GeneratePurpose() failed: %v", err)
+	}
+	if time.Until(expiresAt) > 5*time.Minute {
+		t.Errorf("expiresAt = %v, want within 5 minutes", expiresAt)
+	}
+
+	claims, err := jwtMgr.ValidatePurpose(token, "challenge")
+	if err != nil {
+		t.Fatalf("ValidatePurpose() failed: %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("UserID = %v, want %v", claims.UserID, "user-123")
+	}
+
+	// Purpose tokens are not access tokens, and vice versa
+	if _, err := jwtMgr.Validate(token); err == nil {
+		t.Error("Validate() should reject purpose tokens")
+	}
+	access, _ := jwtMgr.Generate("user-123", "testuser", "test@example.com")
+	if _, err := jwtMgr.ValidatePurpose(access, "challenge"); err == nil {
+		t.Error("ValidatePurpose() should reject access tokens")
+	}
+}
+
 func TestClaims_ImplementsJWTClaims(t *testing.T) {
 	var _ jwt.Claims = &Claims{}
 }
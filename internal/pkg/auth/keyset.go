@@ -0,0 +1,479 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported asymmetric signing algorithms
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// rsaKeyBits is the size of generated RSA keys
+const rsaKeyBits = 2048
+
+const (
+	// keyFileExt is the extension of the key files in a key directory
+	keyFileExt = ".pem"
+	// keyReloadInterval is how often a key directory is reloaded to pick up
+	// keys rotated by other instances
+	keyReloadInterval = time.Minute
+	// minKeyReloadInterval limits how often a token signed with an unknown
+	// key makes a key directory be reloaded
+	minKeyReloadInterval = 5 * time.Second
+)
+
+// SigningKey is an asymmetric key pair used to sign and verify tokens
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	CreatedAt time.Time
+
+	private crypto.Signer
+	public  crypto.PublicKey
+	path    string // The file the key was loaded from, if any
+}
+
+// GenerateSigningKey generates a new key pair for the given algorithm
+func GenerateSigningKey(algorithm string) (*SigningKey, error) {
+	var signer crypto.Signer
+	var err error
+
+	switch algorithm {
+	case AlgorithmRS256:
+		signer, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmEdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s key: %w", algorithm, err)
+	}
+
+	return newSigningKey(signer)
+}
+
+// LoadSigningKey loads a PKCS#8 PEM encoded RSA or Ed25519 private key
+func LoadSigningKey(path string) (*SigningKey, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing key file does not contain PEM data")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing key type %T", key)
+	}
+
+	signingKey, err := newSigningKey(signer)
+	if err != nil {
+		return nil, err
+	}
+	signingKey.path = path
+	return signingKey, nil
+}
+
+// save writes the private key to a file in dir named after the key. The file
+// is written under a temporary name and then renamed, so that instances
+// reloading dir never see it half written.
+func (k *SigningKey) save(dir string) error {
+	der, err := x509.MarshalPKCS8PrivateKey(k.private)
+	if err != nil {
+		return fmt.Errorf("failed to encode signing key: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".signing-key-*")
+	if err != nil {
+		return fmt.Errorf("failed to create signing key file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := pem.Encode(tmp, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write signing key: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write signing key: %w", err)
+	}
+
+	path := filepath.Join(dir, k.ID+keyFileExt)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save signing key: %w", err)
+	}
+	k.path = path
+	return nil
+}
+
+// newSigningKey wraps a private key. The key ID is derived from the public
+// key, so every instance loading the same key file agrees on it.
+func newSigningKey(signer crypto.Signer) (*SigningKey, error) {
+	var algorithm string
+	switch signer.(type) {
+	case *rsa.PrivateKey:
+		algorithm = AlgorithmRS256
+	case ed25519.PrivateKey:
+		algorithm = AlgorithmEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", signer)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+
+	return &SigningKey{
+		ID:        base64.RawURLEncoding.EncodeToString(sum[:16]),
+		Algorithm: algorithm,
+		CreatedAt: time.Now(),
+		private:   signer,
+		public:    signer.Public(),
+	}, nil
+}
+
+// signingMethod returns the JWT signing method for the key
+func (k *SigningKey) signingMethod() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// KeySet holds the current signing key and the public keys of retired keys
+// that may still verify unexpired tokens. A key set loaded from a key
+// directory shares its keys with every instance that loads the same
+// directory: rotating writes the new key there, and the other instances pick
+// it up when they reload it. Other key sets live in memory only.
+type KeySet struct {
+	mu         sync.RWMutex
+	algorithm  string
+	retention  time.Duration
+	dir        string // The key directory, or empty for a key set in memory
+	current    *SigningKey
+	retired    map[string]*SigningKey
+	retiredAt  map[string]time.Time
+	reloadedAt time.Time
+}
+
+// NewKeySet creates a key set in memory that signs with the given key.
+// Retired keys are kept for verification for the retention period, which
+// should be at least the lifetime of issued tokens.
+func NewKeySet(current *SigningKey, retention time.Duration) *KeySet {
+	return &KeySet{
+		algorithm: current.Algorithm,
+		retention: retention,
+		current:   current,
+		retired:   make(map[string]*SigningKey),
+		retiredAt: make(map[string]time.Time),
+	}
+}
+
+// LoadKeySet loads a key set from a directory of PKCS#8 PEM encoded private
+// keys. The newest key signs, and must use the given algorithm; older keys
+// verify tokens for the retention period after a newer key replaced them. A
+// key is generated and saved if the directory holds none.
+func LoadKeySet(dir, algorithm string, retention time.Duration) (*KeySet, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create key directory: %w", err)
+	}
+
+	s := &KeySet{
+		algorithm: algorithm,
+		retention: retention,
+		dir:       dir,
+	}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	if s.current == nil {
+		if err := s.Rotate(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Reload reloads the keys of a key set loaded from a key directory
+func (s *KeySet) Reload() error {
+	if s.dir == "" {
+		return nil
+	}
+
+	keys, _, err := s.readDir()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reloadedAt = time.Now()
+	if len(keys) == 0 {
+		return nil
+	}
+	if keys[0].Algorithm != s.algorithm {
+		return fmt.Errorf("newest signing key in %s is %s, but %s is configured", s.dir, keys[0].Algorithm, s.algorithm)
+	}
+
+	s.current = keys[0]
+	s.retired = make(map[string]*SigningKey, len(keys)-1)
+	s.retiredAt = make(map[string]time.Time, len(keys)-1)
+	for i, key := range keys[1:] {
+		s.retired[key.ID] = key
+		s.retiredAt[key.ID] = keys[i].CreatedAt
+	}
+	return nil
+}
+
+// readDir loads the keys in the key directory, newest first, leaving out
+// those whose retention period has passed. It also returns the files of the
+// keys left out.
+func (s *KeySet) readDir() (keys []*SigningKey, expired []string, err error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read key directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), keyFileExt) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read key directory: %w", err)
+		}
+		key, err := LoadSigningKey(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		// Keys are ordered by when they were written, which every instance
+		// sees the same
+		key.CreatedAt = info.ModTime()
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	// A key is retired when the next newer key is written
+	now := time.Now()
+	for i := 1; i < len(keys); i++ {
+		if now.Sub(keys[i-1].CreatedAt) > s.retention {
+			for _, key := range keys[i:] {
+				expired = append(expired, key.path)
+			}
+			keys = keys[:i]
+			break
+		}
+	}
+	return keys, expired, nil
+}
+
+// Current returns the key used to sign new tokens
+func (s *KeySet) Current() *SigningKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current
+}
+
+// Lookup returns the key with the given ID if it may still verify tokens. A
+// key set loaded from a key directory reloads it if the key is unknown, since
+// another instance may have rotated to it.
+func (s *KeySet) Lookup(kid string) (*SigningKey, bool) {
+	key, ok := s.lookup(kid)
+	if ok || s.dir == "" {
+		return key, ok
+	}
+
+	s.mu.RLock()
+	stale := time.Since(s.reloadedAt) >= minKeyReloadInterval
+	s.mu.RUnlock()
+	if !stale || s.Reload() != nil {
+		return nil, false
+	}
+	return s.lookup(kid)
+}
+
+// lookup returns the key with the given ID among the loaded keys
+func (s *KeySet) lookup(kid string) (*SigningKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.current.ID == kid {
+		return s.current, true
+	}
+	key, ok := s.retired[kid]
+	return key, ok
+}
+
+// Rotate generates a new signing key, retires the current one and drops
+// retired keys whose retention period has passed. A key set loaded from a
+// key directory saves the new key there and deletes the files of the keys it
+// drops.
+func (s *KeySet) Rotate() error {
+	next, err := GenerateSigningKey(s.algorithm)
+	if err != nil {
+		return err
+	}
+
+	if s.dir != "" {
+		if err := next.save(s.dir); err != nil {
+			return err
+		}
+		_, expired, err := s.readDir()
+		if err != nil {
+			return err
+		}
+		for _, path := range expired {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to delete expired signing key: %w", err)
+			}
+		}
+		return s.Reload()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.retired[s.current.ID] = s.current
+	s.retiredAt[s.current.ID] = now
+	s.current = next
+
+	for kid, retiredAt := range s.retiredAt {
+		if now.Sub(retiredAt) > s.retention {
+			delete(s.retired, kid)
+			delete(s.retiredAt, kid)
+		}
+	}
+
+	return nil
+}
+
+// StartRotation rotates the signing key every interval until ctx is done. A
+// key set loaded from a key directory is reloaded in between, and only
+// rotated once its current key is interval old, so that instances sharing
+// the directory do not each rotate.
+func (s *KeySet) StartRotation(ctx context.Context, interval time.Duration, onError func(error)) {
+	tick := interval
+	if s.dir != "" && keyReloadInterval < interval {
+		tick = keyReloadInterval
+	}
+
+	ticker := time.NewTicker(tick)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.rotateIfDue(interval); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+}
+
+// rotateIfDue rotates the signing key if it is interval old
+func (s *KeySet) rotateIfDue(interval time.Duration) error {
+	if err := s.Reload(); err != nil {
+		return err
+	}
+	if s.dir != "" && time.Since(s.Current().CreatedAt) < interval {
+		return nil
+	}
+	return s.Rotate()
+}
+
+// JSONWebKey is the public part of a signing key in JWK format (RFC 7517)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet is a set of public keys in JWKS format
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys that may verify tokens, newest first
+func (s *KeySet) JWKS() JSONWebKeySet {
+	s.mu.RLock()
+	keys := []*SigningKey{s.current}
+	for _, key := range s.retired {
+		keys = append(keys, key)
+	}
+	s.mu.RUnlock()
+
+	sort.SliceStable(keys[1:], func(i, j int) bool {
+		return keys[1+i].CreatedAt.After(keys[1+j].CreatedAt)
+	})
+
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		set.Keys = append(set.Keys, key.jwk())
+	}
+	return set
+}
+
+// jwk encodes the public key in JWK format
+func (k *SigningKey) jwk() JSONWebKey {
+	jwk := JSONWebKey{
+		KeyID:     k.ID,
+		Use:       "sig",
+		Algorithm: k.Algorithm,
+	}
+
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+
+	return jwk
+}
@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJWTManagerWithKeySet(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			key, err := GenerateSigningKey(algorithm)
+			if err != nil {
+				t.Fatalf("GenerateSigningKey() error = %v", err)
+			}
+			keys := NewKeySet(key, time.Hour)
+			jwtMgr := NewJWTManagerWithKeySet(keys, time.Hour)
+
+			token, err := jwtMgr.Generate("user-123", "testuser", "test@example.com")
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			// Tokens signed before a rotation still verify with the retired key
+			if err := keys.Rotate(); err != nil {
+				t.Fatalf("Rotate() error = %v", err)
+			}
+			claims, err := jwtMgr.Validate(token)
+			if err != nil {
+				t.Fatalf("Validate() after rotation error = %v", err)
+			}
+			if claims.UserID != "user-123" {
+				t.Errorf("UserID = %v, want %v", claims.UserID, "user-123")
+			}
+
+			if got := len(keys.JWKS().Keys); got != 2 {
+				t.Errorf("JWKS() returned %d keys, want 2", got)
+			}
+			if keys.JWKS().Keys[0].KeyID != keys.Current().ID {
+				t.Error("JWKS() should list the current key first")
+			}
+
+			// Tokens from another key set are rejected
+			other, _ := GenerateSigningKey(algorithm)
+			foreign, _ := NewJWTManagerWithKeySet(NewKeySet(other, time.Hour), time.Hour).Generate("user-123", "testuser", "test@example.com")
+			if _, err := jwtMgr.Validate(foreign); err == nil {
+				t.Error("expected token signed with unknown key to be rejected")
+			}
+
+			// HS256 tokens are rejected
+			hmacToken, _ := NewJWTManager("secret", time.Hour).Generate("user-123", "testuser", "test@example.com")
+			if _, err := jwtMgr.Validate(hmacToken); err == nil {
+				t.Error("expected HS256 token to be rejected")
+			}
+		})
+	}
+}
+
+func TestKeySet_RotateDropsExpiredKeys(t *testing.T) {
+	key, err := GenerateSigningKey(AlgorithmEdDSA)
+	if err != nil {
+		t.Fatalf("GenerateSigningKey() error = %v", err)
+	}
+	keys := NewKeySet(key, 0)
+
+	if err := keys.Rotate(); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	if err := keys.Rotate(); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	if _, ok := keys.Lookup(key.ID); ok {
+		t.Error("expected key past its retention period to be dropped")
+	}
+}
+
+func TestLoadSigningKey(t *testing.T) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	first, err := LoadSigningKey(path)
+	if err != nil {
+		t.Fatalf("LoadSigningKey() error = %v", err)
+	}
+	second, err := LoadSigningKey(path)
+	if err != nil {
+		t.Fatalf("LoadSigningKey() error = %v", err)
+	}
+
+	if first.Algorithm != AlgorithmEdDSA {
+		t.Errorf("Algorithm = %v, want %v", first.Algorithm, AlgorithmEdDSA)
+	}
+	if first.ID != second.ID {
+		t.Error("expected key ID to be derived from the key")
+	}
+}
+
+func TestLoadKeySet_SharesKeysThroughDirectory(t *testing.T) {
+	dir := t.TempDir()
+	first, err := LoadKeySet(dir, AlgorithmEdDSA, time.Hour)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+	second, err := LoadKeySet(dir, AlgorithmEdDSA, time.Hour)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+	if first.Current().ID != second.Current().ID {
+		t.Fatal("expected key sets loading the same directory to sign with the same key")
+	}
+
+	// A token signed after one instance rotates verifies on the other
+	time.Sleep(10 * time.Millisecond)
+	if err := first.Rotate(); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	token, err := NewJWTManagerWithKeySet(first, time.Hour).Generate("user-123", "testuser", "test@example.com")
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	second.reloadedAt = time.Time{}
+	if _, err := NewJWTManagerWithKeySet(second, time.Hour).Validate(token); err != nil {
+		t.Errorf("Validate() on the other instance error = %v", err)
+	}
+	if second.Current().ID != first.Current().ID {
+		t.Error("expected the other instance to sign with the rotated key")
+	}
+
+	// The other instance does not rotate again before the key is due
+	if err := second.rotateIfDue(time.Hour); err != nil {
+		t.Fatalf("rotateIfDue() error = %v", err)
+	}
+	if second.Current().ID != first.Current().ID {
+		t.Error("expected a key that is not due not to be rotated")
+	}
+
+	if _, err := LoadKeySet(dir, AlgorithmRS256, time.Hour); err == nil {
+		t.Error("expected a key directory of another algorithm to be rejected")
+	}
+}
+
+func TestKeySet_RotateDeletesExpiredKeyFiles(t *testing.T) {
+	dir := t.TempDir()
+	keys, err := LoadKeySet(dir, AlgorithmEdDSA, 0)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+	oldest := keys.Current()
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+		if err := keys.Rotate(); err != nil {
+			t.Fatalf("Rotate() error = %v", err)
+		}
+	}
+
+	if _, err := os.Stat(oldest.path); !os.IsNotExist(err) {
+		t.Errorf("expected the file of a key past its retention period to be deleted, got %v", err)
+	}
+	if _, ok := keys.Lookup(oldest.ID); ok {
+		t.Error("expected key past its retention period to be dropped")
+	}
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// LockoutPolicy controls how failed login attempts are throttled
+type LockoutPolicy struct {
+	// MaxFailures is the number of failures within Window that locks the
+	// key out for LockoutDuration; zero disables lockout
+	MaxFailures     int
+	Window          time.Duration
+	LockoutDuration time.Duration
+	// BaseDelay is the wait imposed after the first failure. It doubles with
+	// each further failure up to MaxDelay; zero disables delays.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Delay returns how long to wait before the next attempt after the given
+// number of consecutive failures
+func (p LockoutPolicy) Delay(failures int) time.Duration {
+	if failures <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+		// Stop doubling well before the duration could overflow
+		if delay > 24*time.Hour {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// LoginAttemptTracker counts failed login attempts per key, such as an
+// email address or a client IP, and blocks keys according to a policy
+type LoginAttemptTracker interface {
+	// Blocked returns how long attempts for key must still wait, or zero
+	Blocked(ctx context.Context, key string) (time.Duration, error)
+
+	// RecordFailure counts a failed attempt and blocks the key for the delay
+	// or lockout the policy prescribes. locked reports whether this failure
+	// started a lockout.
+	RecordFailure(ctx context.Context, key string, policy LockoutPolicy) (failures int, locked bool, err error)
+
+	// Reset clears the failures and any block for key
+	Reset(ctx context.Context, key string) error
+}
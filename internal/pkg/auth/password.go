@@ -1,17 +1,183 @@
 package auth
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// HashPassword hashes a password using bcrypt
+// Supported password hashing algorithms
+const (
+	PasswordAlgorithmArgon2id = "argon2id"
+	PasswordAlgorithmBcrypt   = "bcrypt"
+)
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+
+	// bcryptMaxPasswordLength is the longest password, in bytes, bcrypt can hash
+	bcryptMaxPasswordLength = 72
+)
+
+// errUnknownPasswordHash is returned for hashes in an unrecognized format
+var errUnknownPasswordHash = errors.New("unknown password hash format")
+
+// PasswordHasher hashes passwords with one algorithm and cost. Hashes record
+// their algorithm and parameters, so hashes made with other settings still
+// verify and can be detected with NeedsRehash.
+type PasswordHasher struct {
+	Algorithm string
+
+	// BcryptCost is the bcrypt work factor
+	BcryptCost int
+
+	// Argon2 parameters: passes over memory, memory in KiB and parallelism
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+// DefaultPasswordHasher returns a hasher using argon2id with the OWASP
+// baseline parameters
+func DefaultPasswordHasher() *PasswordHasher {
+	return &PasswordHasher{
+		Algorithm:     PasswordAlgorithmArgon2id,
+		BcryptCost:    bcrypt.DefaultCost,
+		Argon2Time:    2,
+		Argon2Memory:  19 * 1024,
+		Argon2Threads: 1,
+	}
+}
+
+// Validate checks that the hasher is configured with usable parameters
+func (h *PasswordHasher) Validate() error {
+	switch h.Algorithm {
+	case PasswordAlgorithmArgon2id:
+		if h.Argon2Time < 1 || h.Argon2Threads < 1 {
+			return fmt.Errorf("argon2id time and threads must be at least 1")
+		}
+		if h.Argon2Memory < 8*uint32(h.Argon2Threads) {
+			return fmt.Errorf("argon2id memory must be at least 8 KiB per thread")
+		}
+	case PasswordAlgorithmBcrypt:
+		if h.BcryptCost < bcrypt.MinCost || h.BcryptCost > bcrypt.MaxCost {
+			return fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	default:
+		return fmt.Errorf("unsupported password hashing algorithm: %s", h.Algorithm)
+	}
+	return nil
+}
+
+// MaxPasswordLength returns the longest password, in bytes, the configured
+// algorithm can hash, or 0 if it has no limit
+func (h *PasswordHasher) MaxPasswordLength() int {
+	if h.Algorithm == PasswordAlgorithmBcrypt {
+		return bcryptMaxPasswordLength
+	}
+	return 0
+}
+
+// Hash hashes a password with the configured algorithm
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	if h.Algorithm == PasswordAlgorithmBcrypt {
+		bytes, err := bcrypt.GenerateFromPassword([]byte(password), h.BcryptCost)
+		return string(bytes), err
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.Argon2Time, h.Argon2Memory, h.Argon2Threads, argon2KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.Argon2Memory, h.Argon2Time, h.Argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// NeedsRehash reports whether a hash was made with a different algorithm or
+// different parameters than the configured ones. Empty hashes, which belong
+// to accounts without a password, never need rehashing.
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if hash == "" {
+		return false
+	}
+
+	if h.Algorithm == PasswordAlgorithmBcrypt {
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.BcryptCost
+	}
+
+	params, _, _, err := decodeArgon2Hash(hash)
+	return err != nil ||
+		params.version != argon2.Version ||
+		params.time != h.Argon2Time ||
+		params.memory != h.Argon2Memory ||
+		params.threads != h.Argon2Threads
+}
+
+// HashPassword hashes a password with the default hasher
 func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	return string(bytes), err
+	return DefaultPasswordHasher().Hash(password)
 }
 
-// CheckPassword checks if a password matches a hash
+// CheckPassword checks if a password matches an argon2id or bcrypt hash
 func CheckPassword(password, hash string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		params, salt, key, err := decodeArgon2Hash(hash)
+		if err != nil {
+			return false
+		}
+		computed := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+		return subtle.ConstantTimeCompare(computed, key) == 1
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// argon2Params are the parameters recorded in an argon2id hash
+type argon2Params struct {
+	version int
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+// decodeArgon2Hash parses a hash in the PHC string format
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>
+func decodeArgon2Hash(hash string) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != PasswordAlgorithmArgon2id {
+		return params, nil, nil, errUnknownPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &params.version); err != nil {
+		return params, nil, nil, errUnknownPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, nil, nil, errUnknownPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, errUnknownPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, errUnknownPasswordHash
+	}
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// PasswordPolicy defines the rules new passwords must satisfy
+type PasswordPolicy struct {
+	MinLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireNumber    bool
+	RequireSpecial   bool
+
+	denyList map[string]struct{}
+}
+
+// LoadDenyList loads a file of forbidden passwords, one per line. Blank lines
+// and lines starting with '#' are ignored; matching is case-insensitive.
+func (p *PasswordPolicy) LoadDenyList(path string) error {
+	file, err := os.Open(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return fmt.Errorf("failed to open password deny list: %w", err)
+	}
+	defer file.Close()
+
+	denyList := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		denyList[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read password deny list: %w", err)
+	}
+
+	p.denyList = denyList
+	return nil
+}
+
+// Validate checks a password against the policy and returns an error
+// describing the first rule it violates. The policy sets no maximum length;
+// that depends on the hasher, see PasswordHasher.MaxPasswordLength.
+func (p *PasswordPolicy) Validate(password string) error {
+	if len(password) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters long", p.MinLength)
+	}
+
+	var hasUpper, hasLower, hasNumber, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasNumber = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSpecial = true
+		}
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		return fmt.Errorf("password must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !hasLower {
+		return fmt.Errorf("password must contain a lowercase letter")
+	}
+	if p.RequireNumber && !hasNumber {
+		return fmt.Errorf("password must contain a number")
+	}
+	if p.RequireSpecial && !hasSpecial {
+		return fmt.Errorf("password must contain a special character")
+	}
+
+	if _, denied := p.denyList[strings.ToLower(password)]; denied {
+		return fmt.Errorf("password is too common")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := &PasswordPolicy{
+		MinLength:        8,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireNumber:    true,
+		RequireSpecial:   true,
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "valid password", password: "Str0ng!pass", wantErr: false},
+		{name: "too short", password: "S0!a", wantErr: true},
+		{name: "long", password: "Str0ng!pass" + strings.Repeat("x", 80), wantErr: false},
+		{name: "missing uppercase", password: "str0ng!pass", wantErr: true},
+		{name: "missing lowercase", password: "STR0NG!PASS", wantErr: true},
+		{name: "missing number", password: "Strong!pass", wantErr: true},
+		{name: "missing special", password: "Str0ngpass", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_LoadDenyList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	content := "# common passwords\nPassword123!\n\nletmein\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write deny list: %v", err)
+	}
+
+	policy := &PasswordPolicy{MinLength: 6}
+	if err := policy.LoadDenyList(path); err != nil {
+		t.Fatalf("LoadDenyList() error = %v", err)
+	}
+
+	if err := policy.Validate("password123!"); err == nil {
+		t.Error("expected denied password to be rejected")
+	}
+	if err := policy.Validate("letmein"); err == nil {
+		t.Error("expected denied password to be rejected")
+	}
+	if err := policy.Validate("correct horse"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := policy.LoadDenyList(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("expected error for missing deny list file")
+	}
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -156,3 +157,107 @@ func TestPasswordSecurity(t *testing.T) {
 		t.Error("Password2 should not validate against password1's hash")
 	}
 }
+
+func TestPasswordHasher_Algorithms(t *testing.T) {
+	hashers := map[string]*PasswordHasher{
+		PasswordAlgorithmArgon2id: {Algorithm: PasswordAlgorithmArgon2id, Argon2Time: 1, Argon2Memory: 64, Argon2Threads: 1},
+		PasswordAlgorithmBcrypt:   {Algorithm: PasswordAlgorithmBcrypt, BcryptCost: 4},
+	}
+	prefixes := map[string]string{
+		PasswordAlgorithmArgon2id: "$argon2id$v=19$m=64,t=1,p=1$",
+		PasswordAlgorithmBcrypt:   "$2a$04$",
+	}
+
+	for algorithm, hasher := range hashers {
+		t.Run(algorithm, func(t *testing.T) {
+			hash, err := hasher.Hash("password123")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(hash, prefixes[algorithm]) {
+				t.Errorf("Hash() = %v, want prefix %v", hash, prefixes[algorithm])
+			}
+			if !CheckPassword("password123", hash) {
+				t.Error("CheckPassword() should accept the correct password")
+			}
+			if CheckPassword("wrongpassword", hash) {
+				t.Error("CheckPassword() should reject a wrong password")
+			}
+			if hasher.NeedsRehash(hash) {
+				t.Error("NeedsRehash() should be false for a hash made with the current settings")
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_MaxPasswordLength(t *testing.T) {
+	long := strings.Repeat("x", bcryptMaxPasswordLength+1)
+
+	argon := &PasswordHasher{Algorithm: PasswordAlgorithmArgon2id, Argon2Time: 1, Argon2Memory: 64, Argon2Threads: 1}
+	if got := argon.MaxPasswordLength(); got != 0 {
+		t.Errorf("MaxPasswordLength() argon2id = %d, want 0", got)
+	}
+	hash, err := argon.Hash(long)
+	if err != nil {
+		t.Fatalf("Hash() argon2id error = %v", err)
+	}
+	if !CheckPassword(long, hash) {
+		t.Error("CheckPassword() should accept a long argon2id password")
+	}
+
+	bcryptHasher := &PasswordHasher{Algorithm: PasswordAlgorithmBcrypt, BcryptCost: 4}
+	if got := bcryptHasher.MaxPasswordLength(); got != bcryptMaxPasswordLength {
+		t.Errorf("MaxPasswordLength() bcrypt = %d, want %d", got, bcryptMaxPasswordLength)
+	}
+	if _, err := bcryptHasher.Hash(long); err == nil {
+		t.Error("Hash() bcrypt expected error for a password over the limit")
+	}
+}
+
+func TestPasswordHasher_NeedsRehash(t *testing.T) {
+	argon := &PasswordHasher{Algorithm: PasswordAlgorithmArgon2id, Argon2Time: 1, Argon2Memory: 64, Argon2Threads: 1}
+	stronger := &PasswordHasher{Algorithm: PasswordAlgorithmArgon2id, Argon2Time: 2, Argon2Memory: 64, Argon2Threads: 1}
+	bcryptHasher := &PasswordHasher{Algorithm: PasswordAlgorithmBcrypt, BcryptCost: 4}
+
+	argonHash, _ := argon.Hash("password123")
+	bcryptHash, _ := bcryptHasher.Hash("password123")
+
+	tests := []struct {
+		name   string
+		hasher *PasswordHasher
+		hash   string
+		want   bool
+	}{
+		{name: "bcrypt hash with argon2id configured", hasher: argon, hash: bcryptHash, want: true},
+		{name: "argon2id hash with bcrypt configured", hasher: bcryptHasher, hash: argonHash, want: true},
+		{name: "argon2id hash with stronger parameters configured", hasher: stronger, hash: argonHash, want: true},
+		{name: "bcrypt hash with higher cost configured", hasher: &PasswordHasher{Algorithm: PasswordAlgorithmBcrypt, BcryptCost: 5}, hash: bcryptHash, want: true},
+		{name: "account without password", hasher: argon, hash: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_Validate(t *testing.T) {
+	if err := DefaultPasswordHasher().Validate(); err != nil {
+		t.Errorf("Validate() default hasher error = %v", err)
+	}
+
+	invalid := []*PasswordHasher{
+		{Algorithm: "md5"},
+		{Algorithm: PasswordAlgorithmBcrypt, BcryptCost: 2},
+		{Algorithm: PasswordAlgorithmArgon2id, Argon2Time: 0, Argon2Memory: 64, Argon2Threads: 1},
+		{Algorithm: PasswordAlgorithmArgon2id, Argon2Time: 1, Argon2Memory: 8, Argon2Threads: 4},
+	}
+	for _, hasher := range invalid {
+		if err := hasher.Validate(); err == nil {
+			t.Errorf("Validate() expected error for %+v", hasher)
+		}
+	}
+}
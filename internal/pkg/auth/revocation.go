@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// RevocationStore records access tokens that must no longer be accepted
+// even though their signature and expiry are still valid
+type RevocationStore interface {
+	// Revoke revokes a single token by its ID until it expires
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+
+	// RevokeSession revokes every token issued for a login session
+	RevokeSession(ctx context.Context, sessionID string) error
+
+	// RevokeAllBefore revokes every token issued to a user before the given time
+	RevokeAllBefore(ctx context.Context, userID string, before time.Time) error
+
+	// IsRevoked reports whether the token described by claims has been revoked
+	IsRevoked(ctx context.Context, claims *Claims) (bool, error)
+}
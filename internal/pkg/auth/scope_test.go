@@ -0,0 +1,49 @@
+package auth
+
+import "testing"
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required string
+		want     bool
+	}{
+		{name: "exact scope", granted: []string{ScopeTODOsRead}, required: ScopeTODOsRead, want: true},
+		{name: "write implies read", granted: []string{ScopeTODOsWrite}, required: ScopeTODOsRead, want: true},
+		{name: "admin implies write", granted: []string{ScopeTeamsAdmin}, required: ScopeTeamsWrite, want: true},
+		{name: "read does not imply write", granted: []string{ScopeTODOsRead}, required: ScopeTODOsWrite, want: false},
+		{name: "other resource", granted: []string{ScopeTeamsAdmin}, required: ScopeTODOsRead, want: false},
+		{name: "no scopes", granted: nil, required: ScopeMediaRead, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasScope(tt.granted, tt.required); got != tt.want {
+				t.Errorf("HasScope(%v, %v) = %v, want %v", tt.granted, tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	if err := ValidateScopes([]string{ScopeTODOsRead, ScopeTeamsAdmin}); err != nil {
+		t.Errorf("ValidateScopes() unexpected error = %v", err)
+	}
+	if err := ValidateScopes(nil); err == nil {
+		t.Error("expected error for empty scopes")
+	}
+	if err := ValidateScopes([]string{"todos:delete"}); err == nil {
+		t.Error("expected error for unknown scope")
+	}
+}
+
+func TestGeneratePersonalAccessToken(t *testing.T) {
+	token, err := GeneratePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("GeneratePersonalAccessToken() error = %v", err)
+	}
+	if !IsPersonalAccessToken(token) {
+		t.Errorf("expected %q to be recognized as a personal access token", token)
+	}
+}
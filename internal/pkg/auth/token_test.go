@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"testing"
+)
+
+func TestGenerateOpaqueToken(t *testing.T) {
+	first, err := GenerateOpaqueToken()
+	if err != nil {
+		t.Fatalf("GenerateOpaqueToken() error = %v", err)
+	}
+	second, err := GenerateOpaqueToken()
+	if err != nil {
+		t.Fatalf("GenerateOpaqueToken() error = %v", err)
+	}
+
+	if first == "" {
+		t.Error("GenerateOpaqueToken() returned empty token")
+	}
+	if first == second {
+		t.Error("GenerateOpaqueToken() returned the same token twice")
+	}
+}
+
+func TestHashOpaqueToken(t *testing.T) {
+	hash := HashOpaqueToken("token")
+	if len(hash) != 64 {
+		t.Errorf("HashOpaqueToken() length = %d, want 64", len(hash))
+	}
+	if hash != HashOpaqueToken("token") {
+		t.Error("HashOpaqueToken() is not deterministic")
+	}
+	if hash == HashOpaqueToken("other") {
+		t.Error("HashOpaqueToken() returned the same hash for different tokens")
+	}
+}
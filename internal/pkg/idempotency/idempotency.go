@@ -0,0 +1,75 @@
+// Package idempotency lets clients safely retry mutating requests. A request
+// sent with an idempotency key is executed once; repeating it with the same
+// key replays the response of the first execution.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// MaxKeyLength is the maximum length of an idempotency key
+const MaxKeyLength = 255
+
+// Record is what is stored for an idempotency key
+type Record struct {
+	// Fingerprint identifies the request the key was first used with
+	Fingerprint string `json:"fingerprint"`
+	// Response is the response to the request, encoded as a
+	// google.protobuf.Any. It is empty while the request is in progress.
+	Response []byte `json:"response,omitempty"`
+}
+
+// Store stores the records of idempotency keys
+type Store interface {
+	// Reserve stores record under key until the ttl elapses, unless the key
+	// is already taken. It returns the record already stored under the key,
+	// or nil if the key was reserved.
+	Reserve(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, error)
+
+	// Save replaces the record stored under key
+	Save(ctx context.Context, key string, record *Record, ttl time.Duration) error
+
+	// Release removes the record stored under key, so that the key can be
+	// used again
+	Release(ctx context.Context, key string) error
+}
+
+// Fingerprint returns a digest of a request to a method, so that reusing a
+// key for a different request can be detected
+func Fingerprint(method string, req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	sum := sha256.New()
+	sum.Write([]byte(method))
+	sum.Write([]byte{0})
+	sum.Write(data)
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+// EncodeResponse encodes a response for storage in a record
+func EncodeResponse(resp proto.Message) ([]byte, error) {
+	wrapped, err := anypb.New(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap response: %w", err)
+	}
+	return proto.Marshal(wrapped)
+}
+
+// DecodeResponse decodes a response stored by EncodeResponse
+func DecodeResponse(data []byte) (proto.Message, error) {
+	var wrapped anypb.Any
+	if err := proto.Unmarshal(data, &wrapped); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return wrapped.UnmarshalNew()
+}
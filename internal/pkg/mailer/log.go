@@ -0,0 +1,32 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// LogMailer writes messages to a writer instead of delivering them, which is
+// useful for local development and tests
+type LogMailer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	from string
+}
+
+// NewLogMailer creates a new mailer that writes messages to w
+func NewLogMailer(w io.Writer, from string) *LogMailer {
+	return &LogMailer{w: w, from: from}
+}
+
+// Send writes a message followed by a separator line
+func (m *LogMailer) Send(ctx context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := fmt.Fprintf(m.w, "%s\r\n-----\r\n", formatMessage(m.from, msg)); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+	return nil
+}
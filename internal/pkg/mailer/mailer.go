@@ -0,0 +1,19 @@
+// Package mailer sends transactional email such as verification and
+// password reset messages.
+package mailer
+
+import (
+	"context"
+)
+
+// Message is a plain-text email message
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers email messages
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
@@ -0,0 +1,65 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLogMailer_Send(t *testing.T) {
+	var buf bytes.Buffer
+	m := NewLogMailer(&buf, "noreply@example.com")
+
+	err := m.Send(context.Background(), &Message{
+		To:      "user@example.com",
+		Subject: "Hello",
+		Body:    "line one\nline two",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"From: noreply@example.com",
+		"To: user@example.com",
+		"Subject: Hello",
+		"line one\r\nline two",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	data := LinkData{Name: "Alice", Link: "https://example.com/verify?token=abc", ExpiresIn: "24h0m0s"}
+
+	tests := []struct {
+		name    string
+		render  func(string, LinkData) (*Message, error)
+		subject string
+	}{
+		{name: "verification", render: VerificationEmail, subject: "Verify your email address"},
+		{name: "password reset", render: PasswordResetEmail, subject: "Reset your password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := tt.render("alice@example.com", data)
+			if err != nil {
+				t.Fatalf("render error = %v", err)
+			}
+			if msg.To != "alice@example.com" {
+				t.Errorf("To = %v, want %v", msg.To, "alice@example.com")
+			}
+			if msg.Subject != tt.subject {
+				t.Errorf("Subject = %v, want %v", msg.Subject, tt.subject)
+			}
+			if !strings.Contains(msg.Body, data.Link) || !strings.Contains(msg.Body, "Hi Alice") {
+				t.Errorf("Body does not contain rendered data:\n%s", msg.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// LinkData holds the values rendered into account emails
+type LinkData struct {
+	Name      string
+	Link      string
+	ExpiresIn string
+}
+
+var (
+	verificationTemplate = template.Must(template.New("verification").Parse(
+		`Hi {{.Name}},
+
+Please confirm your email address by opening the link below:
+
+{{.Link}}
+
+The link expires in {{.ExpiresIn}}. If you did not create an account, you can ignore this email.
+`))
+
+	passwordResetTemplate = template.Must(template.New("password_reset").Parse(
+		`Hi {{.Name}},
+
+We received a request to reset your password. Open the link below to choose a new one:
+
+{{.Link}}
+
+The link expires in {{.ExpiresIn}}. If you did not request a password reset, you can ignore this email.
+`))
+)
+
+// VerificationEmail renders the email address verification message
+func VerificationEmail(to string, data LinkData) (*Message, error) {
+	return render(verificationTemplate, to, "Verify your email address", data)
+}
+
+// PasswordResetEmail renders the password reset message
+func PasswordResetEmail(to string, data LinkData) (*Message, error) {
+	return render(passwordResetTemplate, to, "Reset your password", data)
+}
+
+func render(tmpl *template.Template, to, subject string, data LinkData) (*Message, error) {
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s email: %w", tmpl.Name(), err)
+	}
+
+	return &Message{
+		To:      to,
+		Subject: subject,
+		Body:    body.String(),
+	}, nil
+}
@@ -29,10 +29,17 @@ const (
 	UsernameKey contextKey = "username"
 	// EmailKey is the context key for email
 	EmailKey contextKey = "email"
+	// SessionIDKey is the context key for the login session ID
+	SessionIDKey contextKey = "session_id"
+	// ClaimsKey is the context key for the validated token claims
+	ClaimsKey contextKey = "claims"
+	// ScopesKey is the context key for the scopes of a personal access token
+	ScopesKey contextKey = "scopes"
 )
 
-// AuthInterceptor creates a gRPC interceptor for authentication
-func AuthInterceptor(jwtMgr *auth.JWTManager) grpc.UnaryServerInterceptor {
+// AuthInterceptor creates a gRPC interceptor for authentication. Bearer
+// tokens may be JWTs or, if tokens is set, personal access tokens.
+func AuthInterceptor(jwtMgr *auth.JWTManager, revocations auth.RevocationStore, tokens auth.PersonalAccessTokenVerifier) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -44,39 +51,106 @@ func AuthInterceptor(jwtMgr *auth.JWTManager) grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		ctx, err := authenticate(ctx, jwtMgr, revocations, tokens)
+		if err != nil {
+			return nil, err
 		}
 
-		// Get authorization header
-		authHeaders := md.Get(AuthorizationHeader)
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor creates a gRPC stream interceptor for authentication
+func AuthStreamInterceptor(jwtMgr *auth.JWTManager, revocations auth.RevocationStore, tokens auth.PersonalAccessTokenVerifier) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if shouldSkipAuth(info.FullMethod) {
+			return handler(srv, stream)
 		}
 
-		// Extract token
-		token := authHeaders[0]
-		if !strings.HasPrefix(token, BearerPrefix) {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+		ctx, err := authenticate(stream.Context(), jwtMgr, revocations, tokens)
+		if err != nil {
+			return err
 		}
 
-		token = strings.TrimPrefix(token, BearerPrefix)
+		return handler(srv, &wrappedServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
 
-		// Validate token
-		claims, err := jwtMgr.Validate(token)
+// authenticate validates the bearer token in the incoming metadata and
+// returns a context carrying the authenticated user's information
+func authenticate(ctx context.Context, jwtMgr *auth.JWTManager, revocations auth.RevocationStore, tokens auth.PersonalAccessTokenVerifier) (context.Context, error) {
+	// Extract token from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	// Get authorization header
+	authHeaders := md.Get(AuthorizationHeader)
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	// Extract token
+	token := authHeaders[0]
+	if !strings.HasPrefix(token, BearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+	}
+
+	token = strings.TrimPrefix(token, BearerPrefix)
+
+	if tokens != nil && auth.IsPersonalAccessToken(token) {
+		claims, scopes, err := tokens.VerifyPersonalAccessToken(ctx, token)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+			return nil, err
 		}
+		return withClaims(context.WithValue(ctx, ScopesKey, scopes), claims), nil
+	}
 
-		// Add user information to context
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, UsernameKey, claims.Username)
-		ctx = context.WithValue(ctx, EmailKey, claims.Email)
+	// Validate token
+	claims, err := jwtMgr.Validate(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
 
-		return handler(ctx, req)
+	// Reject tokens revoked by logout
+	if revocations != nil {
+		revoked, err := revocations.IsRevoked(ctx, claims)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "failed to check token revocation")
+		}
+		if revoked {
+			return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+		}
 	}
+
+	return withClaims(ctx, claims), nil
+}
+
+// withClaims adds the authenticated user's information to the context
+func withClaims(ctx context.Context, claims *auth.Claims) context.Context {
+	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, UsernameKey, claims.Username)
+	ctx = context.WithValue(ctx, EmailKey, claims.Email)
+	ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
+	ctx = context.WithValue(ctx, ClaimsKey, claims)
+	return ctx
+}
+
+// wrappedServerStream overrides the context of a grpc.ServerStream
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the wrapped context
+func (w *wrappedServerStream) Context() context.Context {
+	return w.ctx
 }
 
 // GetUserIDFromContext extracts user ID from context
@@ -106,11 +180,45 @@ func GetEmailFromContext(ctx context.Context) string {
 	return email
 }
 
+// GetSessionIDFromContext extracts the login session ID from context
+func GetSessionIDFromContext(ctx context.Context) string {
+	sessionID, ok := ctx.Value(SessionIDKey).(string)
+	if !ok {
+		return ""
+	}
+	return sessionID
+}
+
+// GetClaimsFromContext extracts the validated token claims from context
+func GetClaimsFromContext(ctx context.Context) (*auth.Claims, error) {
+	claims, ok := ctx.Value(ClaimsKey).(*auth.Claims)
+	if !ok || claims == nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	return claims, nil
+}
+
+// GetScopesFromContext extracts the scopes of the personal access token the
+// request was authenticated with. restricted is false for session tokens,
+// which are not limited by scopes.
+func GetScopesFromContext(ctx context.Context) (scopes []string, restricted bool) {
+	scopes, restricted = ctx.Value(ScopesKey).([]string)
+	return scopes, restricted
+}
+
 // shouldSkipAuth determines if authentication should be skipped for a method
 func shouldSkipAuth(method string) bool {
 	skipMethods := []string{
 		"/todo.v1.AuthService/Register",
 		"/todo.v1.AuthService/Login",
+		"/todo.v1.AuthService/RefreshToken",
+		"/todo.v1.AuthService/VerifyEmail",
+		"/todo.v1.AuthService/RequestPasswordReset",
+		"/todo.v1.AuthService/ConfirmPasswordReset",
+		"/todo.v1.AuthService/ListSSOProviders",
+		"/todo.v1.AuthService/StartSSOLogin",
+		"/todo.v1.AuthService/CompleteSSOLogin",
+		"/todo.v1.AuthService/VerifyTwoFactorLogin",
 		"/todo.v1.SystemService/HealthCheck",
 	}
 	for _, skipMethod := range skipMethods {
@@ -12,9 +12,37 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// mockRevocationStore is an in-memory auth.RevocationStore for interceptor tests
+type mockRevocationStore struct {
+	revoked map[string]bool
+}
+
+func newMockRevocationStore() *mockRevocationStore {
+	return &mockRevocationStore{revoked: make(map[string]bool)}
+}
+
+func (m *mockRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	m.revoked[tokenID] = true
+	return nil
+}
+
+func (m *mockRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	m.revoked[sessionID] = true
+	return nil
+}
+
+func (m *mockRevocationStore) RevokeAllBefore(ctx context.Context, userID string, before time.Time) error {
+	return nil
+}
+
+func (m *mockRevocationStore) IsRevoked(ctx context.Context, claims *auth.Claims) (bool, error) {
+	return m.revoked[claims.ID] || m.revoked[claims.SessionID], nil
+}
+
 func TestAuthInterceptor(t *testing.T) {
 	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
-	interceptor := AuthInterceptor(jwtMgr)
+	revocations := newMockRevocationStore()
+	interceptor := AuthInterceptor(jwtMgr, revocations, nil)
 
 	tests := []struct {
 		name       string
@@ -94,6 +122,21 @@ func TestAuthInterceptor(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:       "revoked token",
+			fullMethod: "/todo.v1.TodoService/CreateTodo",
+			setupCtx: func() context.Context {
+				token, _ := jwtMgr.Generate("user-123", "testuser", "test@example.com")
+				claims, _ := jwtMgr.Validate(token)
+				_ = revocations.Revoke(context.Background(), claims.ID, claims.ExpiresAt.Time)
+				md := metadata.New(map[string]string{
+					"authorization": "Bearer " + token,
+				})
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			wantErr:   true,
+			errorCode: codes.Unauthenticated,
+		},
 	}
 
 	for _, tt := range tests {
@@ -133,6 +176,54 @@ func TestAuthInterceptor(t *testing.T) {
 	}
 }
 
+// mockServerStream is a minimal grpc.ServerStream for interceptor tests
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
+	interceptor := AuthStreamInterceptor(jwtMgr, newMockRevocationStore(), nil)
+
+	t.Run("missing metadata", func(t *testing.T) {
+		stream := &mockServerStream{ctx: context.Background()}
+		info := &grpc.StreamServerInfo{FullMethod: "/todo.v1.MediaService/UploadMedia"}
+
+		err := interceptor(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("error code = %v, want %v", status.Code(err), codes.Unauthenticated)
+		}
+	})
+
+	t.Run("valid token sets user in stream context", func(t *testing.T) {
+		token, _ := jwtMgr.Generate("user-123", "testuser", "test@example.com")
+		md := metadata.New(map[string]string{
+			"authorization": "Bearer " + token,
+		})
+		stream := &mockServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+		info := &grpc.StreamServerInfo{FullMethod: "/todo.v1.MediaService/UploadMedia"}
+
+		var gotUserID string
+		err := interceptor(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+			gotUserID, _ = GetUserIDFromContext(stream.Context())
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotUserID != "user-123" {
+			t.Errorf("user ID = %v, want %v", gotUserID, "user-123")
+		}
+	})
+}
+
 func TestGetUserIDFromContext(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -295,6 +386,16 @@ func TestShouldSkipAuth(t *testing.T) {
 			method:     "/todo.v1.SystemService/HealthCheck",
 			wantResult: true,
 		},
+		{
+			name:       "refresh token method",
+			method:     "/todo.v1.AuthService/RefreshToken",
+			wantResult: true,
+		},
+		{
+			name:       "confirm password reset method",
+			method:     "/todo.v1.AuthService/ConfirmPasswordReset",
+			wantResult: true,
+		},
 		{
 			name:       "other method",
 			method:     "/todo.v1.TodoService/CreateTodo",
@@ -316,3 +417,55 @@ func TestShouldSkipAuth(t *testing.T) {
 		})
 	}
 }
+
+// mockTokenVerifier accepts a single personal access token for interceptor tests
+type mockTokenVerifier struct {
+	token  string
+	scopes []string
+}
+
+func (m *mockTokenVerifier) VerifyPersonalAccessToken(ctx context.Context, token string) (*auth.Claims, []string, error) {
+	if token != m.token {
+		return nil, nil, status.Error(codes.Unauthenticated, "invalid personal access token")
+	}
+	return &auth.Claims{UserID: "user-123", Username: "testuser"}, m.scopes, nil
+}
+
+func TestAuthInterceptor_PersonalAccessToken(t *testing.T) {
+	jwtMgr := auth.NewJWTManager("test-secret", 24*time.Hour)
+	verifier := &mockTokenVerifier{token: "tdp_valid", scopes: []string{auth.ScopeTODOsRead}}
+	interceptor := AuthInterceptor(jwtMgr, newMockRevocationStore(), verifier)
+	info := &grpc.UnaryServerInfo{FullMethod: "/todo.v1.TODOService/ListTODOs"}
+
+	withToken := func(token string) context.Context {
+		md := metadata.New(map[string]string{"authorization": "Bearer " + token})
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	var gotScopes []string
+	var restricted bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		gotScopes, restricted = GetScopesFromContext(ctx)
+		return "success", nil
+	}
+
+	if _, err := interceptor(withToken("tdp_valid"), "test-request", info, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !restricted || len(gotScopes) != 1 || gotScopes[0] != auth.ScopeTODOsRead {
+		t.Errorf("scopes = %v (restricted %v), want [%s]", gotScopes, restricted, auth.ScopeTODOsRead)
+	}
+
+	if _, err := interceptor(withToken("tdp_unknown"), "test-request", info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated for unknown token, got %v", err)
+	}
+
+	// Session tokens are not restricted by scopes
+	token, _ := jwtMgr.Generate("user-123", "testuser", "test@example.com")
+	if _, err := interceptor(withToken(token), "test-request", info, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restricted {
+		t.Error("expected session token to be unrestricted")
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
 	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
 )
 
 // Permission represents the required permission for a method
@@ -19,6 +21,9 @@ const (
 	PermissionView  = "view"
 	PermissionEdit  = "edit"
 	PermissionAdmin = "admin"
+	// PermissionSystemAdmin is held by system administrators rather than
+	// granted by a team role
+	PermissionSystemAdmin = "system_admin"
 )
 
 // MethodPermission defines the required permission for each gRPC method
@@ -28,13 +33,18 @@ type MethodPermission struct {
 }
 
 // AuthorizationInterceptor creates a gRPC interceptor for authorization
-func AuthorizationInterceptor(teamRepo domain.TeamRepository) grpc.UnaryServerInterceptor {
+func AuthorizationInterceptor(teamRepo domain.TeamRepository, userRepo domain.UserRepository) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
+		// Personal access tokens may only call methods their scopes allow
+		if err := checkTokenScopes(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
 		// Skip authorization for public methods
 		if shouldSkipAuthorization(info.FullMethod) {
 			return handler(ctx, req)
@@ -53,6 +63,13 @@ func AuthorizationInterceptor(teamRepo domain.TeamRepository) grpc.UnaryServerIn
 			return handler(ctx, req)
 		}
 
+		if requiredPermission == PermissionSystemAdmin {
+			if err := checkSystemAdmin(ctx, userID, userRepo); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
 		// Extract resource ID from request if needed
 		resourceID, teamID := extractResourceInfo(req, info.FullMethod)
 
@@ -65,6 +82,33 @@ func AuthorizationInterceptor(teamRepo domain.TeamRepository) grpc.UnaryServerIn
 	}
 }
 
+// AuthorizationStreamInterceptor creates a gRPC stream interceptor for authorization.
+// Streaming requests are only available after the handler starts receiving, so
+// resource-level checks are left to the handler; this interceptor only ensures
+// the caller is authenticated.
+func AuthorizationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkTokenScopes(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		if shouldSkipAuthorization(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		if _, err := GetUserIDFromContext(stream.Context()); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
 // shouldSkipAuthorization determines if authorization should be skipped for a method
 func shouldSkipAuthorization(method string) bool {
 	skipMethods := []string{
@@ -72,6 +116,9 @@ func shouldSkipAuthorization(method string) bool {
 		"/todo.v1.AuthService/Login",
 		"/todo.v1.AuthService/Logout",
 		"/todo.v1.AuthService/RefreshToken",
+		"/todo.v1.AuthService/VerifyEmail",
+		"/todo.v1.AuthService/RequestPasswordReset",
+		"/todo.v1.AuthService/ConfirmPasswordReset",
 		"/todo.v1.SystemService/HealthCheck",
 		"/todo.v1.TODOService/GetProfile",
 	}
@@ -87,11 +134,44 @@ func shouldSkipAuthorization(method string) bool {
 func getRequiredPermission(method string) string {
 	methodPermissions := map[string]string{
 		// TODO operations
-		"/todo.v1.TODOService/CreateTODO": PermissionEdit,
-		"/todo.v1.TODOService/GetTODO":    PermissionView,
-		"/todo.v1.TODOService/UpdateTODO": PermissionEdit,
-		"/todo.v1.TODOService/DeleteTODO": PermissionEdit,
-		"/todo.v1.TODOService/ListTODOs":  PermissionView,
+		"/todo.v1.TODOService/CreateTODO":           PermissionEdit,
+		"/todo.v1.TODOService/GetTODO":              PermissionView,
+		"/todo.v1.TODOService/UpdateTODO":           PermissionEdit,
+		"/todo.v1.TODOService/BulkUpdateTODOs":      PermissionEdit,
+		"/todo.v1.TODOService/PatchTODO":            PermissionEdit,
+		"/todo.v1.TODOService/DeleteTODO":           PermissionEdit,
+		"/todo.v1.TODOService/ListTODOs":            PermissionView,
+		"/todo.v1.TODOService/BulkUpdateStatus":     PermissionEdit,
+		"/todo.v1.TODOService/BulkDelete":           PermissionEdit,
+		"/todo.v1.TODOService/MoveTODO":             PermissionEdit,
+		"/todo.v1.TODOService/ReorderTODO":          PermissionEdit,
+		"/todo.v1.TODOService/CompleteTODO":         PermissionEdit,
+		"/todo.v1.TODOService/ReopenTODO":           PermissionEdit,
+		"/todo.v1.TODOService/SkipOccurrence":       PermissionEdit,
+		"/todo.v1.TODOService/EndRecurrence":        PermissionEdit,
+		"/todo.v1.TODOService/ListTODORevisions":    PermissionView,
+		"/todo.v1.TODOService/DiffTODORevisions":    PermissionView,
+		"/todo.v1.TODOService/RestoreTODORevision":  PermissionEdit,
+		"/todo.v1.TODOService/ListTrash":            PermissionView,
+		"/todo.v1.TODOService/RestoreTODO":          PermissionEdit,
+		"/todo.v1.TODOService/PurgeTODO":            PermissionEdit,
+		"/todo.v1.TODOService/AddTODODependency":    PermissionEdit,
+		"/todo.v1.TODOService/RemoveTODODependency": PermissionEdit,
+		"/todo.v1.TODOService/ListTODODependencies": PermissionView,
+		"/todo.v1.TODOService/GetTODOTree":          PermissionView,
+
+		// Reminder operations
+		"/todo.v1.ReminderService/CreateReminder":  PermissionEdit,
+		"/todo.v1.ReminderService/ListReminders":   PermissionView,
+		"/todo.v1.ReminderService/SnoozeReminder":  PermissionEdit,
+		"/todo.v1.ReminderService/DismissReminder": PermissionEdit,
+		"/todo.v1.ReminderService/DeleteReminder":  PermissionEdit,
+
+		// Comment operations
+		"/todo.v1.CommentService/CreateComment": PermissionEdit,
+		"/todo.v1.CommentService/ListComments":  PermissionView,
+		"/todo.v1.CommentService/UpdateComment": PermissionEdit,
+		"/todo.v1.CommentService/DeleteComment": PermissionEdit,
 
 		// Team operations
 		"/todo.v1.TeamService/CreateTeam":       PermissionAdmin,
@@ -100,21 +180,95 @@ func getRequiredPermission(method string) string {
 		"/todo.v1.TeamService/DeleteTeam":       PermissionAdmin,
 		"/todo.v1.TeamService/ListTeams":        PermissionView,
 		"/todo.v1.TeamService/AddTeamMember":    PermissionAdmin,
+		"/todo.v1.TeamService/UpdateTeamMember": PermissionAdmin,
 		"/todo.v1.TeamService/RemoveTeamMember": PermissionAdmin,
+		"/todo.v1.TeamService/ListTeamMembers":  PermissionView,
 
 		// Media operations
-		"/todo.v1.MediaService/UploadMedia": PermissionEdit,
-		"/todo.v1.MediaService/GetMedia":    PermissionView,
-		"/todo.v1.MediaService/DeleteMedia": PermissionEdit,
+		"/todo.v1.MediaService/UploadMedia":       PermissionEdit,
+		"/todo.v1.MediaService/GenerateUploadURL": PermissionEdit,
+		"/todo.v1.MediaService/GetMedia":          PermissionView,
+		"/todo.v1.MediaService/ListMedia":         PermissionView,
+		"/todo.v1.MediaService/DeleteMedia":       PermissionEdit,
 
 		// Real-time operations
-		"/todo.v1.RealtimeService/Subscribe":    PermissionView,
-		"/todo.v1.RealtimeService/PublishEvent": PermissionEdit,
+		"/todo.v1.RealtimeService/Subscribe":      PermissionView,
+		"/todo.v1.RealtimeService/PublishEvent":   PermissionEdit,
+		"/todo.v1.RealtimeService/ListActivities": PermissionView,
+		"/todo.v1.RealtimeService/GetOnlineUsers": PermissionView,
+		"/todo.v1.RealtimeService/Heartbeat":      PermissionView,
+
+		// User administration
+		"/todo.v1.UserAdminService/ListUsers":          PermissionSystemAdmin,
+		"/todo.v1.UserAdminService/UnlockUser":         PermissionSystemAdmin,
+		"/todo.v1.UserAdminService/DeactivateUser":     PermissionSystemAdmin,
+		"/todo.v1.UserAdminService/ReactivateUser":     PermissionSystemAdmin,
+		"/todo.v1.UserAdminService/ForcePasswordReset": PermissionSystemAdmin,
+		"/todo.v1.UserAdminService/DeleteUser":         PermissionSystemAdmin,
 	}
 
 	return methodPermissions[method]
 }
 
+// checkTokenScopes checks that a request authenticated with a personal access
+// token has a scope covering the method's required permission. Methods
+// without a required permission, such as account management, are closed to
+// personal access tokens unless they are explicitly allowed.
+func checkTokenScopes(ctx context.Context, method string) error {
+	scopes, restricted := GetScopesFromContext(ctx)
+	if !restricted {
+		return nil
+	}
+
+	if tokenAllowedMethods[method] {
+		return nil
+	}
+
+	required := getRequiredScope(method)
+	if required == "" {
+		return status.Error(codes.PermissionDenied, "personal access tokens cannot call this method")
+	}
+
+	if !auth.HasScope(scopes, required) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("token is missing the %s scope", required))
+	}
+
+	return nil
+}
+
+// tokenAllowedMethods are open to personal access tokens regardless of scope
+var tokenAllowedMethods = map[string]bool{
+	"/todo.v1.AuthService/GetProfile": true,
+}
+
+// getRequiredScope returns the token scope that grants a method's required
+// permission, or "" if no scope does
+func getRequiredScope(method string) string {
+	var resource string
+	switch {
+	case strings.HasPrefix(method, "/todo.v1.TODOService/"), strings.HasPrefix(method, "/todo.v1.RealtimeService/"),
+		strings.HasPrefix(method, "/todo.v1.ReminderService/"), strings.HasPrefix(method, "/todo.v1.CommentService/"):
+		resource = "todos"
+	case strings.HasPrefix(method, "/todo.v1.TeamService/"):
+		resource = "teams"
+	case strings.HasPrefix(method, "/todo.v1.MediaService/"):
+		resource = "media"
+	default:
+		return ""
+	}
+
+	switch getRequiredPermission(method) {
+	case PermissionView:
+		return resource + ":" + auth.ScopeLevelRead
+	case PermissionEdit:
+		return resource + ":" + auth.ScopeLevelWrite
+	case PermissionAdmin:
+		return resource + ":" + auth.ScopeLevelAdmin
+	default:
+		return ""
+	}
+}
+
 // extractResourceInfo extracts resource ID and team ID from request
 func extractResourceInfo(req interface{}, method string) (resourceID, teamID string) {
 	// Use reflection to extract resource IDs from request objects
@@ -153,6 +307,8 @@ func extractResourceInfo(req interface{}, method string) (resourceID, teamID str
 		resourceID = req.Id
 	case *todov1.UpdateTODORequest:
 		resourceID = req.Id
+	case *todov1.PatchTODORequest:
+		resourceID = req.GetTodo().GetId()
 	case *todov1.DeleteTODORequest:
 		resourceID = req.Id
 	}
@@ -192,6 +348,20 @@ func checkAuthorization(ctx context.Context, userID, resourceID, teamID, require
 	return status.Error(codes.PermissionDenied, "access denied")
 }
 
+// checkSystemAdmin checks that the user is a system administrator. The flag
+// is read from the database on every call so that revoking it takes effect
+// immediately rather than when the user's tokens expire.
+func checkSystemAdmin(ctx context.Context, userID string, userRepo domain.UserRepository) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+	if !user.IsAdmin {
+		return status.Error(codes.PermissionDenied, "system administrator access required")
+	}
+	return nil
+}
+
 // hasPermission checks if a role has the required permission
 func hasPermission(role commonv1.Role, requiredPermission string) bool {
 	rolePermissions := map[commonv1.Role][]string{
@@ -3,10 +3,12 @@ package middleware
 import (
 	"context"
 	"testing"
+	"time"
 
 	commonv1 "github.com/venslupro/todo-api/api/gen/common/v1"
 	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
 	"github.com/venslupro/todo-api/internal/domain"
+	"github.com/venslupro/todo-api/internal/pkg/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -100,9 +102,70 @@ func (m *MockTeamRepository) GetSharedTeams(ctx context.Context, todoID string)
 	return nil, nil
 }
 
+// MockUserRepository is a mock implementation of UserRepository for testing
+type MockUserRepository struct {
+	users map[string]*domain.User
+}
+
+func NewMockUserRepository() *MockUserRepository {
+	return &MockUserRepository{
+		users: make(map[string]*domain.User),
+	}
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *domain.User) error {
+	m.users[user.ID] = user
+	return nil
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return user, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
+	m.users[user.ID] = user
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id string) error {
+	delete(m.users, id)
+	return nil
+}
+
+func (m *MockUserRepository) List(ctx context.Context, options domain.UserListOptions) ([]*domain.User, *domain.PaginationResult, error) {
+	return nil, &domain.PaginationResult{}, nil
+}
+
+func (m *MockUserRepository) ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+
 func TestAuthorizationInterceptor(t *testing.T) {
 	teamRepo := NewMockTeamRepository()
-	interceptor := AuthorizationInterceptor(teamRepo)
+	userRepo := NewMockUserRepository()
+	userRepo.users["admin-1"] = &domain.User{ID: "admin-1", IsAdmin: true}
+	userRepo.users["user-123"] = &domain.User{ID: "user-123"}
+	interceptor := AuthorizationInterceptor(teamRepo, userRepo)
 
 	tests := []struct {
 		name       string
@@ -140,6 +203,82 @@ func TestAuthorizationInterceptor(t *testing.T) {
 			setupReq:   func() interface{} { return &todov1.CreateTODORequest{Title: "Test TODO"} },
 			wantErr:    false,
 		},
+		{
+			name:       "personal access token with required scope",
+			fullMethod: "/todo.v1.TODOService/CreateTODO",
+			setupCtx:   func() context.Context { return tokenContext(auth.ScopeTODOsWrite) },
+			setupReq:   func() interface{} { return &todov1.CreateTODORequest{Title: "Test TODO"} },
+			wantErr:    false,
+		},
+		{
+			name:       "personal access token missing scope",
+			fullMethod: "/todo.v1.TODOService/CreateTODO",
+			setupCtx:   func() context.Context { return tokenContext(auth.ScopeTODOsRead) },
+			setupReq:   func() interface{} { return &todov1.CreateTODORequest{Title: "Test TODO"} },
+			wantErr:    true,
+			errorCode:  codes.PermissionDenied,
+		},
+		{
+			name:       "personal access token calling account management",
+			fullMethod: "/todo.v1.AuthService/ChangePassword",
+			setupCtx:   func() context.Context { return tokenContext(auth.ScopeTODOsWrite, auth.ScopeTeamsAdmin) },
+			setupReq:   func() interface{} { return nil },
+			wantErr:    true,
+			errorCode:  codes.PermissionDenied,
+		},
+		{
+			name:       "personal access token calling logout",
+			fullMethod: "/todo.v1.AuthService/Logout",
+			setupCtx:   func() context.Context { return tokenContext(auth.ScopeTODOsWrite) },
+			setupReq:   func() interface{} { return nil },
+			wantErr:    true,
+			errorCode:  codes.PermissionDenied,
+		},
+		{
+			name:       "personal access token reading profile",
+			fullMethod: "/todo.v1.AuthService/GetProfile",
+			setupCtx:   func() context.Context { return tokenContext(auth.ScopeMediaRead) },
+			setupReq:   func() interface{} { return nil },
+			wantErr:    false,
+		},
+		{
+			name:       "system administrator unlocking a user",
+			fullMethod: "/todo.v1.UserAdminService/UnlockUser",
+			setupCtx:   func() context.Context { return context.WithValue(context.Background(), UserIDKey, "admin-1") },
+			setupReq:   func() interface{} { return &todov1.UnlockUserRequest{UserId: "user-123"} },
+			wantErr:    false,
+		},
+		{
+			name:       "regular user unlocking a user",
+			fullMethod: "/todo.v1.UserAdminService/UnlockUser",
+			setupCtx:   func() context.Context { return context.WithValue(context.Background(), UserIDKey, "user-123") },
+			setupReq:   func() interface{} { return &todov1.UnlockUserRequest{UserId: "user-123"} },
+			wantErr:    true,
+			errorCode:  codes.PermissionDenied,
+		},
+		{
+			name:       "personal access token unlocking a user",
+			fullMethod: "/todo.v1.UserAdminService/UnlockUser",
+			setupCtx:   func() context.Context { return tokenContext(auth.ScopeTeamsAdmin) },
+			setupReq:   func() interface{} { return &todov1.UnlockUserRequest{UserId: "user-123"} },
+			wantErr:    true,
+			errorCode:  codes.PermissionDenied,
+		},
+		{
+			name:       "system administrator listing users",
+			fullMethod: "/todo.v1.UserAdminService/ListUsers",
+			setupCtx:   func() context.Context { return context.WithValue(context.Background(), UserIDKey, "admin-1") },
+			setupReq:   func() interface{} { return &todov1.ListUsersRequest{} },
+			wantErr:    false,
+		},
+		{
+			name:       "regular user deleting a user",
+			fullMethod: "/todo.v1.UserAdminService/DeleteUser",
+			setupCtx:   func() context.Context { return context.WithValue(context.Background(), UserIDKey, "user-123") },
+			setupReq:   func() interface{} { return &todov1.DeleteUserRequest{UserId: "admin-1"} },
+			wantErr:    true,
+			errorCode:  codes.PermissionDenied,
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,6 +319,48 @@ func TestAuthorizationInterceptor(t *testing.T) {
 	}
 }
 
+// tokenContext returns the context of a request authenticated with a
+// personal access token carrying the given scopes
+func tokenContext(scopes ...string) context.Context {
+	ctx := context.WithValue(context.Background(), UserIDKey, "user-123")
+	return context.WithValue(ctx, ScopesKey, scopes)
+}
+
+func TestGetRequiredScope(t *testing.T) {
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{method: "/todo.v1.TODOService/ListTODOs", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.TODOService/BulkDelete", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TODOService/DiffTODORevisions", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.TODOService/RestoreTODORevision", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TODOService/ListTrash", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.TODOService/PurgeTODO", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TODOService/ListTODODependencies", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.TODOService/AddTODODependency", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TODOService/GetTODOTree", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.TODOService/ReorderTODO", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TODOService/PatchTODO", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TODOService/BulkUpdateTODOs", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.TeamService/AddTeamMember", want: auth.ScopeTeamsAdmin},
+		{method: "/todo.v1.MediaService/UploadMedia", want: auth.ScopeMediaWrite},
+		{method: "/todo.v1.RealtimeService/Subscribe", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.ReminderService/SnoozeReminder", want: auth.ScopeTODOsWrite},
+		{method: "/todo.v1.CommentService/ListComments", want: auth.ScopeTODOsRead},
+		{method: "/todo.v1.AuthService/CreatePersonalAccessToken", want: ""},
+		{method: "/todo.v1.SystemService/ExportData", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			if got := getRequiredScope(tt.method); got != tt.want {
+				t.Errorf("getRequiredScope(%v) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestShouldSkipAuthorization(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/venslupro/todo-api/internal/pkg/idempotency"
+)
+
+const (
+	// IdempotencyKeyHeader is the header key for the idempotency key of a request
+	IdempotencyKeyHeader = "idempotency-key"
+	// IdempotentReplayedHeader is the response header key set when a stored
+	// response is replayed
+	IdempotentReplayedHeader = "idempotent-replayed"
+)
+
+// idempotentMethods lists the methods that honor idempotency keys: those
+// that create TODOs or change many of them, which clients cannot tell apart
+// from a failed call when the response is lost
+var idempotentMethods = map[string]bool{
+	"/todo.v1.TODOService/CreateTODO":       true,
+	"/todo.v1.TODOService/BulkUpdateStatus": true,
+	"/todo.v1.TODOService/BulkDelete":       true,
+	"/todo.v1.TODOService/BulkUpdateTODOs":  true,
+	"/todo.v1.TODOService/MoveTODO":         true,
+}
+
+// IdempotencyInterceptor creates a gRPC interceptor that executes requests
+// carrying an idempotency key once. The response is stored for ttl and
+// replayed for a repeated request with the same key; reusing the key for a
+// different request fails with ALREADY_EXISTS. Failed requests are not
+// stored, so they can be retried with the same key. It must run after
+// authentication, since keys are scoped to the user.
+func IdempotencyInterceptor(store idempotency.Store, ttl time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		key := extractIdempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > idempotency.MaxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", idempotency.MaxKeyLength)
+		}
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		fingerprint, err := idempotency.Fingerprint(info.FullMethod, message)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to fingerprint request: %v", err)
+		}
+
+		userID, _ := GetUserIDFromContext(ctx)
+		storeKey := userID + ":" + key
+
+		existing, err := store.Reserve(ctx, storeKey, &idempotency.Record{Fingerprint: fingerprint}, ttl)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to check idempotency key: %v", err)
+		}
+		if existing != nil {
+			return replayResponse(ctx, existing, fingerprint)
+		}
+
+		resp, err := handler(ctx, req)
+		// Store the outcome even if the client has gone away, since a retry
+		// is what the key is for
+		storeCtx := context.WithoutCancel(ctx)
+		if err != nil {
+			if releaseErr := store.Release(storeCtx, storeKey); releaseErr != nil {
+				log.Printf("Failed to release idempotency key of %s: %v", info.FullMethod, releaseErr)
+			}
+			return nil, err
+		}
+
+		record := &idempotency.Record{Fingerprint: fingerprint}
+		if record.Response, err = idempotency.EncodeResponse(resp.(proto.Message)); err == nil {
+			err = store.Save(storeCtx, storeKey, record, ttl)
+		}
+		if err != nil {
+			// The request succeeded; a retry would now execute it again
+			log.Printf("Failed to store response of %s for its idempotency key: %v", info.FullMethod, err)
+			if releaseErr := store.Release(storeCtx, storeKey); releaseErr != nil {
+				log.Printf("Failed to release idempotency key of %s: %v", info.FullMethod, releaseErr)
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+// replayResponse returns the response stored for a repeated request
+func replayResponse(ctx context.Context, record *idempotency.Record, fingerprint string) (interface{}, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, status.Error(codes.AlreadyExists, "idempotency key was already used for a different request")
+	}
+	if len(record.Response) == 0 {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	resp, err := idempotency.DecodeResponse(record.Response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay response: %v", err)
+	}
+
+	// Outside of a gRPC call there is nowhere to send the header
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedHeader, "true"))
+	return resp, nil
+}
+
+// extractIdempotencyKey extracts the idempotency key from the request metadata
+func extractIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	todov1 "github.com/venslupro/todo-api/api/gen/todo/v1"
+	"github.com/venslupro/todo-api/internal/pkg/idempotency"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockIdempotencyStore is an in-memory idempotency.Store for interceptor tests
+type mockIdempotencyStore struct {
+	records map[string]*idempotency.Record
+}
+
+func newMockIdempotencyStore() *mockIdempotencyStore {
+	return &mockIdempotencyStore{records: make(map[string]*idempotency.Record)}
+}
+
+func (m *mockIdempotencyStore) Reserve(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) (*idempotency.Record, error) {
+	if existing, ok := m.records[key]; ok {
+		return existing, nil
+	}
+	m.records[key] = record
+	return nil, nil
+}
+
+func (m *mockIdempotencyStore) Save(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) error {
+	m.records[key] = record
+	return nil
+}
+
+func (m *mockIdempotencyStore) Release(ctx context.Context, key string) error {
+	delete(m.records, key)
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	store := newMockIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour)
+	info := &grpc.UnaryServerInfo{FullMethod: "/todo.v1.TODOService/CreateTODO"}
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		title := req.(*todov1.CreateTODORequest).Title
+		if title == "fail" {
+			return nil, status.Error(codes.Internal, "failed")
+		}
+		return &todov1.CreateTODOResponse{Todo: &todov1.TODO{Id: "todo-1", Title: title}}, nil
+	}
+
+	withKey := func(userID, key string) context.Context {
+		ctx := context.WithValue(context.Background(), UserIDKey, userID)
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, key))
+	}
+
+	// The first request is executed and a retry replays its response
+	for i := 0; i < 2; i++ {
+		resp, err := interceptor(withKey("user-123", "key-1"), &todov1.CreateTODORequest{Title: "Buy milk"}, info, handler)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if todo := resp.(*todov1.CreateTODOResponse).Todo; todo.Id != "todo-1" || todo.Title != "Buy milk" {
+			t.Errorf("Expected the stored response, got %v", todo)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the request to be executed once, got %d", calls)
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		method    string
+		req       *todov1.CreateTODORequest
+		wantCode  codes.Code
+		wantCalls int
+	}{
+		{"different payload", withKey("user-123", "key-1"), info.FullMethod, &todov1.CreateTODORequest{Title: "Buy bread"}, codes.AlreadyExists, 0},
+		{"different method", withKey("user-123", "key-1"), "/todo.v1.TODOService/MoveTODO", &todov1.CreateTODORequest{Title: "Buy milk"}, codes.AlreadyExists, 0},
+		{"other user", withKey("user-456", "key-1"), info.FullMethod, &todov1.CreateTODORequest{Title: "Buy milk"}, codes.OK, 1},
+		{"no key", context.Background(), info.FullMethod, &todov1.CreateTODORequest{Title: "Buy milk"}, codes.OK, 1},
+		{"method without keys", withKey("user-123", "key-1"), "/todo.v1.TODOService/UpdateTODO", &todov1.CreateTODORequest{Title: "Buy bread"}, codes.OK, 1},
+		{"failed request", withKey("user-123", "key-2"), info.FullMethod, &todov1.CreateTODORequest{Title: "fail"}, codes.Internal, 1},
+		{"retried failed request", withKey("user-123", "key-2"), info.FullMethod, &todov1.CreateTODORequest{Title: "fail"}, codes.Internal, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			_, err := interceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("Expected %v, got %v", tt.wantCode, err)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Expected %d handler calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestIdempotencyInterceptor_InProgress(t *testing.T) {
+	store := newMockIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour)
+	info := &grpc.UnaryServerInfo{FullMethod: "/todo.v1.TODOService/BulkDelete"}
+	req := &todov1.BulkDeleteRequest{Ids: []string{"todo-1"}}
+	ctx := metadata.NewIncomingContext(context.WithValue(context.Background(), UserIDKey, "user-123"),
+		metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+
+	// The retry arrives while the first request is still being executed
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, err := interceptor(ctx, req, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, errors.New("executed twice")
+		})
+		if status.Code(err) != codes.Aborted {
+			t.Errorf("Expected Aborted for a request in progress, got %v", err)
+		}
+		return &todov1.BulkDeleteResponse{}, nil
+	}
+
+	if _, err := interceptor(ctx, req, info, handler); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+// Package orderkey implements fractional order keys for manually ordered
+// lists. Keys are strings of base-62 digits compared byte by byte, and a new
+// key can always be made between two others, so placing an item never
+// requires renumbering its neighbours.
+package orderkey
+
+import (
+	"errors"
+	"strings"
+)
+
+// digits are the base-62 digits in ascending byte order
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// MaxLength is the length beyond which keys should be rebalanced with
+// Spread. Repeatedly placing items at the same spot makes keys grow by about
+// one digit every six placements.
+const MaxLength = 24
+
+// ErrInvalidKey is returned for keys that contain characters other than
+// base-62 digits or end with the digit 0, and for bounds that are not in
+// ascending order
+var ErrInvalidKey = errors.New("invalid order key")
+
+// Between returns a key that sorts after a and before b. An empty a means
+// no lower bound and an empty b means no upper bound.
+func Between(a, b string) (string, error) {
+	if !valid(a) || !valid(b) || (a != "" && b != "" && a >= b) {
+		return "", ErrInvalidKey
+	}
+	return midpoint(a, b), nil
+}
+
+// Spread returns n keys in ascending order, evenly spaced and as short as
+// possible, for rebalancing a list of n items
+func Spread(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	// The smallest width with at least n+1 gaps between 0 and base^width
+	width, space := 1, uint64(len(digits))
+	for space < uint64(n)+1 {
+		width++
+		space *= uint64(len(digits))
+	}
+	step := space / (uint64(n) + 1)
+
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = encode(uint64(i+1)*step, width)
+	}
+	return keys
+}
+
+// midpoint returns a key between a and b, which are valid and ascending
+func midpoint(a, b string) string {
+	if b != "" {
+		// Keep the common prefix, treating a as padded with zeros
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	// The first digits differ
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(digits, a[0])
+	}
+	digitB := len(digits)
+	if b != "" {
+		digitB = strings.IndexByte(digits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2])
+	}
+
+	// The first digits are consecutive: a longer b can be cut short, otherwise
+	// extend a
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(digits[digitA]) + midpoint(rest, "")
+}
+
+// digitAt returns the digit of key at i, or 0 past its end
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
+
+// valid reports whether key is empty or a valid order key
+func valid(key string) bool {
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return false
+		}
+	}
+	return key == "" || key[len(key)-1] != digits[0]
+}
+
+// encode writes value as width base-62 digits without trailing zeros, which
+// keeps the order of equally wide values
+func encode(value uint64, width int) string {
+	key := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		key[i] = digits[value%uint64(len(digits))]
+		value /= uint64(len(digits))
+	}
+	return strings.TrimRight(string(key), digits[:1])
+}
@@ -0,0 +1,90 @@
+package orderkey
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+		err  error
+	}{
+		{"empty list", "", "", "V", nil},
+		{"after", "V", "", "l", nil},
+		{"before", "", "V", "G", nil},
+		{"wide gap", "A", "C", "B", nil},
+		{"consecutive digits", "A", "B", "AV", nil},
+		{"common prefix", "AB", "AC", "ABV", nil},
+		{"longer upper bound", "A", "B5", "B", nil},
+		{"after last digit", "z", "", "zV", nil},
+		{"before first digit", "", "01", "00V", nil},
+		{"equal bounds", "A", "A", "", ErrInvalidKey},
+		{"descending bounds", "B", "A", "", ErrInvalidKey},
+		{"trailing zero", "A0", "", "", ErrInvalidKey},
+		{"invalid character", "A-", "", "", ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Between(%q, %q) error = %v, want %v", tt.a, tt.b, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Between(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBetween_RandomInsertions(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var keys []string
+	for i := 0; i < 1000; i++ {
+		at := rng.Intn(len(keys) + 1)
+		var a, b string
+		if at > 0 {
+			a = keys[at-1]
+		}
+		if at < len(keys) {
+			b = keys[at]
+		}
+
+		key, err := Between(a, b)
+		if err != nil {
+			t.Fatalf("Between(%q, %q) returned error: %v", a, b, err)
+		}
+		if (a != "" && key <= a) || (b != "" && key >= b) {
+			t.Fatalf("Between(%q, %q) = %q, which is out of order", a, b, key)
+		}
+		keys = append(keys[:at], append([]string{key}, keys[at:]...)...)
+	}
+}
+
+func TestSpread(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 61, 62, 5000} {
+		keys := Spread(n)
+		if len(keys) != n {
+			t.Fatalf("Spread(%d) returned %d keys", n, len(keys))
+		}
+		if !sort.StringsAreSorted(keys) {
+			t.Errorf("Spread(%d) keys are not sorted", n)
+		}
+		for i, key := range keys {
+			if !valid(key) || key == "" {
+				t.Errorf("Spread(%d) key %q is invalid", n, key)
+			}
+			if i > 0 && keys[i-1] == key {
+				t.Errorf("Spread(%d) returned duplicate key %q", n, key)
+			}
+		}
+	}
+
+	if keys := Spread(1); keys[0] != "V" {
+		t.Errorf("Spread(1) = %q, want the middle key", keys)
+	}
+}
@@ -0,0 +1,339 @@
+// Package recurrence implements the subset of RFC 5545 recurrence rules
+// (RRULE) supported for recurring TODOs: FREQ, INTERVAL, BYDAY, COUNT and
+// UNTIL.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a recurrence rule
+type Frequency string
+
+// Supported frequencies
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// maxIterations bounds the search for the next occurrence, so that rules
+// which rarely match, such as every 5th Friday, cannot loop forever
+const maxIterations = 1000
+
+// Date formats accepted for UNTIL; rules are written with the first one
+const (
+	untilDateTimeUTC = "20060102T150405Z"
+	untilDateTime    = "20060102T150405"
+	untilDate        = "20060102"
+)
+
+// ErrInvalidRule is returned for rules that are malformed or use parts
+// outside of the supported subset
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeekdayNum is an entry of BYDAY. For monthly rules a non-zero N selects
+// the Nth such weekday of the month, counting from the end if negative;
+// otherwise every such weekday is selected.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []WeekdayNum
+	// Count is the total number of occurrences, or 0 if unlimited
+	Count int
+	// Until is the last time an occurrence may fall on, or nil if unlimited
+	Until *time.Time
+}
+
+// Parse parses a recurrence rule such as "FREQ=WEEKLY;BYDAY=MO,TH". The
+// "RRULE:" prefix is optional. UNTIL given as a date includes that whole day
+// in UTC; a date-time without a zone is read as UTC.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+	if s == "" {
+		return nil, fmt.Errorf("%w: rule is empty", ErrInvalidRule)
+	}
+
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s is given more than once", ErrInvalidRule, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq = Frequency(value)
+			switch rule.Freq {
+			case Daily, Weekly, Monthly, Yearly:
+			default:
+				err = fmt.Errorf("unsupported frequency %s", value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(name, value)
+		case "COUNT":
+			rule.Count, err = parsePositive(name, value)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		default:
+			err = fmt.Errorf("unsupported part %s", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot be combined", ErrInvalidRule)
+	}
+	for _, day := range rule.ByDay {
+		if rule.Freq == Yearly {
+			return nil, fmt.Errorf("%w: BYDAY is not supported for yearly rules", ErrInvalidRule)
+		}
+		if day.N != 0 && rule.Freq != Monthly {
+			return nil, fmt.Errorf("%w: numbered BYDAY entries require a monthly rule", ErrInvalidRule)
+		}
+	}
+
+	return rule, nil
+}
+
+func parsePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer", name)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (*time.Time, error) {
+	for _, layout := range []string{untilDateTimeUTC, untilDateTime} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	t, err := time.Parse(untilDate, value)
+	if err != nil {
+		return nil, fmt.Errorf("UNTIL must be a date or a UTC date-time")
+	}
+	t = t.Add(24*time.Hour - time.Second)
+	return &t, nil
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, entry := range strings.Split(value, ",") {
+		if len(entry) < 2 {
+			return nil, fmt.Errorf("malformed BYDAY entry %q", entry)
+		}
+		weekday, ok := weekdayCodes[entry[len(entry)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday in BYDAY entry %q", entry)
+		}
+
+		day := WeekdayNum{Weekday: weekday}
+		if prefix := entry[:len(entry)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("malformed BYDAY entry %q", entry)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// String returns the rule in its canonical form, without the "RRULE:" prefix
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = strings.ToUpper(day.Weekday.String()[:2])
+			if day.N != 0 {
+				days[i] = strconv.Itoa(day.N) + days[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilDateTimeUTC))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the occurrence that follows prev, which is the given
+// occurrence of the series counting from 1. The time of day of prev is kept.
+// It returns false if the series ends with prev.
+func (r *Rule) Next(prev time.Time, occurrence int) (time.Time, bool) {
+	if r.Count > 0 && occurrence >= r.Count {
+		return time.Time{}, false
+	}
+
+	next, ok := r.next(prev)
+	if !ok || (r.Until != nil && next.After(*r.Until)) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+func (r *Rule) next(prev time.Time) (time.Time, bool) {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	switch r.Freq {
+	case Daily:
+		for i := 1; i <= maxIterations; i++ {
+			if t := prev.AddDate(0, 0, i*interval); r.matchesWeekday(t) {
+				return t, true
+			}
+		}
+
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return prev.AddDate(0, 0, 7*interval), true
+		}
+		// Remaining days of the week of prev, then the days of the next week
+		// of the series; weeks start on Monday
+		for d := 1; weekdayIndex(prev)+d < 7; d++ {
+			if t := prev.AddDate(0, 0, d); r.matchesWeekday(t) {
+				return t, true
+			}
+		}
+		weekStart := prev.AddDate(0, 0, 7*interval-weekdayIndex(prev))
+		for d := 0; d < 7; d++ {
+			if t := weekStart.AddDate(0, 0, d); r.matchesWeekday(t) {
+				return t, true
+			}
+		}
+
+	case Monthly:
+		for i := 0; i <= maxIterations; i++ {
+			for _, t := range r.monthlyCandidates(prev, i*interval) {
+				if t.After(prev) {
+					return t, true
+				}
+			}
+		}
+
+	case Yearly:
+		for i := 1; i <= maxIterations; i++ {
+			t := time.Date(prev.Year()+i*interval, prev.Month(), prev.Day(),
+				prev.Hour(), prev.Minute(), prev.Second(), prev.Nanosecond(), prev.Location())
+			// February 29th only occurs in leap years
+			if t.Day() == prev.Day() {
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// monthlyCandidates returns the days, in order, on which a monthly rule
+// occurs in the month that lies the given number of months after prev's
+func (r *Rule) monthlyCandidates(prev time.Time, months int) []time.Time {
+	first := time.Date(prev.Year(), prev.Month()+time.Month(months), 1,
+		prev.Hour(), prev.Minute(), prev.Second(), prev.Nanosecond(), prev.Location())
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+
+	if len(r.ByDay) == 0 {
+		// Months without the day of prev, such as the 31st, are skipped
+		if prev.Day() > daysInMonth {
+			return nil
+		}
+		return []time.Time{first.AddDate(0, 0, prev.Day()-1)}
+	}
+
+	var days []int
+	for _, byDay := range r.ByDay {
+		firstMatch := 1 + (int(byDay.Weekday)-int(first.Weekday())+7)%7
+		var matches []int
+		for day := firstMatch; day <= daysInMonth; day += 7 {
+			matches = append(matches, day)
+		}
+
+		switch {
+		case byDay.N == 0:
+			days = append(days, matches...)
+		case byDay.N > 0 && byDay.N <= len(matches):
+			days = append(days, matches[byDay.N-1])
+		case byDay.N < 0 && -byDay.N <= len(matches):
+			days = append(days, matches[len(matches)+byDay.N])
+		}
+	}
+	sort.Ints(days)
+
+	candidates := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		candidates = append(candidates, first.AddDate(0, 0, day-1))
+	}
+	return candidates
+}
+
+// matchesWeekday reports whether t falls on one of the rule's BYDAY
+// weekdays; rules without BYDAY match every day
+func (r *Rule) matchesWeekday(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, day := range r.ByDay {
+		if day.Weekday == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdayIndex returns the position of t's weekday in a week starting on
+// Monday
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
@@ -0,0 +1,158 @@
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{name: "daily", rule: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "prefix and case", rule: "RRULE:freq=weekly;byday=mo,th", want: "FREQ=WEEKLY;BYDAY=MO,TH"},
+		{name: "interval of one is dropped", rule: "FREQ=MONTHLY;INTERVAL=1", want: "FREQ=MONTHLY"},
+		{name: "numbered weekdays", rule: "FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;COUNT=6", want: "FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;COUNT=6"},
+		{name: "until date", rule: "FREQ=YEARLY;UNTIL=20301231", want: "FREQ=YEARLY;UNTIL=20301231T235959Z"},
+		{name: "until date-time", rule: "FREQ=DAILY;UNTIL=20300101T120000Z", want: "FREQ=DAILY;UNTIL=20300101T120000Z"},
+		{name: "empty", rule: "", wantErr: true},
+		{name: "missing frequency", rule: "INTERVAL=2", wantErr: true},
+		{name: "unsupported frequency", rule: "FREQ=HOURLY", wantErr: true},
+		{name: "unsupported part", rule: "FREQ=MONTHLY;BYMONTHDAY=15", wantErr: true},
+		{name: "duplicate part", rule: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "count and until", rule: "FREQ=DAILY;COUNT=3;UNTIL=20300101", wantErr: true},
+		{name: "unknown weekday", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "numbered weekday in weekly rule", rule: "FREQ=WEEKLY;BYDAY=2MO", wantErr: true},
+		{name: "weekday in yearly rule", rule: "FREQ=YEARLY;BYDAY=MO", wantErr: true},
+		{name: "malformed until", rule: "FREQ=DAILY;UNTIL=tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRule) {
+					t.Fatalf("Parse(%q) error = %v, want ErrInvalidRule", tt.rule, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.rule, err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: date(2026, time.March, 30),
+			want:  []time.Time{date(2026, time.April, 1), date(2026, time.April, 3)},
+		},
+		{
+			name:  "weekdays",
+			rule:  "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start: date(2026, time.October, 15), // Thursday
+			want:  []time.Time{date(2026, time.October, 16), date(2026, time.October, 19)},
+		},
+		{
+			name:  "weekly",
+			rule:  "FREQ=WEEKLY",
+			start: date(2026, time.October, 15),
+			want:  []time.Time{date(2026, time.October, 22), date(2026, time.October, 29)},
+		},
+		{
+			name:  "every other week on Monday and Thursday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			start: date(2026, time.October, 12), // Monday
+			want:  []time.Time{date(2026, time.October, 15), date(2026, time.October, 26), date(2026, time.October, 29)},
+		},
+		{
+			name:  "week starts on Monday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,MO",
+			start: date(2026, time.October, 12), // Monday
+			want:  []time.Time{date(2026, time.October, 18), date(2026, time.October, 26), date(2026, time.November, 1)},
+		},
+		{
+			name:  "monthly skips months without the day",
+			rule:  "FREQ=MONTHLY",
+			start: date(2026, time.January, 31),
+			want:  []time.Time{date(2026, time.March, 31), date(2026, time.May, 31)},
+		},
+		{
+			name:  "first Monday and last Friday",
+			rule:  "FREQ=MONTHLY;BYDAY=1MO,-1FR",
+			start: date(2026, time.October, 5),
+			want:  []time.Time{date(2026, time.October, 30), date(2026, time.November, 2), date(2026, time.November, 27)},
+		},
+		{
+			name:  "quarterly on the second Tuesday",
+			rule:  "FREQ=MONTHLY;INTERVAL=3;BYDAY=2TU",
+			start: date(2026, time.January, 13),
+			want:  []time.Time{date(2026, time.April, 14), date(2026, time.July, 14)},
+		},
+		{
+			name:  "yearly on a leap day",
+			rule:  "FREQ=YEARLY",
+			start: date(2028, time.February, 29),
+			want:  []time.Time{date(2032, time.February, 29)},
+		},
+		{
+			name:  "count",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: date(2026, time.October, 15),
+			want:  []time.Time{date(2026, time.October, 16), date(2026, time.October, 17)},
+		},
+		{
+			name:  "until",
+			rule:  "FREQ=WEEKLY;UNTIL=20261029",
+			start: date(2026, time.October, 15),
+			want:  []time.Time{date(2026, time.October, 22), date(2026, time.October, 29)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.rule, err)
+			}
+
+			prev, occurrence := tt.start, 1
+			for _, want := range tt.want {
+				got, ok := rule.Next(prev, occurrence)
+				if !ok {
+					t.Fatalf("Next(%v) ended the series, want %v", prev, want)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("Next(%v) = %v, want %v", prev, got, want)
+				}
+				prev, occurrence = got, occurrence+1
+			}
+			if rule.Count > 0 || rule.Until != nil {
+				if got, ok := rule.Next(prev, occurrence); ok {
+					t.Errorf("Next(%v) = %v, want the series to end", prev, got)
+				}
+			}
+		})
+	}
+}
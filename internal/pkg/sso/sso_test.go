@@ -0,0 +1,97 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/venslupro/todo-api/internal/pkg/sso/ssotest"
+)
+
+func newTestProvider(t *testing.T, issuer *ssotest.Issuer) *Provider {
+	t.Helper()
+	provider, err := NewProvider(context.Background(), ProviderConfig{
+		Name:         "corp",
+		IssuerURL:    issuer.URL,
+		ClientID:     issuer.ClientID,
+		ClientSecret: issuer.ClientSecret,
+		RedirectURL:  "https://app.example.com/sso/callback",
+	})
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	return provider
+}
+
+func TestProvider_AuthorizationCodeFlow(t *testing.T) {
+	ctx := context.Background()
+	issuer := ssotest.NewIssuer(t)
+	provider := newTestProvider(t, issuer)
+
+	login, err := NewLoginState(provider.Name())
+	if err != nil {
+		t.Fatalf("NewLoginState() error = %v", err)
+	}
+
+	authURL := provider.AuthCodeURL(login)
+	u, _ := url.Parse(authURL)
+	if u.Query().Get("code_challenge") == login.Verifier {
+		t.Error("authorization URL must carry the challenge, not the verifier")
+	}
+
+	code, state, err := issuer.Authorize(authURL, ssotest.Claims{
+		Subject:       "user-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+	})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if state != login.State {
+		t.Errorf("state = %v, want %v", state, login.State)
+	}
+
+	identity, err := provider.Exchange(ctx, code, login)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if identity.Subject != "user-1" || identity.Email != "jane@example.com" || !identity.EmailVerified {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+
+	// Codes are single-use
+	if _, err := provider.Exchange(ctx, code, login); err == nil {
+		t.Error("expected redeemed code to be rejected")
+	}
+}
+
+func TestProvider_ExchangeRejectsWrongLogin(t *testing.T) {
+	ctx := context.Background()
+	issuer := ssotest.NewIssuer(t)
+	provider := newTestProvider(t, issuer)
+
+	login, _ := NewLoginState(provider.Name())
+	other, _ := NewLoginState(provider.Name())
+
+	// A different PKCE verifier fails the code exchange
+	code, _, err := issuer.Authorize(provider.AuthCodeURL(login), ssotest.Claims{Subject: "user-1"})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if _, err := provider.Exchange(ctx, code, other); err == nil {
+		t.Error("expected exchange with wrong verifier to fail")
+	}
+
+	// A matching verifier with a different nonce fails ID token validation
+	code, _, err = issuer.Authorize(provider.AuthCodeURL(login), ssotest.Claims{Subject: "user-1"})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	mismatched := *login
+	mismatched.Nonce = other.Nonce
+	if _, err := provider.Exchange(ctx, code, &mismatched); !errors.Is(err, ErrNonceMismatch) {
+		t.Errorf("expected ErrNonceMismatch, got %v", err)
+	}
+}
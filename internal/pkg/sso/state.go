@@ -0,0 +1,63 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrLoginStateNotFound is returned when a login state is unknown, has
+// expired or has already been used
+var ErrLoginStateNotFound = errors.New("login state not found")
+
+// LoginState is kept server-side between starting a login and handling the
+// provider's callback
+type LoginState struct {
+	State    string `json:"state"`
+	Provider string `json:"provider"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+// NewLoginState creates the state, nonce and PKCE verifier for a new login
+func NewLoginState(provider string) (*LoginState, error) {
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginState{
+		State:    state,
+		Provider: provider,
+		Nonce:    nonce,
+		Verifier: oauth2.GenerateVerifier(),
+	}, nil
+}
+
+// StateStore stores pending logins
+type StateStore interface {
+	// Save stores a login state until it is taken or the ttl elapses
+	Save(ctx context.Context, login *LoginState, ttl time.Duration) error
+
+	// Take removes and returns a login state. It returns
+	// ErrLoginStateNotFound if no such state is pending.
+	Take(ctx context.Context, state string) (*LoginState, error)
+}
+
+// randomString returns 32 random bytes encoded for use in URLs
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// LocalStorage implements media storage on the local filesystem
+type LocalStorage struct {
+	basePath string
+}
+
+// NewLocalStorage creates a new local storage instance rooted at basePath
+func NewLocalStorage(basePath string) (*LocalStorage, error) {
+	if err := os.MkdirAll(filepath.Join(basePath, "media"), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %v", err)
+	}
+
+	return &LocalStorage{basePath: basePath}, nil
+}
+
+// UploadFile stores a file on disk and returns its relative file URL
+func (s *LocalStorage) UploadFile(ctx context.Context, file multipart.File, header *multipart.FileHeader, userID string) (string, error) {
+	if file == nil {
+		return "", grpcstatus.Error(codes.InvalidArgument, "file is required")
+	}
+	if header == nil {
+		return "", grpcstatus.Error(codes.InvalidArgument, "file header is required")
+	}
+
+	// Validate file size (max 10MB)
+	if header.Size > 10*1024*1024 {
+		return "", grpcstatus.Error(codes.InvalidArgument, "file size exceeds 10MB limit")
+	}
+
+	// Generate unique file name
+	ext := filepath.Ext(header.Filename)
+	fileName := fmt.Sprintf("%s_%d%s", userID, time.Now().UnixNano(), ext)
+	filePath := fmt.Sprintf("media/%s", fileName)
+
+	dst, err := os.Create(filepath.Join(s.basePath, filePath))
+	if err != nil {
+		return "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to create file: %v", err))
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, file); err != nil {
+		return "", grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to write file: %v", err))
+	}
+
+	return filePath, nil
+}
+
+// DeleteFile deletes a file from disk
+func (s *LocalStorage) DeleteFile(ctx context.Context, fileURL string) error {
+	path, err := s.resolve(fileURL)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to delete file: %v", err))
+	}
+
+	return nil
+}
+
+// DownloadFile opens a file from disk
+func (s *LocalStorage) DownloadFile(ctx context.Context, fileURL string) (io.ReadCloser, error) {
+	path, err := s.resolve(fileURL)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path) // #nosec G304 -- path is confined to basePath by resolve
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to download file: %v", err))
+	}
+
+	return f, nil
+}
+
+// GetFileInfo retrieves file metadata from disk
+func (s *LocalStorage) GetFileInfo(ctx context.Context, fileURL string) (*FileInfo, error) {
+	path, err := s.resolve(fileURL)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, fmt.Sprintf("failed to get file info: %v", err))
+	}
+
+	return &FileInfo{
+		Size:         info.Size(),
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+// resolve maps a file URL to a path inside the storage directory
+func (s *LocalStorage) resolve(fileURL string) (string, error) {
+	if fileURL == "" {
+		return "", grpcstatus.Error(codes.InvalidArgument, "file URL is required")
+	}
+
+	path := filepath.Join(s.basePath, filepath.Clean("/"+fileURL))
+	if !strings.HasPrefix(path, filepath.Clean(s.basePath)+string(os.PathSeparator)) {
+		return "", grpcstatus.Error(codes.InvalidArgument, "invalid file URL")
+	}
+
+	return path, nil
+}